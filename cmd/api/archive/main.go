@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"go-playground/cmd/api/internal/datasource"
+	"go-playground/cmd/api/internal/datasource/database"
+	"go-playground/cmd/api/internal/usecase"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+func main() {
+	days := flag.Int("days", 180, "archive tasks not updated for given days")
+	batch := flag.Int("batch", 100, "number of tasks moved in one transaction")
+	flag.Parse()
+	if *days <= 0 {
+		panic("days must be positive")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	db, err := database.NewDB(os.LookupEnv)
+	if err != nil {
+		panic(err)
+	}
+	defer func() { _ = db.Close() }()
+	u := usecase.NewTaskArchiveUseCase(datasource.NewTaskArchiveAdaptor(db), datasource.NewDBTransactionAdaptor(db))
+
+	before := time.Now().AddDate(0, 0, -*days)
+	n, err := u.ArchiveTasks(ctx, before, int32(*batch))
+	if err != nil {
+		// Committed batches are kept, so running again resumes from remaining tasks.
+		slog.Error("Failed to archive tasks", slog.Int64("archived", n), slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	slog.Info("Archived tasks", slog.Int64("archived", n), slog.Time("before", before))
+}
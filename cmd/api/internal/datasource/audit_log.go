@@ -0,0 +1,91 @@
+package datasource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go-playground/cmd/api/internal/datasource/database"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/domain/repository"
+	"go-playground/pkg/apperr"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// AuditLogAdaptor is implementation of [repository.AuditLogRepository].
+type AuditLogAdaptor struct {
+	base
+}
+
+// NewAuditLogAdaptor creates AuditLogAdaptor.
+func NewAuditLogAdaptor(db *sqlx.DB) *AuditLogAdaptor {
+	return &AuditLogAdaptor{base: base{db: db}}
+}
+
+// Create inserts given audit log.
+func (a *AuditLogAdaptor) Create(ctx context.Context, log entity.AuditLog) error {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/AuditLogAdaptor/Create").End()
+
+	detail := log.Detail
+	if detail == nil {
+		detail = map[string]string{}
+	}
+	b, err := json.Marshal(detail)
+	if err != nil {
+		return apperr.New(fmt.Sprintf("marshal detail of audit log %q", log.Action), "failed to record audit log", apperr.WithCause(err))
+	}
+	txq := a.queriesFromContext(ctx)
+	_, err = txq.CreateAuditLog(ctx, database.CreateAuditLogParams{
+		ID:       log.ID,
+		ActorID:  log.ActorID[:],
+		Action:   string(log.Action),
+		TargetID: log.TargetID,
+		Detail:   b,
+	})
+	if err != nil {
+		return apperr.New(fmt.Sprintf("create audit log %q", log.Action), "failed to record audit log", apperr.WithCause(err))
+	}
+	return nil
+}
+
+// ListByTarget finds audit logs of given target.
+func (a *AuditLogAdaptor) ListByTarget(ctx context.Context, targetID string) ([]entity.AuditLog, error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/AuditLogAdaptor/ListByTarget").End()
+
+	txq := a.queriesFromContext(ctx)
+	rows, err := txq.ListAuditLogsByTarget(ctx, targetID)
+	if err != nil {
+		return nil, apperr.New(fmt.Sprintf("list audit logs of target %q", targetID), "failed to find audit logs", apperr.WithCause(err))
+	}
+	logs := make([]entity.AuditLog, len(rows))
+	for i, row := range rows {
+		logs[i], err = toAuditLog(row)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return logs, nil
+}
+
+func toAuditLog(row database.AuditLog) (entity.AuditLog, error) {
+	actorID, err := uuid.FromBytes(row.ActorID)
+	if err != nil {
+		return entity.AuditLog{}, apperr.New(fmt.Sprintf("raw actor id of audit log %q to uuid", row.ID), "failed to find audit logs", apperr.WithCause(err))
+	}
+	var detail map[string]string
+	if err := json.Unmarshal(row.Detail, &detail); err != nil {
+		return entity.AuditLog{}, apperr.New(fmt.Sprintf("unmarshal detail of audit log %q", row.ID), "failed to find audit logs", apperr.WithCause(err))
+	}
+	return entity.AuditLog{
+		ID:        row.ID,
+		ActorID:   actorID,
+		Action:    entity.AuditAction(row.Action),
+		TargetID:  row.TargetID,
+		Detail:    detail,
+		CreatedAt: row.CreatedAt,
+	}, nil
+}
+
+var _ repository.AuditLogRepository = (*AuditLogAdaptor)(nil)
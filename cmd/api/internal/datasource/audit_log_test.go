@@ -0,0 +1,34 @@
+package datasource_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/datasource"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/pkg/testhelper"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLogAdaptor_CreateAndListByTarget(t *testing.T) {
+	uid := testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812")
+	adaptor := datasource.NewAuditLogAdaptor(db)
+	runInTx(t, func(ctx context.Context) {
+		first, err := entity.NewAuditLog(uid, entity.AuditActionUserEmailChanged, uid.String(), map[string]string{"from": "a@example.com", "to": "b@example.com"})
+		require.NoError(t, err)
+		second, err := entity.NewAuditLog(uid, entity.AuditActionUserEmailChanged, uid.String(), nil)
+		require.NoError(t, err)
+		require.NoError(t, adaptor.Create(ctx, first))
+		require.NoError(t, adaptor.Create(ctx, second))
+
+		got, err := adaptor.ListByTarget(ctx, uid.String())
+
+		require.NoError(t, err)
+		second.Detail = map[string]string{}
+		diff := cmp.Diff([]entity.AuditLog{second, first}, got, cmpopts.IgnoreFields(entity.AuditLog{}, "CreatedAt"))
+		assert.Empty(t, diff)
+	})
+}
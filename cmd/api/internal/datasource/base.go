@@ -3,6 +3,9 @@ package datasource
 import (
 	"context"
 	"go-playground/cmd/api/internal/datasource/database"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -28,3 +31,13 @@ func (b *base) extFromContext(ctx context.Context) sqlx.Ext {
 	}
 	return txx
 }
+
+// organizationFromContext retrieves id of active organization from [context.Context].
+// Queries of tenant data such as tasks must be scoped by it, so error is returned if no organization is active.
+func (b *base) organizationFromContext(ctx context.Context) (string, error) {
+	orgID, ok := ctxhelper.OrganizationID(ctx)
+	if !ok {
+		return "", apperr.New("query tenant data without active organization", "Organization is not selected", apperr.WithMessageID(message.OrganizationNotSelected), apperr.CodeInvalidArgument)
+	}
+	return orgID, nil
+}
@@ -0,0 +1,87 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: audit_logs.sql
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+)
+
+const createAuditLog = `-- name: CreateAuditLog :execrows
+INSERT INTO
+	audit_logs (
+		id,
+		actor_id,
+		action,
+		target_id,
+		detail
+	)
+VALUES
+	(?, ?, ?, ?, ?)
+`
+
+type CreateAuditLogParams struct {
+	ID       string
+	ActorID  []byte
+	Action   string
+	TargetID string
+	Detail   json.RawMessage
+}
+
+// CreateAuditLog inserts given audit log.
+func (q *Queries) CreateAuditLog(ctx context.Context, arg CreateAuditLogParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createAuditLog,
+		arg.ID,
+		arg.ActorID,
+		arg.Action,
+		arg.TargetID,
+		arg.Detail,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const listAuditLogsByTarget = `-- name: ListAuditLogsByTarget :many
+SELECT
+	id, actor_id, action, target_id, detail, created_at
+FROM
+	audit_logs
+WHERE
+	target_id = ?
+ORDER BY
+	id DESC
+`
+
+// ListAuditLogsByTarget finds audit logs of given target in order of newest first.
+func (q *Queries) ListAuditLogsByTarget(ctx context.Context, targetID string) ([]AuditLog, error) {
+	rows, err := q.db.QueryContext(ctx, listAuditLogsByTarget, targetID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditLog
+	for rows.Next() {
+		var i AuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.ActorID,
+			&i.Action,
+			&i.TargetID,
+			&i.Detail,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -3,9 +3,66 @@
 package database
 
 import (
+	"database/sql"
+	"encoding/json"
 	"time"
 )
 
+// audit_logs is append only record of security relevant actions. It has no foreign key to outlive actors and targets
+type AuditLog struct {
+	// id is audit log id
+	ID string
+	// actor_id is id of user who did the action
+	ActorID []byte
+	// action is kind of recorded action
+	Action string
+	// target_id is id of resource which the action was done to
+	TargetID string
+	// detail is additional information depending on action
+	Detail    json.RawMessage
+	CreatedAt time.Time
+}
+
+// organizations is tenancy boundary which owns tasks
+type Organization struct {
+	// id is organization id
+	ID string
+	// name is display name of organization
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// organization_members is users belonging to organization with role
+type OrganizationMember struct {
+	// organization_id is id of organization
+	OrganizationID string
+	// user_id is id of member
+	UserID []byte
+	// role is one of owner, admin and member
+	Role      string
+	CreatedAt time.Time
+}
+
+// personal_access_tokens is long-lived token issued by user for scripts and CI jobs
+type PersonalAccessToken struct {
+	// id is personal access token id
+	ID string
+	// user_id is id of user who owns the token
+	UserID []byte
+	// name is label of the token given by user
+	Name string
+	// token_hash is SHA-256 of raw token. Raw token is never stored
+	TokenHash []byte
+	// scopes is space separated scopes granted to the token
+	Scopes string
+	// expires_at is when the token expires
+	ExpiresAt time.Time
+	// revoked_at is when the token is revoked
+	RevokedAt sql.NullTime
+	CreatedAt time.Time
+}
+
 type Task struct {
 	// id is task id
 	ID string
@@ -13,6 +70,51 @@ type Task struct {
 	Content   string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// organization_id is id of organization owning task
+	OrganizationID string
+	// owner_id is id of user who created task
+	OwnerID sql.NullString
+}
+
+// task_mentions is users mentioned in task content
+type TaskMention struct {
+	// task_id is id of task mentioning user
+	TaskID string
+	// user_id is id of mentioned user
+	UserID    []byte
+	CreatedAt time.Time
+}
+
+// tasks_archive is cold storage of tasks not updated for long time
+type TasksArchive struct {
+	// id is task id
+	ID string
+	// content is task content
+	Content   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// archived_at is when task was moved from tasks
+	ArchivedAt time.Time
+	// organization_id is id of organization owning task
+	OrganizationID string
+	// owner_id is id of user who created task
+	OwnerID sql.NullString
+}
+
+// time_entries is time tracked on tasks
+type TimeEntry struct {
+	// id is time entry id
+	ID string
+	// task_id is id of tracked task
+	TaskID string
+	// user_id is id of user who tracked time
+	UserID []byte
+	// started_at is when tracking started
+	StartedAt time.Time
+	// ended_at is when tracking ended. null means timer is running
+	EndedAt   sql.NullTime
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 // users is user information
@@ -31,4 +133,39 @@ type User struct {
 	EmailVerified bool
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
+	// deleted_at is when user requested deletion. User is purged after grace period
+	DeletedAt sql.NullTime
+	// role is one of user, support and admin
+	Role string
+	// disabled_at is when user is disabled by admin. Disabled user is rejected by auth layer
+	DisabledAt sql.NullTime
+}
+
+// user_exports is personal data export requested by user
+type UserExport struct {
+	// id is user export id
+	ID string
+	// user_id is id of exported user
+	UserID []byte
+	// status is one of pending, ready and failed
+	Status string
+	// content is exported personal data. This is present when status is ready
+	Content   json.RawMessage
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// user_preferences is preferences of user. Default preferences are used for user without the record
+type UserPreference struct {
+	// user_id is id of user who owns the preferences
+	UserID []byte
+	// timezone is IANA time zone name to render and compute dates
+	Timezone string
+	// locale is BCP 47 language tag
+	Locale string
+	// page_size is default size of list pages
+	PageSize int32
+	// week_start is first day of week. 0 is Sunday and 6 is Saturday
+	WeekStart int8
+	UpdatedAt time.Time
 }
@@ -0,0 +1,190 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: organizations.sql
+
+package database
+
+import (
+	"context"
+	"time"
+)
+
+const createOrganization = `-- name: CreateOrganization :exec
+INSERT INTO organizations (id, name, created_at, updated_at)
+		VALUES(?, ?, ?, ?)
+`
+
+type CreateOrganizationParams struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CreateOrganization inserts given organization.
+func (q *Queries) CreateOrganization(ctx context.Context, arg CreateOrganizationParams) error {
+	_, err := q.db.ExecContext(ctx, createOrganization,
+		arg.ID,
+		arg.Name,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const createOrganizationMember = `-- name: CreateOrganizationMember :exec
+INSERT INTO organization_members (organization_id, user_id, role, created_at)
+		VALUES(?, ?, ?, ?)
+`
+
+type CreateOrganizationMemberParams struct {
+	OrganizationID string
+	UserID         []byte
+	Role           string
+	CreatedAt      time.Time
+}
+
+// CreateOrganizationMember inserts given member of organization.
+func (q *Queries) CreateOrganizationMember(ctx context.Context, arg CreateOrganizationMemberParams) error {
+	_, err := q.db.ExecContext(ctx, createOrganizationMember,
+		arg.OrganizationID,
+		arg.UserID,
+		arg.Role,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const deleteOrganizationMember = `-- name: DeleteOrganizationMember :execrows
+DELETE FROM
+	organization_members
+WHERE
+	organization_id = ?
+	AND user_id = ?
+`
+
+type DeleteOrganizationMemberParams struct {
+	OrganizationID string
+	UserID         []byte
+}
+
+// DeleteOrganizationMember deletes membership of given user in given organization.
+func (q *Queries) DeleteOrganizationMember(ctx context.Context, arg DeleteOrganizationMemberParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteOrganizationMember, arg.OrganizationID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const findOrganizationMember = `-- name: FindOrganizationMember :one
+SELECT
+	organization_id, user_id, role, created_at
+FROM
+	organization_members
+WHERE
+	organization_id = ?
+	AND user_id = ?
+`
+
+type FindOrganizationMemberParams struct {
+	OrganizationID string
+	UserID         []byte
+}
+
+// FindOrganizationMember finds membership of given user in given organization.
+func (q *Queries) FindOrganizationMember(ctx context.Context, arg FindOrganizationMemberParams) (OrganizationMember, error) {
+	row := q.db.QueryRowContext(ctx, findOrganizationMember, arg.OrganizationID, arg.UserID)
+	var i OrganizationMember
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.UserID,
+		&i.Role,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listOrganizationMembers = `-- name: ListOrganizationMembers :many
+SELECT
+	organization_id, user_id, role, created_at
+FROM
+	organization_members
+WHERE
+	organization_id = ?
+ORDER BY
+	created_at,
+	user_id
+`
+
+// ListOrganizationMembers lists members of given organization in joined order.
+func (q *Queries) ListOrganizationMembers(ctx context.Context, organizationID string) ([]OrganizationMember, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizationMembers, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OrganizationMember
+	for rows.Next() {
+		var i OrganizationMember
+		if err := rows.Scan(
+			&i.OrganizationID,
+			&i.UserID,
+			&i.Role,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrganizationsByUserID = `-- name: ListOrganizationsByUserID :many
+SELECT
+	o.id,
+	o.name,
+	o.created_at,
+	o.updated_at
+FROM
+	organizations o
+	INNER JOIN organization_members m ON m.organization_id = o.id
+WHERE
+	m.user_id = ?
+ORDER BY
+	o.id
+`
+
+// ListOrganizationsByUserID lists organizations which given user belongs to from oldest.
+func (q *Queries) ListOrganizationsByUserID(ctx context.Context, userID []byte) ([]Organization, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizationsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Organization
+	for rows.Next() {
+		var i Organization
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,172 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: personal_access_tokens.sql
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const createPersonalAccessToken = `-- name: CreatePersonalAccessToken :execrows
+INSERT INTO
+	personal_access_tokens (
+		id,
+		user_id,
+		name,
+		token_hash,
+		scopes,
+		expires_at,
+		created_at
+	)
+VALUES
+	(?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreatePersonalAccessTokenParams struct {
+	ID        string
+	UserID    []byte
+	Name      string
+	TokenHash []byte
+	Scopes    string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// CreatePersonalAccessToken inserts given personal access token.
+func (q *Queries) CreatePersonalAccessToken(ctx context.Context, arg CreatePersonalAccessTokenParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createPersonalAccessToken,
+		arg.ID,
+		arg.UserID,
+		arg.Name,
+		arg.TokenHash,
+		arg.Scopes,
+		arg.ExpiresAt,
+		arg.CreatedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const findPersonalAccessTokenByHash = `-- name: FindPersonalAccessTokenByHash :one
+SELECT
+	id, user_id, name, token_hash, scopes, expires_at, revoked_at, created_at
+FROM
+	personal_access_tokens
+WHERE
+	token_hash = ?
+`
+
+// FindPersonalAccessTokenByHash finds personal access token by hash of raw token.
+func (q *Queries) FindPersonalAccessTokenByHash(ctx context.Context, tokenHash []byte) (PersonalAccessToken, error) {
+	row := q.db.QueryRowContext(ctx, findPersonalAccessTokenByHash, tokenHash)
+	var i PersonalAccessToken
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.TokenHash,
+		&i.Scopes,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const findPersonalAccessTokenForUpdate = `-- name: FindPersonalAccessTokenForUpdate :one
+SELECT
+	id, user_id, name, token_hash, scopes, expires_at, revoked_at, created_at
+FROM
+	personal_access_tokens
+WHERE
+	id = ?
+FOR UPDATE
+`
+
+// FindPersonalAccessTokenForUpdate finds and locks personal access token by given id.
+func (q *Queries) FindPersonalAccessTokenForUpdate(ctx context.Context, id string) (PersonalAccessToken, error) {
+	row := q.db.QueryRowContext(ctx, findPersonalAccessTokenForUpdate, id)
+	var i PersonalAccessToken
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.TokenHash,
+		&i.Scopes,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listPersonalAccessTokensByUserID = `-- name: ListPersonalAccessTokensByUserID :many
+SELECT
+	id, user_id, name, token_hash, scopes, expires_at, revoked_at, created_at
+FROM
+	personal_access_tokens
+WHERE
+	user_id = ?
+ORDER BY
+	id DESC
+`
+
+// ListPersonalAccessTokensByUserID lists personal access tokens of given user from newest.
+func (q *Queries) ListPersonalAccessTokensByUserID(ctx context.Context, userID []byte) ([]PersonalAccessToken, error) {
+	rows, err := q.db.QueryContext(ctx, listPersonalAccessTokensByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PersonalAccessToken
+	for rows.Next() {
+		var i PersonalAccessToken
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.TokenHash,
+			&i.Scopes,
+			&i.ExpiresAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokePersonalAccessToken = `-- name: RevokePersonalAccessToken :execrows
+UPDATE
+	personal_access_tokens
+SET
+	revoked_at = ?
+WHERE
+	id = ?
+`
+
+type RevokePersonalAccessTokenParams struct {
+	RevokedAt sql.NullTime
+	ID        string
+}
+
+// RevokePersonalAccessToken revokes personal access token with given id.
+func (q *Queries) RevokePersonalAccessToken(ctx context.Context, arg RevokePersonalAccessTokenParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, revokePersonalAccessToken, arg.RevokedAt, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
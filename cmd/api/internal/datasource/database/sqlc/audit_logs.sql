@@ -0,0 +1,23 @@
+-- name: CreateAuditLog :execrows
+-- CreateAuditLog inserts given audit log.
+INSERT INTO
+	audit_logs (
+		id,
+		actor_id,
+		action,
+		target_id,
+		detail
+	)
+VALUES
+	(?, ?, ?, ?, ?);
+
+-- name: ListAuditLogsByTarget :many
+-- ListAuditLogsByTarget finds audit logs of given target in order of newest first.
+SELECT
+	*
+FROM
+	audit_logs
+WHERE
+	target_id = ?
+ORDER BY
+	id DESC;
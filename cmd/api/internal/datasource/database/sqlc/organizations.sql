@@ -0,0 +1,54 @@
+-- name: CreateOrganization :exec
+-- CreateOrganization inserts given organization.
+INSERT INTO organizations (id, name, created_at, updated_at)
+		VALUES(?, ?, ?, ?);
+
+-- name: ListOrganizationsByUserID :many
+-- ListOrganizationsByUserID lists organizations which given user belongs to from oldest.
+SELECT
+	o.id,
+	o.name,
+	o.created_at,
+	o.updated_at
+FROM
+	organizations o
+	INNER JOIN organization_members m ON m.organization_id = o.id
+WHERE
+	m.user_id = ?
+ORDER BY
+	o.id;
+
+-- name: CreateOrganizationMember :exec
+-- CreateOrganizationMember inserts given member of organization.
+INSERT INTO organization_members (organization_id, user_id, role, created_at)
+		VALUES(?, ?, ?, ?);
+
+-- name: FindOrganizationMember :one
+-- FindOrganizationMember finds membership of given user in given organization.
+SELECT
+	*
+FROM
+	organization_members
+WHERE
+	organization_id = ?
+	AND user_id = ?;
+
+-- name: ListOrganizationMembers :many
+-- ListOrganizationMembers lists members of given organization in joined order.
+SELECT
+	*
+FROM
+	organization_members
+WHERE
+	organization_id = ?
+ORDER BY
+	created_at,
+	user_id;
+
+-- name: DeleteOrganizationMember :execrows
+-- DeleteOrganizationMember deletes membership of given user in given organization.
+DELETE FROM
+	organization_members
+WHERE
+	organization_id = ?
+	AND user_id = ?;
@@ -0,0 +1,53 @@
+-- name: CreatePersonalAccessToken :execrows
+-- CreatePersonalAccessToken inserts given personal access token.
+INSERT INTO
+	personal_access_tokens (
+		id,
+		user_id,
+		name,
+		token_hash,
+		scopes,
+		expires_at,
+		created_at
+	)
+VALUES
+	(?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListPersonalAccessTokensByUserID :many
+-- ListPersonalAccessTokensByUserID lists personal access tokens of given user from newest.
+SELECT
+	*
+FROM
+	personal_access_tokens
+WHERE
+	user_id = ?
+ORDER BY
+	id DESC;
+
+-- name: FindPersonalAccessTokenForUpdate :one
+-- FindPersonalAccessTokenForUpdate finds and locks personal access token by given id.
+SELECT
+	*
+FROM
+	personal_access_tokens
+WHERE
+	id = ?
+FOR UPDATE;
+
+-- name: FindPersonalAccessTokenByHash :one
+-- FindPersonalAccessTokenByHash finds personal access token by hash of raw token.
+SELECT
+	*
+FROM
+	personal_access_tokens
+WHERE
+	token_hash = ?;
+
+-- name: RevokePersonalAccessToken :execrows
+-- RevokePersonalAccessToken revokes personal access token with given id.
+UPDATE
+	personal_access_tokens
+SET
+	revoked_at = ?
+WHERE
+	id = ?;
@@ -0,0 +1,50 @@
+-- name: DeleteTaskMentions :exec
+-- DeleteTaskMentions deletes all mentions of given task.
+DELETE FROM
+	task_mentions
+WHERE
+	task_id = ?;
+
+-- name: CreateTaskMention :exec
+-- CreateTaskMention inserts given user mentioned in given task.
+INSERT INTO task_mentions (task_id, user_id)
+		VALUES(?, ?);
+
+-- name: ListMentionedTasks :many
+-- ListMentionedTasks finds tasks of given organization mentioning given user by cursor pagination.
+SELECT
+	t.id,
+	t.content,
+	t.created_at,
+	t.updated_at
+FROM
+	tasks t
+	INNER JOIN task_mentions m ON m.task_id = t.id
+WHERE
+	m.user_id = sqlc.arg('user_id')
+	AND t.organization_id = sqlc.arg('organization_id')
+	AND ('' = sqlc.arg('id') OR t.id <= sqlc.arg('id'))
+ORDER BY
+	t.id DESC
+LIMIT ?;
+
+-- name: DeleteTaskMentionsOfOwners :exec
+-- DeleteTaskMentionsOfOwners deletes all mentions in active and archived tasks of given owners.
+-- Mentions are not deleted by cascade since task may be in either tasks or tasks_archive.
+DELETE FROM
+	task_mentions
+WHERE
+	task_id IN (
+		SELECT
+			tasks.id
+		FROM
+			tasks
+		WHERE
+			tasks.owner_id IN (sqlc.slice('owner_ids'))
+		UNION ALL
+		SELECT
+			tasks_archive.id
+		FROM
+			tasks_archive
+		WHERE
+			tasks_archive.owner_id IN (sqlc.slice('archive_owner_ids')));
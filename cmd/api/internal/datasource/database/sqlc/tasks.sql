@@ -8,31 +8,34 @@ SELECT
 FROM
     tasks
 WHERE
-    '' = sqlc.arg('id') OR id <= sqlc.arg('id')
+    organization_id = sqlc.arg('organization_id')
+    AND ('' = sqlc.arg('id') OR id <= sqlc.arg('id'))
 ORDER BY
     id DESC
 LIMIT ?;
 
 
 -- name: FindTask :one
--- FindTask finds task by given id.
+-- FindTask finds task by given id in given organization.
 SELECT
 	*
 FROM
 	tasks
 WHERE
-	id = ?;
+	id = ?
+	AND organization_id = ?;
 
 -- name: CreateTask :execresult
 -- CreateTask inserts given task.
-INSERT INTO tasks (id, content)
-		VALUES(?, ?);
+INSERT INTO tasks (id, organization_id, owner_id, content)
+		VALUES(?, ?, ?, ?);
  
 -- name: UpdateTask :execresult
--- UpdateTask updates task by given id.
+-- UpdateTask updates task by given id in given organization.
 UPDATE
 	tasks
 SET
 	content = ?
 WHERE
-	id = ?;
+	id = ?
+	AND organization_id = ?;
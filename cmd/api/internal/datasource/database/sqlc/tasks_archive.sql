@@ -0,0 +1,211 @@
+-- name: ListArchivableTaskIDs :many
+-- ListArchivableTaskIDs locks tasks not updated since given time. Tasks locked by other transaction are skipped.
+SELECT
+	id
+FROM
+	tasks
+WHERE
+	updated_at < ?
+ORDER BY
+	id
+LIMIT ?
+FOR UPDATE SKIP LOCKED;
+
+-- name: CopyTasksToArchive :execrows
+-- CopyTasksToArchive copies tasks of given ids to archive.
+INSERT INTO tasks_archive (id, organization_id, owner_id, content, created_at, updated_at)
+SELECT
+	tasks.id,
+	tasks.organization_id,
+	tasks.owner_id,
+	tasks.content,
+	tasks.created_at,
+	tasks.updated_at
+FROM
+	tasks
+WHERE
+	tasks.id IN (sqlc.slice('ids'));
+
+-- name: ListOwnedTaskIDsForUpdate :many
+-- ListOwnedTaskIDsForUpdate locks tasks of given owner in all organizations.
+SELECT
+	id
+FROM
+	tasks
+WHERE
+	owner_id = ?
+ORDER BY
+	id
+FOR UPDATE;
+
+-- name: CopyTasksToArchiveAt :execrows
+-- CopyTasksToArchiveAt copies tasks of given ids to archive as archived at given time.
+INSERT INTO tasks_archive (id, organization_id, owner_id, content, created_at, updated_at, archived_at)
+SELECT
+	tasks.id,
+	tasks.organization_id,
+	tasks.owner_id,
+	tasks.content,
+	tasks.created_at,
+	tasks.updated_at,
+	sqlc.arg('archived_at')
+FROM
+	tasks
+WHERE
+	tasks.id IN (sqlc.slice('ids'));
+
+-- name: DeleteTasks :execrows
+-- DeleteTasks deletes tasks of given ids.
+DELETE FROM
+	tasks
+WHERE
+	id IN (sqlc.slice('ids'));
+
+-- name: FindArchivedTaskForUpdate :one
+-- FindArchivedTaskForUpdate finds and locks archived task by given id in given organization.
+SELECT
+	*
+FROM
+	tasks_archive
+WHERE
+	id = ?
+	AND organization_id = ?
+FOR UPDATE;
+
+-- name: CopyArchivedTaskToTasks :execrows
+-- CopyArchivedTaskToTasks copies archived task of given id back to tasks.
+-- Task is updated at unarchiving, so that it is not archived again by the next run.
+INSERT INTO tasks (id, organization_id, owner_id, content, created_at, updated_at)
+SELECT
+	tasks_archive.id,
+	tasks_archive.organization_id,
+	tasks_archive.owner_id,
+	tasks_archive.content,
+	tasks_archive.created_at,
+	CURRENT_TIMESTAMP
+FROM
+	tasks_archive
+WHERE
+	tasks_archive.id = ?;
+
+-- name: DeleteArchivedTask :execrows
+-- DeleteArchivedTask deletes archived task by given id.
+DELETE FROM
+	tasks_archive
+WHERE
+	id = ?;
+
+-- name: ListOwnedArchivedTaskIDsForUpdate :many
+-- ListOwnedArchivedTaskIDsForUpdate locks archived tasks of given owner which are archived at or after given time.
+SELECT
+	id
+FROM
+	tasks_archive
+WHERE
+	owner_id = ?
+	AND archived_at >= ?
+ORDER BY
+	id
+FOR UPDATE;
+
+-- name: CopyArchivedTasksToTasks :execrows
+-- CopyArchivedTasksToTasks copies archived tasks of given ids back to tasks.
+-- Task is updated at unarchiving, so that it is not archived again by the next run.
+INSERT INTO tasks (id, organization_id, owner_id, content, created_at, updated_at)
+SELECT
+	tasks_archive.id,
+	tasks_archive.organization_id,
+	tasks_archive.owner_id,
+	tasks_archive.content,
+	tasks_archive.created_at,
+	CURRENT_TIMESTAMP
+FROM
+	tasks_archive
+WHERE
+	tasks_archive.id IN (sqlc.slice('ids'));
+
+-- name: DeleteArchivedTasks :execrows
+-- DeleteArchivedTasks deletes archived tasks of given ids.
+DELETE FROM
+	tasks_archive
+WHERE
+	id IN (sqlc.slice('ids'));
+
+-- name: ListTasksIncludingArchived :many
+-- ListTasksIncludingArchived finds both active and archived tasks of given organization by cursor pagination.
+SELECT
+	id,
+	content,
+	created_at,
+	updated_at,
+	FALSE AS archived
+FROM
+	tasks
+WHERE
+	tasks.organization_id = sqlc.arg('organization_id')
+	AND ('' = sqlc.arg('id') OR tasks.id <= sqlc.arg('id'))
+UNION ALL
+SELECT
+	id,
+	content,
+	created_at,
+	updated_at,
+	TRUE AS archived
+FROM
+	tasks_archive
+WHERE
+	tasks_archive.organization_id = sqlc.arg('organization_id')
+	AND ('' = sqlc.arg('id') OR tasks_archive.id <= sqlc.arg('id'))
+ORDER BY
+	id DESC
+LIMIT ?;
+
+-- name: ListOwnedTasksIncludingArchived :many
+-- ListOwnedTasksIncludingArchived finds both active and archived tasks of given owner in all organizations.
+SELECT
+	id,
+	content,
+	created_at,
+	updated_at,
+	FALSE AS archived
+FROM
+	tasks
+WHERE
+	tasks.owner_id = sqlc.arg('owner_id')
+UNION ALL
+SELECT
+	id,
+	content,
+	created_at,
+	updated_at,
+	TRUE AS archived
+FROM
+	tasks_archive
+WHERE
+	tasks_archive.owner_id = sqlc.arg('owner_id')
+ORDER BY
+	id;
+
+-- name: FindTaskIncludingArchived :one
+-- FindTaskIncludingArchived finds task with given id from both active and archived tasks of all organizations.
+SELECT
+	id,
+	content,
+	created_at,
+	updated_at,
+	FALSE AS archived
+FROM
+	tasks
+WHERE
+	tasks.id = sqlc.arg('id')
+UNION ALL
+SELECT
+	id,
+	content,
+	created_at,
+	updated_at,
+	TRUE AS archived
+FROM
+	tasks_archive
+WHERE
+	tasks_archive.id = sqlc.arg('id');
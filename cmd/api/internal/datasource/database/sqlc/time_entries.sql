@@ -0,0 +1,81 @@
+-- name: LockUser :one
+-- LockUser locks user row of given id until end of transaction.
+SELECT
+	id
+FROM
+	users
+WHERE
+	id = ?
+FOR UPDATE;
+
+-- name: FindRunningTimeEntry :one
+-- FindRunningTimeEntry finds time entry of running timer of given user.
+SELECT
+	id,
+	task_id,
+	user_id,
+	started_at,
+	ended_at,
+	created_at,
+	updated_at
+FROM
+	time_entries
+WHERE
+	user_id = ?
+	AND ended_at IS NULL
+LIMIT 1;
+
+-- name: ListTimeEntriesInRange :many
+-- ListTimeEntriesInRange finds time entries of given user overlapping given range.
+SELECT
+	id,
+	task_id,
+	user_id,
+	started_at,
+	ended_at,
+	created_at,
+	updated_at
+FROM
+	time_entries
+WHERE
+	user_id = sqlc.arg('user_id')
+	AND started_at < sqlc.arg('to')
+	AND (ended_at IS NULL OR ended_at > sqlc.arg('from'))
+ORDER BY
+	started_at;
+
+-- name: CreateTimeEntry :exec
+-- CreateTimeEntry inserts given time entry.
+INSERT INTO time_entries (id, task_id, user_id, started_at, ended_at)
+		VALUES(?, ?, ?, ?, ?);
+
+-- name: UpdateTimeEntry :execrows
+-- UpdateTimeEntry updates period of time entry by given id.
+UPDATE
+	time_entries
+SET
+	started_at = ?,
+	ended_at = ?
+WHERE
+	id = ?;
+
+-- name: DeleteTimeEntriesOfOwners :exec
+-- DeleteTimeEntriesOfOwners deletes all time entries on active and archived tasks of given owners.
+-- Time entries are not deleted by cascade since task may be in either tasks or tasks_archive.
+DELETE FROM
+	time_entries
+WHERE
+	task_id IN (
+		SELECT
+			tasks.id
+		FROM
+			tasks
+		WHERE
+			tasks.owner_id IN (sqlc.slice('owner_ids'))
+		UNION ALL
+		SELECT
+			tasks_archive.id
+		FROM
+			tasks_archive
+		WHERE
+			tasks_archive.owner_id IN (sqlc.slice('archive_owner_ids')));
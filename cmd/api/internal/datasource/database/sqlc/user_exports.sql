@@ -0,0 +1,42 @@
+-- name: CreateUserExport :execrows
+-- CreateUserExport inserts given user export.
+INSERT INTO
+	user_exports (
+		id,
+		user_id,
+		status
+	)
+VALUES
+	(?, ?, ?);
+
+-- name: FindUserExport :one
+-- FindUserExport finds user export by given id.
+SELECT
+	*
+FROM
+	user_exports
+WHERE
+	id = ?;
+
+-- name: FindPendingUserExportForUpdate :one
+-- FindPendingUserExportForUpdate finds and locks oldest pending user export. Exports locked by other transaction are skipped.
+SELECT
+	*
+FROM
+	user_exports
+WHERE
+	status = 'pending'
+ORDER BY
+	id
+LIMIT 1
+FOR UPDATE SKIP LOCKED;
+
+-- name: UpdateUserExport :execrows
+-- UpdateUserExport updates status and content of user export with given id.
+UPDATE
+	user_exports
+SET
+	status = ?,
+	content = ?
+WHERE
+	id = ?;
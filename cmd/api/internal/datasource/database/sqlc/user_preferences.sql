@@ -0,0 +1,28 @@
+-- name: FindUserPreferences :one
+-- FindUserPreferences finds preferences of given user.
+SELECT
+	*
+FROM
+	user_preferences
+WHERE
+	user_id = ?;
+
+-- name: SaveUserPreferences :exec
+-- SaveUserPreferences inserts or updates preferences of given user.
+INSERT INTO
+	user_preferences (
+		user_id,
+		timezone,
+		locale,
+		page_size,
+		week_start,
+		updated_at
+	)
+VALUES
+	(?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+	timezone = VALUES(timezone),
+	locale = VALUES(locale),
+	page_size = VALUES(page_size),
+	week_start = VALUES(week_start),
+	updated_at = VALUES(updated_at);
@@ -22,8 +22,132 @@ SELECT
 	email,
 	email_verified,
 	created_at,
-	updated_at
+	updated_at,
+	deleted_at,
+	role,
+	disabled_at
 FROM
 	users
 WHERE
 	sub = ?;
+
+-- name: FindUserByID :one
+-- FindUserByID finds user with given id.
+SELECT
+	id,
+	sub,
+	given_name,
+	family_name,
+	email,
+	email_verified,
+	created_at,
+	updated_at,
+	deleted_at,
+	role,
+	disabled_at
+FROM
+	users
+WHERE
+	id = ?;
+
+-- name: ListUsersByEmails :many
+-- ListUsersByEmails finds users with given emails.
+SELECT
+	id,
+	sub,
+	given_name,
+	family_name,
+	email,
+	email_verified,
+	created_at,
+	updated_at,
+	deleted_at,
+	role,
+	disabled_at
+FROM
+	users
+WHERE
+	email IN (sqlc.slice('emails'))
+	AND deleted_at IS NULL;
+
+-- name: FindUserBySubForUpdate :one
+-- FindUserBySubForUpdate finds and locks user with given sub(jwt subject).
+SELECT
+	id,
+	sub,
+	given_name,
+	family_name,
+	email,
+	email_verified,
+	created_at,
+	updated_at,
+	deleted_at,
+	role,
+	disabled_at
+FROM
+	users
+WHERE
+	sub = ?
+FOR UPDATE;
+
+-- name: UpdateUser :execrows
+-- UpdateUser updates user with given id.
+UPDATE
+	users
+SET
+	given_name = ?,
+	family_name = ?,
+	email = ?,
+	email_verified = ?,
+	deleted_at = ?,
+	role = ?,
+	disabled_at = ?
+WHERE
+	id = ?;
+
+-- name: ListPurgeableUserIDs :many
+-- ListPurgeableUserIDs locks users who requested deletion before given time. Users locked by other transaction are skipped.
+SELECT
+	id
+FROM
+	users
+WHERE
+	deleted_at < ?
+ORDER BY
+	id
+LIMIT ?
+FOR UPDATE SKIP LOCKED;
+
+-- name: DeleteUsers :execrows
+-- DeleteUsers deletes users of given ids. Rows owned by users are deleted by cascade.
+DELETE FROM
+	users
+WHERE
+	id IN (sqlc.slice('ids'));
+
+-- name: SearchUsers :many
+-- SearchUsers finds users whose email, given name or family name matches given LIKE pattern by cursor pagination.
+SELECT
+	id,
+	sub,
+	given_name,
+	family_name,
+	email,
+	email_verified,
+	created_at,
+	updated_at,
+	deleted_at,
+	role,
+	disabled_at
+FROM
+	users
+WHERE
+	('' = sqlc.arg('id') OR id <= sqlc.arg('id'))
+	AND (
+		email LIKE sqlc.arg('pattern')
+		OR given_name LIKE sqlc.arg('pattern')
+		OR family_name LIKE sqlc.arg('pattern')
+	)
+ORDER BY
+	id DESC
+LIMIT ?;
@@ -0,0 +1,157 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: task_mentions.sql
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+)
+
+const createTaskMention = `-- name: CreateTaskMention :exec
+INSERT INTO task_mentions (task_id, user_id)
+		VALUES(?, ?)
+`
+
+type CreateTaskMentionParams struct {
+	TaskID string
+	UserID []byte
+}
+
+// CreateTaskMention inserts given user mentioned in given task.
+func (q *Queries) CreateTaskMention(ctx context.Context, arg CreateTaskMentionParams) error {
+	_, err := q.db.ExecContext(ctx, createTaskMention, arg.TaskID, arg.UserID)
+	return err
+}
+
+const deleteTaskMentions = `-- name: DeleteTaskMentions :exec
+DELETE FROM
+	task_mentions
+WHERE
+	task_id = ?
+`
+
+// DeleteTaskMentions deletes all mentions of given task.
+func (q *Queries) DeleteTaskMentions(ctx context.Context, taskID string) error {
+	_, err := q.db.ExecContext(ctx, deleteTaskMentions, taskID)
+	return err
+}
+
+const deleteTaskMentionsOfOwners = `-- name: DeleteTaskMentionsOfOwners :exec
+DELETE FROM
+	task_mentions
+WHERE
+	task_id IN (
+		SELECT
+			tasks.id
+		FROM
+			tasks
+		WHERE
+			tasks.owner_id IN (/*SLICE:owner_ids*/?)
+		UNION ALL
+		SELECT
+			tasks_archive.id
+		FROM
+			tasks_archive
+		WHERE
+			tasks_archive.owner_id IN (/*SLICE:archive_owner_ids*/?))
+`
+
+type DeleteTaskMentionsOfOwnersParams struct {
+	OwnerIds        []sql.NullString
+	ArchiveOwnerIds []sql.NullString
+}
+
+// DeleteTaskMentionsOfOwners deletes all mentions in active and archived tasks of given owners.
+// Mentions are not deleted by cascade since task may be in either tasks or tasks_archive.
+func (q *Queries) DeleteTaskMentionsOfOwners(ctx context.Context, arg DeleteTaskMentionsOfOwnersParams) error {
+	query := deleteTaskMentionsOfOwners
+	var queryParams []interface{}
+	if len(arg.OwnerIds) > 0 {
+		for _, v := range arg.OwnerIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:owner_ids*/?", strings.Repeat(",?", len(arg.OwnerIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:owner_ids*/?", "NULL", 1)
+	}
+	if len(arg.ArchiveOwnerIds) > 0 {
+		for _, v := range arg.ArchiveOwnerIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:archive_owner_ids*/?", strings.Repeat(",?", len(arg.ArchiveOwnerIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:archive_owner_ids*/?", "NULL", 1)
+	}
+	_, err := q.db.ExecContext(ctx, query, queryParams...)
+	return err
+}
+
+const listMentionedTasks = `-- name: ListMentionedTasks :many
+SELECT
+	t.id,
+	t.content,
+	t.created_at,
+	t.updated_at
+FROM
+	tasks t
+	INNER JOIN task_mentions m ON m.task_id = t.id
+WHERE
+	m.user_id = ?
+	AND t.organization_id = ?
+	AND ('' = ? OR t.id <= ?)
+ORDER BY
+	t.id DESC
+LIMIT ?
+`
+
+type ListMentionedTasksParams struct {
+	UserID         []byte
+	OrganizationID string
+	ID             string
+	Limit          int32
+}
+
+type ListMentionedTasksRow struct {
+	ID        string
+	Content   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ListMentionedTasks finds tasks of given organization mentioning given user by cursor pagination.
+func (q *Queries) ListMentionedTasks(ctx context.Context, arg ListMentionedTasksParams) ([]ListMentionedTasksRow, error) {
+	rows, err := q.db.QueryContext(ctx, listMentionedTasks,
+		arg.UserID,
+		arg.OrganizationID,
+		arg.ID,
+		arg.ID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMentionedTasksRow
+	for rows.Next() {
+		var i ListMentionedTasksRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Content,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -6,41 +6,57 @@ package database
 import (
 	"context"
 	"database/sql"
+	"time"
 )
 
 const createTask = `-- name: CreateTask :execresult
-INSERT INTO tasks (id, content)
-		VALUES(?, ?)
+INSERT INTO tasks (id, organization_id, owner_id, content)
+		VALUES(?, ?, ?, ?)
 `
 
 type CreateTaskParams struct {
-	ID      string
-	Content string
+	ID             string
+	OrganizationID string
+	OwnerID        sql.NullString
+	Content        string
 }
 
 // CreateTask inserts given task.
 func (q *Queries) CreateTask(ctx context.Context, arg CreateTaskParams) (sql.Result, error) {
-	return q.db.ExecContext(ctx, createTask, arg.ID, arg.Content)
+	return q.db.ExecContext(ctx, createTask,
+		arg.ID,
+		arg.OrganizationID,
+		arg.OwnerID,
+		arg.Content,
+	)
 }
 
 const findTask = `-- name: FindTask :one
 SELECT
-	id, content, created_at, updated_at
+	id, content, created_at, updated_at, organization_id, owner_id
 FROM
 	tasks
 WHERE
 	id = ?
+	AND organization_id = ?
 `
 
-// FindTask finds task by given id.
-func (q *Queries) FindTask(ctx context.Context, id string) (Task, error) {
-	row := q.db.QueryRowContext(ctx, findTask, id)
+type FindTaskParams struct {
+	ID             string
+	OrganizationID string
+}
+
+// FindTask finds task by given id in given organization.
+func (q *Queries) FindTask(ctx context.Context, arg FindTaskParams) (Task, error) {
+	row := q.db.QueryRowContext(ctx, findTask, arg.ID, arg.OrganizationID)
 	var i Task
 	err := row.Scan(
 		&i.ID,
 		&i.Content,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.OrganizationID,
+		&i.OwnerID,
 	)
 	return i, err
 }
@@ -54,27 +70,41 @@ SELECT
 FROM
     tasks
 WHERE
-    '' = ? OR id <= ?
+    organization_id = ?
+    AND ('' = ? OR id <= ?)
 ORDER BY
     id DESC
 LIMIT ?
 `
 
 type ListTasksParams struct {
-	ID    string
-	Limit int32
+	OrganizationID string
+	ID             string
+	Limit          int32
+}
+
+type ListTasksRow struct {
+	ID        string
+	Content   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 // ListTasks finds tasks by cursor pagination.
-func (q *Queries) ListTasks(ctx context.Context, arg ListTasksParams) ([]Task, error) {
-	rows, err := q.db.QueryContext(ctx, listTasks, arg.ID, arg.ID, arg.Limit)
+func (q *Queries) ListTasks(ctx context.Context, arg ListTasksParams) ([]ListTasksRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTasks,
+		arg.OrganizationID,
+		arg.ID,
+		arg.ID,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Task
+	var items []ListTasksRow
 	for rows.Next() {
-		var i Task
+		var i ListTasksRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Content,
@@ -101,14 +131,16 @@ SET
 	content = ?
 WHERE
 	id = ?
+	AND organization_id = ?
 `
 
 type UpdateTaskParams struct {
-	Content string
-	ID      string
+	Content        string
+	ID             string
+	OrganizationID string
 }
 
-// UpdateTask updates task by given id.
+// UpdateTask updates task by given id in given organization.
 func (q *Queries) UpdateTask(ctx context.Context, arg UpdateTaskParams) (sql.Result, error) {
-	return q.db.ExecContext(ctx, updateTask, arg.Content, arg.ID)
+	return q.db.ExecContext(ctx, updateTask, arg.Content, arg.ID, arg.OrganizationID)
 }
@@ -0,0 +1,565 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: tasks_archive.sql
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+)
+
+const copyArchivedTaskToTasks = `-- name: CopyArchivedTaskToTasks :execrows
+INSERT INTO tasks (id, organization_id, owner_id, content, created_at, updated_at)
+SELECT
+	tasks_archive.id,
+	tasks_archive.organization_id,
+	tasks_archive.owner_id,
+	tasks_archive.content,
+	tasks_archive.created_at,
+	CURRENT_TIMESTAMP
+FROM
+	tasks_archive
+WHERE
+	tasks_archive.id = ?
+`
+
+// CopyArchivedTaskToTasks copies archived task of given id back to tasks.
+// Task is updated at unarchiving, so that it is not archived again by the next run.
+func (q *Queries) CopyArchivedTaskToTasks(ctx context.Context, id string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, copyArchivedTaskToTasks, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const copyArchivedTasksToTasks = `-- name: CopyArchivedTasksToTasks :execrows
+INSERT INTO tasks (id, organization_id, owner_id, content, created_at, updated_at)
+SELECT
+	tasks_archive.id,
+	tasks_archive.organization_id,
+	tasks_archive.owner_id,
+	tasks_archive.content,
+	tasks_archive.created_at,
+	CURRENT_TIMESTAMP
+FROM
+	tasks_archive
+WHERE
+	tasks_archive.id IN (/*SLICE:ids*/?)
+`
+
+// CopyArchivedTasksToTasks copies archived tasks of given ids back to tasks.
+// Task is updated at unarchiving, so that it is not archived again by the next run.
+func (q *Queries) CopyArchivedTasksToTasks(ctx context.Context, ids []string) (int64, error) {
+	query := copyArchivedTasksToTasks
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	result, err := q.db.ExecContext(ctx, query, queryParams...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const copyTasksToArchive = `-- name: CopyTasksToArchive :execrows
+INSERT INTO tasks_archive (id, organization_id, owner_id, content, created_at, updated_at)
+SELECT
+	tasks.id,
+	tasks.organization_id,
+	tasks.owner_id,
+	tasks.content,
+	tasks.created_at,
+	tasks.updated_at
+FROM
+	tasks
+WHERE
+	tasks.id IN (/*SLICE:ids*/?)
+`
+
+// CopyTasksToArchive copies tasks of given ids to archive.
+func (q *Queries) CopyTasksToArchive(ctx context.Context, ids []string) (int64, error) {
+	query := copyTasksToArchive
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	result, err := q.db.ExecContext(ctx, query, queryParams...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const copyTasksToArchiveAt = `-- name: CopyTasksToArchiveAt :execrows
+INSERT INTO tasks_archive (id, organization_id, owner_id, content, created_at, updated_at, archived_at)
+SELECT
+	tasks.id,
+	tasks.organization_id,
+	tasks.owner_id,
+	tasks.content,
+	tasks.created_at,
+	tasks.updated_at,
+	?
+FROM
+	tasks
+WHERE
+	tasks.id IN (/*SLICE:ids*/?)
+`
+
+type CopyTasksToArchiveAtParams struct {
+	ArchivedAt time.Time
+	Ids        []string
+}
+
+// CopyTasksToArchiveAt copies tasks of given ids to archive as archived at given time.
+func (q *Queries) CopyTasksToArchiveAt(ctx context.Context, arg CopyTasksToArchiveAtParams) (int64, error) {
+	query := copyTasksToArchiveAt
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.ArchivedAt)
+	if len(arg.Ids) > 0 {
+		for _, v := range arg.Ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(arg.Ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	result, err := q.db.ExecContext(ctx, query, queryParams...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteArchivedTask = `-- name: DeleteArchivedTask :execrows
+DELETE FROM
+	tasks_archive
+WHERE
+	id = ?
+`
+
+// DeleteArchivedTask deletes archived task by given id.
+func (q *Queries) DeleteArchivedTask(ctx context.Context, id string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteArchivedTask, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteArchivedTasks = `-- name: DeleteArchivedTasks :execrows
+DELETE FROM
+	tasks_archive
+WHERE
+	id IN (/*SLICE:ids*/?)
+`
+
+// DeleteArchivedTasks deletes archived tasks of given ids.
+func (q *Queries) DeleteArchivedTasks(ctx context.Context, ids []string) (int64, error) {
+	query := deleteArchivedTasks
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	result, err := q.db.ExecContext(ctx, query, queryParams...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteTasks = `-- name: DeleteTasks :execrows
+DELETE FROM
+	tasks
+WHERE
+	id IN (/*SLICE:ids*/?)
+`
+
+// DeleteTasks deletes tasks of given ids.
+func (q *Queries) DeleteTasks(ctx context.Context, ids []string) (int64, error) {
+	query := deleteTasks
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	result, err := q.db.ExecContext(ctx, query, queryParams...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const findArchivedTaskForUpdate = `-- name: FindArchivedTaskForUpdate :one
+SELECT
+	id, content, created_at, updated_at, archived_at, organization_id, owner_id
+FROM
+	tasks_archive
+WHERE
+	id = ?
+	AND organization_id = ?
+FOR UPDATE
+`
+
+type FindArchivedTaskForUpdateParams struct {
+	ID             string
+	OrganizationID string
+}
+
+// FindArchivedTaskForUpdate finds and locks archived task by given id in given organization.
+func (q *Queries) FindArchivedTaskForUpdate(ctx context.Context, arg FindArchivedTaskForUpdateParams) (TasksArchive, error) {
+	row := q.db.QueryRowContext(ctx, findArchivedTaskForUpdate, arg.ID, arg.OrganizationID)
+	var i TasksArchive
+	err := row.Scan(
+		&i.ID,
+		&i.Content,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ArchivedAt,
+		&i.OrganizationID,
+		&i.OwnerID,
+	)
+	return i, err
+}
+
+const findTaskIncludingArchived = `-- name: FindTaskIncludingArchived :one
+SELECT
+	id,
+	content,
+	created_at,
+	updated_at,
+	FALSE AS archived
+FROM
+	tasks
+WHERE
+	tasks.id = ?
+UNION ALL
+SELECT
+	id,
+	content,
+	created_at,
+	updated_at,
+	TRUE AS archived
+FROM
+	tasks_archive
+WHERE
+	tasks_archive.id = ?
+`
+
+type FindTaskIncludingArchivedParams struct {
+	ID string
+}
+
+type FindTaskIncludingArchivedRow struct {
+	ID        string
+	Content   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Archived  int32
+}
+
+// FindTaskIncludingArchived finds task with given id from both active and archived tasks of all organizations.
+func (q *Queries) FindTaskIncludingArchived(ctx context.Context, arg FindTaskIncludingArchivedParams) (FindTaskIncludingArchivedRow, error) {
+	row := q.db.QueryRowContext(ctx, findTaskIncludingArchived, arg.ID, arg.ID)
+	var i FindTaskIncludingArchivedRow
+	err := row.Scan(
+		&i.ID,
+		&i.Content,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Archived,
+	)
+	return i, err
+}
+
+const listArchivableTaskIDs = `-- name: ListArchivableTaskIDs :many
+SELECT
+	id
+FROM
+	tasks
+WHERE
+	updated_at < ?
+ORDER BY
+	id
+LIMIT ?
+FOR UPDATE SKIP LOCKED
+`
+
+type ListArchivableTaskIDsParams struct {
+	UpdatedAt time.Time
+	Limit     int32
+}
+
+// ListArchivableTaskIDs locks tasks not updated since given time. Tasks locked by other transaction are skipped.
+func (q *Queries) ListArchivableTaskIDs(ctx context.Context, arg ListArchivableTaskIDsParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listArchivableTaskIDs, arg.UpdatedAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOwnedArchivedTaskIDsForUpdate = `-- name: ListOwnedArchivedTaskIDsForUpdate :many
+SELECT
+	id
+FROM
+	tasks_archive
+WHERE
+	owner_id = ?
+	AND archived_at >= ?
+ORDER BY
+	id
+FOR UPDATE
+`
+
+type ListOwnedArchivedTaskIDsForUpdateParams struct {
+	OwnerID    sql.NullString
+	ArchivedAt time.Time
+}
+
+// ListOwnedArchivedTaskIDsForUpdate locks archived tasks of given owner which are archived at or after given time.
+func (q *Queries) ListOwnedArchivedTaskIDsForUpdate(ctx context.Context, arg ListOwnedArchivedTaskIDsForUpdateParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listOwnedArchivedTaskIDsForUpdate, arg.OwnerID, arg.ArchivedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOwnedTaskIDsForUpdate = `-- name: ListOwnedTaskIDsForUpdate :many
+SELECT
+	id
+FROM
+	tasks
+WHERE
+	owner_id = ?
+ORDER BY
+	id
+FOR UPDATE
+`
+
+// ListOwnedTaskIDsForUpdate locks tasks of given owner in all organizations.
+func (q *Queries) ListOwnedTaskIDsForUpdate(ctx context.Context, ownerID sql.NullString) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listOwnedTaskIDsForUpdate, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOwnedTasksIncludingArchived = `-- name: ListOwnedTasksIncludingArchived :many
+SELECT
+	id,
+	content,
+	created_at,
+	updated_at,
+	FALSE AS archived
+FROM
+	tasks
+WHERE
+	tasks.owner_id = ?
+UNION ALL
+SELECT
+	id,
+	content,
+	created_at,
+	updated_at,
+	TRUE AS archived
+FROM
+	tasks_archive
+WHERE
+	tasks_archive.owner_id = ?
+ORDER BY
+	id
+`
+
+type ListOwnedTasksIncludingArchivedParams struct {
+	OwnerID sql.NullString
+}
+
+type ListOwnedTasksIncludingArchivedRow struct {
+	ID        string
+	Content   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Archived  int32
+}
+
+// ListOwnedTasksIncludingArchived finds both active and archived tasks of given owner in all organizations.
+func (q *Queries) ListOwnedTasksIncludingArchived(ctx context.Context, arg ListOwnedTasksIncludingArchivedParams) ([]ListOwnedTasksIncludingArchivedRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOwnedTasksIncludingArchived, arg.OwnerID, arg.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOwnedTasksIncludingArchivedRow
+	for rows.Next() {
+		var i ListOwnedTasksIncludingArchivedRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Content,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Archived,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTasksIncludingArchived = `-- name: ListTasksIncludingArchived :many
+SELECT
+	id,
+	content,
+	created_at,
+	updated_at,
+	FALSE AS archived
+FROM
+	tasks
+WHERE
+	tasks.organization_id = ?
+	AND ('' = ? OR tasks.id <= ?)
+UNION ALL
+SELECT
+	id,
+	content,
+	created_at,
+	updated_at,
+	TRUE AS archived
+FROM
+	tasks_archive
+WHERE
+	tasks_archive.organization_id = ?
+	AND ('' = ? OR tasks_archive.id <= ?)
+ORDER BY
+	id DESC
+LIMIT ?
+`
+
+type ListTasksIncludingArchivedParams struct {
+	OrganizationID string
+	ID             string
+	Limit          int32
+}
+
+type ListTasksIncludingArchivedRow struct {
+	ID        string
+	Content   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Archived  int32
+}
+
+// ListTasksIncludingArchived finds both active and archived tasks of given organization by cursor pagination.
+func (q *Queries) ListTasksIncludingArchived(ctx context.Context, arg ListTasksIncludingArchivedParams) ([]ListTasksIncludingArchivedRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTasksIncludingArchived,
+		arg.OrganizationID,
+		arg.ID,
+		arg.ID,
+		arg.OrganizationID,
+		arg.ID,
+		arg.ID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTasksIncludingArchivedRow
+	for rows.Next() {
+		var i ListTasksIncludingArchivedRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Content,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Archived,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
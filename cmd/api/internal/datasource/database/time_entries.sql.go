@@ -0,0 +1,219 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: time_entries.sql
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+)
+
+const createTimeEntry = `-- name: CreateTimeEntry :exec
+INSERT INTO time_entries (id, task_id, user_id, started_at, ended_at)
+		VALUES(?, ?, ?, ?, ?)
+`
+
+type CreateTimeEntryParams struct {
+	ID        string
+	TaskID    string
+	UserID    []byte
+	StartedAt time.Time
+	EndedAt   sql.NullTime
+}
+
+// CreateTimeEntry inserts given time entry.
+func (q *Queries) CreateTimeEntry(ctx context.Context, arg CreateTimeEntryParams) error {
+	_, err := q.db.ExecContext(ctx, createTimeEntry,
+		arg.ID,
+		arg.TaskID,
+		arg.UserID,
+		arg.StartedAt,
+		arg.EndedAt,
+	)
+	return err
+}
+
+const deleteTimeEntriesOfOwners = `-- name: DeleteTimeEntriesOfOwners :exec
+DELETE FROM
+	time_entries
+WHERE
+	task_id IN (
+		SELECT
+			tasks.id
+		FROM
+			tasks
+		WHERE
+			tasks.owner_id IN (/*SLICE:owner_ids*/?)
+		UNION ALL
+		SELECT
+			tasks_archive.id
+		FROM
+			tasks_archive
+		WHERE
+			tasks_archive.owner_id IN (/*SLICE:archive_owner_ids*/?))
+`
+
+type DeleteTimeEntriesOfOwnersParams struct {
+	OwnerIds        []sql.NullString
+	ArchiveOwnerIds []sql.NullString
+}
+
+// DeleteTimeEntriesOfOwners deletes all time entries on active and archived tasks of given owners.
+// Time entries are not deleted by cascade since task may be in either tasks or tasks_archive.
+func (q *Queries) DeleteTimeEntriesOfOwners(ctx context.Context, arg DeleteTimeEntriesOfOwnersParams) error {
+	query := deleteTimeEntriesOfOwners
+	var queryParams []interface{}
+	if len(arg.OwnerIds) > 0 {
+		for _, v := range arg.OwnerIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:owner_ids*/?", strings.Repeat(",?", len(arg.OwnerIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:owner_ids*/?", "NULL", 1)
+	}
+	if len(arg.ArchiveOwnerIds) > 0 {
+		for _, v := range arg.ArchiveOwnerIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:archive_owner_ids*/?", strings.Repeat(",?", len(arg.ArchiveOwnerIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:archive_owner_ids*/?", "NULL", 1)
+	}
+	_, err := q.db.ExecContext(ctx, query, queryParams...)
+	return err
+}
+
+const findRunningTimeEntry = `-- name: FindRunningTimeEntry :one
+SELECT
+	id,
+	task_id,
+	user_id,
+	started_at,
+	ended_at,
+	created_at,
+	updated_at
+FROM
+	time_entries
+WHERE
+	user_id = ?
+	AND ended_at IS NULL
+LIMIT 1
+`
+
+// FindRunningTimeEntry finds time entry of running timer of given user.
+func (q *Queries) FindRunningTimeEntry(ctx context.Context, userID []byte) (TimeEntry, error) {
+	row := q.db.QueryRowContext(ctx, findRunningTimeEntry, userID)
+	var i TimeEntry
+	err := row.Scan(
+		&i.ID,
+		&i.TaskID,
+		&i.UserID,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listTimeEntriesInRange = `-- name: ListTimeEntriesInRange :many
+SELECT
+	id,
+	task_id,
+	user_id,
+	started_at,
+	ended_at,
+	created_at,
+	updated_at
+FROM
+	time_entries
+WHERE
+	user_id = ?
+	AND started_at < ?
+	AND (ended_at IS NULL OR ended_at > ?)
+ORDER BY
+	started_at
+`
+
+type ListTimeEntriesInRangeParams struct {
+	UserID []byte
+	To     time.Time
+	From   sql.NullTime
+}
+
+// ListTimeEntriesInRange finds time entries of given user overlapping given range.
+func (q *Queries) ListTimeEntriesInRange(ctx context.Context, arg ListTimeEntriesInRangeParams) ([]TimeEntry, error) {
+	rows, err := q.db.QueryContext(ctx, listTimeEntriesInRange, arg.UserID, arg.To, arg.From)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TimeEntry
+	for rows.Next() {
+		var i TimeEntry
+		if err := rows.Scan(
+			&i.ID,
+			&i.TaskID,
+			&i.UserID,
+			&i.StartedAt,
+			&i.EndedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockUser = `-- name: LockUser :one
+SELECT
+	id
+FROM
+	users
+WHERE
+	id = ?
+FOR UPDATE
+`
+
+// LockUser locks user row of given id until end of transaction.
+func (q *Queries) LockUser(ctx context.Context, id []byte) ([]byte, error) {
+	row := q.db.QueryRowContext(ctx, lockUser, id)
+	var id_2 []byte
+	err := row.Scan(&id_2)
+	return id_2, err
+}
+
+const updateTimeEntry = `-- name: UpdateTimeEntry :execrows
+UPDATE
+	time_entries
+SET
+	started_at = ?,
+	ended_at = ?
+WHERE
+	id = ?
+`
+
+type UpdateTimeEntryParams struct {
+	StartedAt time.Time
+	EndedAt   sql.NullTime
+	ID        string
+}
+
+// UpdateTimeEntry updates period of time entry by given id.
+func (q *Queries) UpdateTimeEntry(ctx context.Context, arg UpdateTimeEntryParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateTimeEntry, arg.StartedAt, arg.EndedAt, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,112 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: user_exports.sql
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+)
+
+const createUserExport = `-- name: CreateUserExport :execrows
+INSERT INTO
+	user_exports (
+		id,
+		user_id,
+		status
+	)
+VALUES
+	(?, ?, ?)
+`
+
+type CreateUserExportParams struct {
+	ID     string
+	UserID []byte
+	Status string
+}
+
+// CreateUserExport inserts given user export.
+func (q *Queries) CreateUserExport(ctx context.Context, arg CreateUserExportParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createUserExport, arg.ID, arg.UserID, arg.Status)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const findPendingUserExportForUpdate = `-- name: FindPendingUserExportForUpdate :one
+SELECT
+	id, user_id, status, content, created_at, updated_at
+FROM
+	user_exports
+WHERE
+	status = 'pending'
+ORDER BY
+	id
+LIMIT 1
+FOR UPDATE SKIP LOCKED
+`
+
+// FindPendingUserExportForUpdate finds and locks oldest pending user export. Exports locked by other transaction are skipped.
+func (q *Queries) FindPendingUserExportForUpdate(ctx context.Context) (UserExport, error) {
+	row := q.db.QueryRowContext(ctx, findPendingUserExportForUpdate)
+	var i UserExport
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Status,
+		&i.Content,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findUserExport = `-- name: FindUserExport :one
+SELECT
+	id, user_id, status, content, created_at, updated_at
+FROM
+	user_exports
+WHERE
+	id = ?
+`
+
+// FindUserExport finds user export by given id.
+func (q *Queries) FindUserExport(ctx context.Context, id string) (UserExport, error) {
+	row := q.db.QueryRowContext(ctx, findUserExport, id)
+	var i UserExport
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Status,
+		&i.Content,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateUserExport = `-- name: UpdateUserExport :execrows
+UPDATE
+	user_exports
+SET
+	status = ?,
+	content = ?
+WHERE
+	id = ?
+`
+
+type UpdateUserExportParams struct {
+	Status  string
+	Content json.RawMessage
+	ID      string
+}
+
+// UpdateUserExport updates status and content of user export with given id.
+func (q *Queries) UpdateUserExport(ctx context.Context, arg UpdateUserExportParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateUserExport, arg.Status, arg.Content, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
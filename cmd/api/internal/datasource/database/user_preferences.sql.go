@@ -0,0 +1,75 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: user_preferences.sql
+
+package database
+
+import (
+	"context"
+	"time"
+)
+
+const findUserPreferences = `-- name: FindUserPreferences :one
+SELECT
+	user_id, timezone, locale, page_size, week_start, updated_at
+FROM
+	user_preferences
+WHERE
+	user_id = ?
+`
+
+// FindUserPreferences finds preferences of given user.
+func (q *Queries) FindUserPreferences(ctx context.Context, userID []byte) (UserPreference, error) {
+	row := q.db.QueryRowContext(ctx, findUserPreferences, userID)
+	var i UserPreference
+	err := row.Scan(
+		&i.UserID,
+		&i.Timezone,
+		&i.Locale,
+		&i.PageSize,
+		&i.WeekStart,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const saveUserPreferences = `-- name: SaveUserPreferences :exec
+INSERT INTO
+	user_preferences (
+		user_id,
+		timezone,
+		locale,
+		page_size,
+		week_start,
+		updated_at
+	)
+VALUES
+	(?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+	timezone = VALUES(timezone),
+	locale = VALUES(locale),
+	page_size = VALUES(page_size),
+	week_start = VALUES(week_start),
+	updated_at = VALUES(updated_at)
+`
+
+type SaveUserPreferencesParams struct {
+	UserID    []byte
+	Timezone  string
+	Locale    string
+	PageSize  int32
+	WeekStart int8
+	UpdatedAt time.Time
+}
+
+// SaveUserPreferences inserts or updates preferences of given user.
+func (q *Queries) SaveUserPreferences(ctx context.Context, arg SaveUserPreferencesParams) error {
+	_, err := q.db.ExecContext(ctx, saveUserPreferences,
+		arg.UserID,
+		arg.Timezone,
+		arg.Locale,
+		arg.PageSize,
+		arg.WeekStart,
+		arg.UpdatedAt,
+	)
+	return err
+}
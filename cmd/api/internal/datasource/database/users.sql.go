@@ -5,6 +5,8 @@ package database
 
 import (
 	"context"
+	"database/sql"
+	"strings"
 )
 
 const createUser = `-- name: CreateUser :execrows
@@ -46,6 +48,71 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (int64,
 	return result.RowsAffected()
 }
 
+const deleteUsers = `-- name: DeleteUsers :execrows
+DELETE FROM
+	users
+WHERE
+	id IN (/*SLICE:ids*/?)
+`
+
+// DeleteUsers deletes users of given ids. Rows owned by users are deleted by cascade.
+func (q *Queries) DeleteUsers(ctx context.Context, ids [][]byte) (int64, error) {
+	query := deleteUsers
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	result, err := q.db.ExecContext(ctx, query, queryParams...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const findUserByID = `-- name: FindUserByID :one
+SELECT
+	id,
+	sub,
+	given_name,
+	family_name,
+	email,
+	email_verified,
+	created_at,
+	updated_at,
+	deleted_at,
+	role,
+	disabled_at
+FROM
+	users
+WHERE
+	id = ?
+`
+
+// FindUserByID finds user with given id.
+func (q *Queries) FindUserByID(ctx context.Context, id []byte) (User, error) {
+	row := q.db.QueryRowContext(ctx, findUserByID, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Sub,
+		&i.GivenName,
+		&i.FamilyName,
+		&i.Email,
+		&i.EmailVerified,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Role,
+		&i.DisabledAt,
+	)
+	return i, err
+}
+
 const findUserBySub = `-- name: FindUserBySub :one
 SELECT
 	id,
@@ -55,7 +122,10 @@ SELECT
 	email,
 	email_verified,
 	created_at,
-	updated_at
+	updated_at,
+	deleted_at,
+	role,
+	disabled_at
 FROM
 	users
 WHERE
@@ -75,6 +145,277 @@ func (q *Queries) FindUserBySub(ctx context.Context, sub string) (User, error) {
 		&i.EmailVerified,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Role,
+		&i.DisabledAt,
 	)
 	return i, err
 }
+
+const findUserBySubForUpdate = `-- name: FindUserBySubForUpdate :one
+SELECT
+	id,
+	sub,
+	given_name,
+	family_name,
+	email,
+	email_verified,
+	created_at,
+	updated_at,
+	deleted_at,
+	role,
+	disabled_at
+FROM
+	users
+WHERE
+	sub = ?
+FOR UPDATE
+`
+
+// FindUserBySubForUpdate finds and locks user with given sub(jwt subject).
+func (q *Queries) FindUserBySubForUpdate(ctx context.Context, sub string) (User, error) {
+	row := q.db.QueryRowContext(ctx, findUserBySubForUpdate, sub)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Sub,
+		&i.GivenName,
+		&i.FamilyName,
+		&i.Email,
+		&i.EmailVerified,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Role,
+		&i.DisabledAt,
+	)
+	return i, err
+}
+
+const listPurgeableUserIDs = `-- name: ListPurgeableUserIDs :many
+SELECT
+	id
+FROM
+	users
+WHERE
+	deleted_at < ?
+ORDER BY
+	id
+LIMIT ?
+FOR UPDATE SKIP LOCKED
+`
+
+type ListPurgeableUserIDsParams struct {
+	DeletedAt sql.NullTime
+	Limit     int32
+}
+
+// ListPurgeableUserIDs locks users who requested deletion before given time. Users locked by other transaction are skipped.
+func (q *Queries) ListPurgeableUserIDs(ctx context.Context, arg ListPurgeableUserIDsParams) ([][]byte, error) {
+	rows, err := q.db.QueryContext(ctx, listPurgeableUserIDs, arg.DeletedAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items [][]byte
+	for rows.Next() {
+		var id []byte
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersByEmails = `-- name: ListUsersByEmails :many
+SELECT
+	id,
+	sub,
+	given_name,
+	family_name,
+	email,
+	email_verified,
+	created_at,
+	updated_at,
+	deleted_at,
+	role,
+	disabled_at
+FROM
+	users
+WHERE
+	email IN (/*SLICE:emails*/?)
+	AND deleted_at IS NULL
+`
+
+// ListUsersByEmails finds users with given emails.
+func (q *Queries) ListUsersByEmails(ctx context.Context, emails []string) ([]User, error) {
+	query := listUsersByEmails
+	var queryParams []interface{}
+	if len(emails) > 0 {
+		for _, v := range emails {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:emails*/?", strings.Repeat(",?", len(emails))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:emails*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Sub,
+			&i.GivenName,
+			&i.FamilyName,
+			&i.Email,
+			&i.EmailVerified,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Role,
+			&i.DisabledAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchUsers = `-- name: SearchUsers :many
+SELECT
+	id,
+	sub,
+	given_name,
+	family_name,
+	email,
+	email_verified,
+	created_at,
+	updated_at,
+	deleted_at,
+	role,
+	disabled_at
+FROM
+	users
+WHERE
+	('' = ? OR id <= ?)
+	AND (
+		email LIKE ?
+		OR given_name LIKE ?
+		OR family_name LIKE ?
+	)
+ORDER BY
+	id DESC
+LIMIT ?
+`
+
+type SearchUsersParams struct {
+	ID      []byte
+	Pattern string
+	Limit   int32
+}
+
+// SearchUsers finds users whose email, given name or family name matches given LIKE pattern by cursor pagination.
+func (q *Queries) SearchUsers(ctx context.Context, arg SearchUsersParams) ([]User, error) {
+	rows, err := q.db.QueryContext(ctx, searchUsers,
+		arg.ID,
+		arg.ID,
+		arg.Pattern,
+		arg.Pattern,
+		arg.Pattern,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Sub,
+			&i.GivenName,
+			&i.FamilyName,
+			&i.Email,
+			&i.EmailVerified,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Role,
+			&i.DisabledAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateUser = `-- name: UpdateUser :execrows
+UPDATE
+	users
+SET
+	given_name = ?,
+	family_name = ?,
+	email = ?,
+	email_verified = ?,
+	deleted_at = ?,
+	role = ?,
+	disabled_at = ?
+WHERE
+	id = ?
+`
+
+type UpdateUserParams struct {
+	GivenName     string
+	FamilyName    string
+	Email         string
+	EmailVerified bool
+	DeletedAt     sql.NullTime
+	Role          string
+	DisabledAt    sql.NullTime
+	ID            []byte
+}
+
+// UpdateUser updates user with given id.
+func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateUser,
+		arg.GivenName,
+		arg.FamilyName,
+		arg.Email,
+		arg.EmailVerified,
+		arg.DeletedAt,
+		arg.Role,
+		arg.DisabledAt,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
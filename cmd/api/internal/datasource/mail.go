@@ -0,0 +1,37 @@
+package datasource
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/repository"
+	"go-playground/pkg/apperr"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// MailSender sends mail such as SMTPSender of go-playground/pkg/mail.
+type MailSender interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// MailAdaptor is implementation of [repository.MailRepository].
+type MailAdaptor struct {
+	sender MailSender
+}
+
+// NewMailAdaptor creates MailAdaptor.
+func NewMailAdaptor(sender MailSender) *MailAdaptor {
+	return &MailAdaptor{sender: sender}
+}
+
+// Send sends plain text mail to given address.
+func (a *MailAdaptor) Send(ctx context.Context, to, subject, body string) error {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/MailAdaptor/Send").End()
+
+	err := a.sender.Send(ctx, to, subject, body)
+	if err != nil {
+		return apperr.New("send mail", "failed to send mail", apperr.WithCause(err))
+	}
+	return nil
+}
+
+var _ repository.MailRepository = (*MailAdaptor)(nil)
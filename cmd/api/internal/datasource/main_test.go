@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"go-playground/cmd/api/internal/datasource"
+	"go-playground/pkg/ctxhelper"
 	"go-playground/pkg/migration"
 	"testing"
 	"time"
@@ -17,6 +18,13 @@ import (
 
 var db *sqlx.DB
 
+const (
+	// testOrganizationID is organization owning task fixtures.
+	testOrganizationID = "0194c3a1-0000-7000-8000-000000000001"
+	// testOtherOrganizationID is organization owning no task fixtures.
+	testOtherOrganizationID = "0194c3a1-0000-7000-8000-000000000002"
+)
+
 func TestMain(m *testing.M) {
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
 	defer cancel()
@@ -107,6 +115,7 @@ func prepareFixture(db *sql.DB) error {
 	return nil
 }
 
+// runInTx runs target in transaction rolled back at the end. [testOrganizationID] is active in context.
 func runInTx(t *testing.T, target func(context.Context)) {
 	t.Helper()
 
@@ -121,5 +130,5 @@ func runInTx(t *testing.T, target func(context.Context)) {
 		}
 	}()
 	ctx := context.WithValue(context.Background(), datasource.TransactionContextKey{}, txx)
-	target(ctx)
+	target(ctxhelper.WithOrganizationID(ctx, testOrganizationID))
 }
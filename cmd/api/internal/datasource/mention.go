@@ -0,0 +1,70 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"go-playground/cmd/api/internal/datasource/database"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/domain/repository"
+	"go-playground/pkg/apperr"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// MentionAdaptor is implementation of [repository.MentionRepository].
+type MentionAdaptor struct {
+	base
+}
+
+// NewMentionAdaptor initializes MentionAdaptor.
+func NewMentionAdaptor(db *sqlx.DB) *MentionAdaptor {
+	return &MentionAdaptor{base: base{db: db}}
+}
+
+// ReplaceTaskMentions deletes mentions of given task and inserts given users as new mentions.
+// This should be called in transaction.
+func (a *MentionAdaptor) ReplaceTaskMentions(ctx context.Context, taskID entity.TaskID, userIDs []uuid.UUID) error {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/MentionAdaptor/ReplaceTaskMentions").End()
+
+	queries := a.queriesFromContext(ctx)
+	err := queries.DeleteTaskMentions(ctx, taskID)
+	if err != nil {
+		return apperr.New(fmt.Sprintf("delete mentions of task %q", taskID), "failed to save mentions", apperr.WithCause(err))
+	}
+	for _, uid := range userIDs {
+		err = queries.CreateTaskMention(ctx, database.CreateTaskMentionParams{TaskID: taskID, UserID: uid[:]})
+		if err != nil {
+			return apperr.New(fmt.Sprintf("create mention of user %q in task %q", uid, taskID), "failed to save mentions", apperr.WithCause(err))
+		}
+	}
+	return nil
+}
+
+// ListMentionedTasks lists tasks of active organization mentioning given user.
+func (a *MentionAdaptor) ListMentionedTasks(ctx context.Context, userID uuid.UUID, next entity.TaskID, limit int32) (entity.Page[entity.Task], error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/MentionAdaptor/ListMentionedTasks").End()
+
+	orgID, err := a.organizationFromContext(ctx)
+	if err != nil {
+		return entity.Page[entity.Task]{}, err
+	}
+	queries := a.queriesFromContext(ctx)
+	rows, err := queries.ListMentionedTasks(ctx, database.ListMentionedTasksParams{UserID: userID[:], OrganizationID: orgID, ID: next, Limit: limit + 1})
+	if err != nil {
+		return entity.Page[entity.Task]{}, apperr.New(fmt.Sprintf("list tasks mentioning user %q", userID), "failed to list mentions", apperr.WithCause(err))
+	}
+	tasks := make([]entity.Task, len(rows))
+	for i, r := range rows {
+		tasks[i] = entity.Task{
+			ID:        r.ID,
+			Content:   r.Content,
+			CreatedAt: r.CreatedAt,
+			UpdatedAt: r.UpdatedAt,
+		}
+	}
+	return entity.NewPage(tasks, limit)
+}
+
+var _ repository.MentionRepository = (*MentionAdaptor)(nil)
@@ -0,0 +1,101 @@
+package datasource_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/datasource"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/pkg/ctxhelper"
+	"go-playground/pkg/testhelper"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMentionAdaptor_ListMentionedTasks(t *testing.T) {
+	type input struct {
+		userID uuid.UUID
+		orgID  string
+		next   string
+		limit  int32
+	}
+	type want struct {
+		page entity.Page[entity.Task]
+	}
+	tests := map[string]struct {
+		input input
+		want  want
+	}{
+		"next is empty": {
+			input: input{userID: testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812"), limit: 1},
+			want: want{page: entity.Page[entity.Task]{
+				Items: []entity.Task{
+					{
+						ID:        "019102ca-b58b-7b46-8e27-d63485a70574",
+						Content:   "this is test 3",
+						CreatedAt: time.Date(2024, 7, 30, 17, 38, 44, 0, time.UTC),
+						UpdatedAt: time.Date(2024, 7, 30, 17, 38, 44, 0, time.UTC),
+					},
+				},
+				HasNext:   true,
+				NextToken: "eyJpZCI6IjAxOTBmZTU5LTY2MTgtNzgxMS04YjI4LWEzZTY3OTY5YTRlZiJ9",
+			}},
+		},
+		"no next": {
+			input: input{userID: testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812"), next: "0190fe59-6618-7811-8b28-a3e67969a4ef", limit: 1},
+			want: want{page: entity.Page[entity.Task]{
+				Items: []entity.Task{
+					{
+						ID:        "0190fe59-6618-7811-8b28-a3e67969a4ef",
+						Content:   "this is test 1",
+						CreatedAt: time.Date(2024, 7, 29, 20, 56, 30, 0, time.UTC),
+						UpdatedAt: time.Date(2024, 7, 29, 20, 56, 30, 0, time.UTC),
+					},
+				},
+			}},
+		},
+		"no result": {
+			input: input{userID: testhelper.UUIDFromString(t, "00000000-0000-7000-8000-000000000000"), limit: 1},
+			want:  want{page: entity.Page[entity.Task]{Items: []entity.Task{}}},
+		},
+		"no result in other organization": {
+			input: input{userID: testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812"), orgID: testOtherOrganizationID, limit: 1},
+			want:  want{page: entity.Page[entity.Task]{Items: []entity.Task{}}},
+		},
+	}
+	adaptor := datasource.NewMentionAdaptor(db)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			runInTx(t, func(ctx context.Context) {
+				if tc.input.orgID != "" {
+					ctx = ctxhelper.WithOrganizationID(ctx, tc.input.orgID)
+				}
+				got, err := adaptor.ListMentionedTasks(ctx, tc.input.userID, tc.input.next, tc.input.limit)
+
+				require.NoError(t, err)
+				assert.Equal(t, tc.want.page, got)
+			})
+		})
+	}
+}
+
+func TestMentionAdaptor_ReplaceTaskMentions(t *testing.T) {
+	uid := testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812")
+	adaptor := datasource.NewMentionAdaptor(db)
+	runInTx(t, func(ctx context.Context) {
+		err := adaptor.ReplaceTaskMentions(ctx, "0190fe5b-1f83-7024-a233-c8a18935f5dc", []uuid.UUID{uid})
+		require.NoError(t, err)
+		err = adaptor.ReplaceTaskMentions(ctx, "0190fe59-6618-7811-8b28-a3e67969a4ef", nil)
+		require.NoError(t, err)
+
+		got, err := adaptor.ListMentionedTasks(ctx, uid, "", 10)
+		require.NoError(t, err)
+		ids := make([]string, len(got.Items))
+		for i, task := range got.Items {
+			ids[i] = task.ID
+		}
+		assert.Equal(t, []string{"019102ca-b58b-7b46-8e27-d63485a70574", "0190fe5b-1f83-7024-a233-c8a18935f5dc"}, ids)
+	})
+}
@@ -0,0 +1,160 @@
+package datasource
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"go-playground/cmd/api/internal/datasource/database"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/domain/repository"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// OrganizationAdaptor is implementation of [repository.OrganizationRepository].
+//
+// Organizations are tenancy boundary itself, so queries are not scoped by active organization.
+type OrganizationAdaptor struct {
+	base
+}
+
+// NewOrganizationAdaptor creates OrganizationAdaptor.
+func NewOrganizationAdaptor(db *sqlx.DB) *OrganizationAdaptor {
+	return &OrganizationAdaptor{base: base{db: db}}
+}
+
+// Create inserts given organization.
+func (a *OrganizationAdaptor) Create(ctx context.Context, org entity.Organization) error {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/OrganizationAdaptor/Create").End()
+
+	queries := a.queriesFromContext(ctx)
+	err := queries.CreateOrganization(ctx, database.CreateOrganizationParams{
+		ID:        org.ID,
+		Name:      org.Name,
+		CreatedAt: org.CreatedAt,
+		UpdatedAt: org.UpdatedAt,
+	})
+	if err != nil {
+		return apperr.New(fmt.Sprintf("create organization %q", org.ID), "failed to create organization", apperr.WithCause(err))
+	}
+	return nil
+}
+
+// ListByUserID lists organizations which given user belongs to.
+func (a *OrganizationAdaptor) ListByUserID(ctx context.Context, userID uuid.UUID) ([]entity.Organization, error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/OrganizationAdaptor/ListByUserID").End()
+
+	queries := a.queriesFromContext(ctx)
+	rows, err := queries.ListOrganizationsByUserID(ctx, userID[:])
+	if err != nil {
+		return nil, apperr.New(fmt.Sprintf("list organizations of user %q", userID), "failed to list organizations", apperr.WithCause(err))
+	}
+	orgs := make([]entity.Organization, len(rows))
+	for i, row := range rows {
+		orgs[i] = entity.Organization{
+			ID:        row.ID,
+			Name:      row.Name,
+			CreatedAt: row.CreatedAt,
+			UpdatedAt: row.UpdatedAt,
+		}
+	}
+	return orgs, nil
+}
+
+// AddMember inserts given member of organization.
+func (a *OrganizationAdaptor) AddMember(ctx context.Context, member entity.OrganizationMember) error {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/OrganizationAdaptor/AddMember").End()
+
+	queries := a.queriesFromContext(ctx)
+	err := queries.CreateOrganizationMember(ctx, database.CreateOrganizationMemberParams{
+		OrganizationID: member.OrganizationID,
+		UserID:         member.UserID[:],
+		Role:           string(member.Role),
+		CreatedAt:      member.CreatedAt,
+	})
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 /* duplicate entry */ {
+			return apperr.New(
+				fmt.Sprintf("add user %q to organization %q but user is already member", member.UserID, member.OrganizationID),
+				"User is already a member",
+				apperr.WithMessageID(message.AlreadyMember),
+				apperr.WithCause(err),
+				apperr.CodeAlreadyExists,
+			)
+		}
+		return apperr.New(fmt.Sprintf("add user %q to organization %q", member.UserID, member.OrganizationID), "failed to add member", apperr.WithCause(err))
+	}
+	return nil
+}
+
+// FindMember finds member of given user in given organization.
+func (a *OrganizationAdaptor) FindMember(ctx context.Context, orgID entity.OrganizationID, userID uuid.UUID) (entity.OrganizationMember, error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/OrganizationAdaptor/FindMember").End()
+
+	queries := a.queriesFromContext(ctx)
+	row, err := queries.FindOrganizationMember(ctx, database.FindOrganizationMemberParams{OrganizationID: orgID, UserID: userID[:]})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.OrganizationMember{}, apperr.New(fmt.Sprintf("find user %q in organization %q", userID, orgID), "Member not found", apperr.WithMessageID(message.MemberNotFound), apperr.WithCause(err), apperr.CodeNotFound)
+		}
+		return entity.OrganizationMember{}, apperr.New(fmt.Sprintf("find user %q in organization %q", userID, orgID), "failed to find member", apperr.WithCause(err))
+	}
+	return toOrganizationMember(row)
+}
+
+// ListMembers lists members of given organization.
+func (a *OrganizationAdaptor) ListMembers(ctx context.Context, orgID entity.OrganizationID) ([]entity.OrganizationMember, error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/OrganizationAdaptor/ListMembers").End()
+
+	queries := a.queriesFromContext(ctx)
+	rows, err := queries.ListOrganizationMembers(ctx, orgID)
+	if err != nil {
+		return nil, apperr.New(fmt.Sprintf("list members of organization %q", orgID), "failed to list members", apperr.WithCause(err))
+	}
+	members := make([]entity.OrganizationMember, len(rows))
+	for i, row := range rows {
+		member, err := toOrganizationMember(row)
+		if err != nil {
+			return nil, err
+		}
+		members[i] = member
+	}
+	return members, nil
+}
+
+// RemoveMember deletes given user from given organization.
+func (a *OrganizationAdaptor) RemoveMember(ctx context.Context, orgID entity.OrganizationID, userID uuid.UUID) error {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/OrganizationAdaptor/RemoveMember").End()
+
+	queries := a.queriesFromContext(ctx)
+	n, err := queries.DeleteOrganizationMember(ctx, database.DeleteOrganizationMemberParams{OrganizationID: orgID, UserID: userID[:]})
+	if err != nil {
+		return apperr.New(fmt.Sprintf("remove user %q from organization %q", userID, orgID), "failed to remove member", apperr.WithCause(err))
+	}
+	if n == 0 {
+		return apperr.New(fmt.Sprintf("remove user %q from organization %q but user is not member", userID, orgID), "Member not found", apperr.WithMessageID(message.MemberNotFound), apperr.CodeNotFound)
+	}
+	return nil
+}
+
+func toOrganizationMember(row database.OrganizationMember) (entity.OrganizationMember, error) {
+	uid, err := uuid.FromBytes(row.UserID)
+	if err != nil {
+		return entity.OrganizationMember{}, apperr.New(fmt.Sprintf("raw user id of member of organization %q to uuid", row.OrganizationID), "failed to find member", apperr.WithCause(err))
+	}
+	return entity.OrganizationMember{
+		OrganizationID: row.OrganizationID,
+		UserID:         uid,
+		Role:           entity.OrganizationRole(row.Role),
+		CreatedAt:      row.CreatedAt,
+	}, nil
+}
+
+var _ repository.OrganizationRepository = (*OrganizationAdaptor)(nil)
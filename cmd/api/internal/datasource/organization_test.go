@@ -0,0 +1,82 @@
+package datasource_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/datasource"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/testhelper"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationAdaptor(t *testing.T) {
+	owner := testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812")
+	member := testhelper.UUIDFromString(t, "01930c3c-e82b-700a-b41a-6f58b5c2b814")
+	now := time.Now().UTC().Truncate(time.Second)
+	adaptor := datasource.NewOrganizationAdaptor(db)
+	runInTx(t, func(ctx context.Context) {
+		org, err := entity.NewOrganization("Platform team", now)
+		require.NoError(t, err)
+		require.NoError(t, adaptor.Create(ctx, org))
+		ownership := entity.NewOrganizationOwner(org, owner)
+		require.NoError(t, adaptor.AddMember(ctx, ownership))
+		membership, err := ownership.Invite(member, entity.OrganizationRoleMember, now.Add(time.Minute))
+		require.NoError(t, err)
+		require.NoError(t, adaptor.AddMember(ctx, membership))
+
+		err = adaptor.AddMember(ctx, membership)
+		assert.True(t, apperr.IsCode(err, apperr.CodeInvalidArgument))
+
+		orgs, err := adaptor.ListByUserID(ctx, owner)
+		require.NoError(t, err)
+		assert.Equal(t, []entity.Organization{
+			{ID: testOrganizationID, Name: "Fixture", CreatedAt: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)},
+			org,
+		}, orgs)
+
+		got, err := adaptor.FindMember(ctx, org.ID, member)
+		require.NoError(t, err)
+		assert.Equal(t, membership, got)
+
+		members, err := adaptor.ListMembers(ctx, org.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []entity.OrganizationMember{ownership, membership}, members)
+
+		require.NoError(t, adaptor.RemoveMember(ctx, org.ID, member))
+		_, err = adaptor.FindMember(ctx, org.ID, member)
+		assert.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+		err = adaptor.RemoveMember(ctx, org.ID, member)
+		assert.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+	})
+}
+
+func TestOrganizationAdaptor_FindMember(t *testing.T) {
+	adaptor := datasource.NewOrganizationAdaptor(db)
+	runInTx(t, func(ctx context.Context) {
+		got, err := adaptor.FindMember(ctx, testOrganizationID, testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812"))
+		require.NoError(t, err)
+		assert.Equal(t, entity.OrganizationRoleOwner, got.Role)
+
+		_, err = adaptor.FindMember(ctx, testOtherOrganizationID, testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812"))
+		assert.EqualError(t, err, `find user "01930c3a-e82b-700a-b41a-6f58b5c2b812" in organization "0194c3a1-0000-7000-8000-000000000002": sql: no rows in result set`)
+		assert.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+	})
+}
+
+func TestOrganization_DeleteWithTasks(t *testing.T) {
+	runInTx(t, func(ctx context.Context) {
+		tx := ctx.Value(datasource.TransactionContextKey{}).(*sqlx.Tx)
+
+		_, err := tx.ExecContext(ctx, "DELETE FROM organizations WHERE id = ?", testOrganizationID)
+
+		var mysqlErr *mysql.MySQLError
+		require.ErrorAs(t, err, &mysqlErr)
+		assert.Equal(t, uint16(1451), mysqlErr.Number, "organization owning tasks must not be deleted not to leave rows referring them")
+	})
+}
@@ -0,0 +1,132 @@
+package datasource
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"go-playground/cmd/api/internal/datasource/database"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/domain/repository"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// PersonalAccessTokenAdaptor is implementation of [repository.PersonalAccessTokenRepository].
+type PersonalAccessTokenAdaptor struct {
+	base
+}
+
+// NewPersonalAccessTokenAdaptor creates PersonalAccessTokenAdaptor.
+func NewPersonalAccessTokenAdaptor(db *sqlx.DB) *PersonalAccessTokenAdaptor {
+	return &PersonalAccessTokenAdaptor{base: base{db: db}}
+}
+
+// Create inserts given personal access token.
+func (a *PersonalAccessTokenAdaptor) Create(ctx context.Context, token entity.PersonalAccessToken) error {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/PersonalAccessTokenAdaptor/Create").End()
+
+	queries := a.queriesFromContext(ctx)
+	_, err := queries.CreatePersonalAccessToken(ctx, database.CreatePersonalAccessTokenParams{
+		ID:        token.ID,
+		UserID:    token.UserID[:],
+		Name:      token.Name,
+		TokenHash: token.Hash,
+		Scopes:    strings.Join(token.Scopes, " "),
+		ExpiresAt: token.ExpiresAt,
+		CreatedAt: token.CreatedAt,
+	})
+	if err != nil {
+		return apperr.New(fmt.Sprintf("create personal access token %q", token.ID), "failed to create token", apperr.WithCause(err))
+	}
+	return nil
+}
+
+// ListByUserID lists personal access tokens of given user.
+func (a *PersonalAccessTokenAdaptor) ListByUserID(ctx context.Context, userID uuid.UUID) ([]entity.PersonalAccessToken, error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/PersonalAccessTokenAdaptor/ListByUserID").End()
+
+	queries := a.queriesFromContext(ctx)
+	rows, err := queries.ListPersonalAccessTokensByUserID(ctx, userID[:])
+	if err != nil {
+		return nil, apperr.New(fmt.Sprintf("list personal access tokens of user %q", userID), "failed to list tokens", apperr.WithCause(err))
+	}
+	tokens := make([]entity.PersonalAccessToken, len(rows))
+	for i, row := range rows {
+		token, err := toPersonalAccessToken(row)
+		if err != nil {
+			return nil, err
+		}
+		tokens[i] = token
+	}
+	return tokens, nil
+}
+
+// FindByIDForUpdate finds and locks personal access token by given id.
+func (a *PersonalAccessTokenAdaptor) FindByIDForUpdate(ctx context.Context, id entity.PersonalAccessTokenID) (entity.PersonalAccessToken, error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/PersonalAccessTokenAdaptor/FindByIDForUpdate").End()
+
+	queries := a.queriesFromContext(ctx)
+	row, err := queries.FindPersonalAccessTokenForUpdate(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.PersonalAccessToken{}, apperr.New(fmt.Sprintf("find personal access token by id %q for update", id), "Token not found", apperr.WithMessageID(message.TokenNotFound), apperr.WithCause(err), apperr.CodeNotFound)
+		}
+		return entity.PersonalAccessToken{}, apperr.New(fmt.Sprintf("find personal access token by id %q for update", id), "failed to find token", apperr.WithCause(err))
+	}
+	return toPersonalAccessToken(row)
+}
+
+// FindByHash finds personal access token by hash of raw token.
+func (a *PersonalAccessTokenAdaptor) FindByHash(ctx context.Context, hash []byte) (entity.PersonalAccessToken, error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/PersonalAccessTokenAdaptor/FindByHash").End()
+
+	queries := a.queriesFromContext(ctx)
+	row, err := queries.FindPersonalAccessTokenByHash(ctx, hash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.PersonalAccessToken{}, apperr.New("find personal access token by hash but result set is zero", "Invalid token", apperr.WithMessageID(message.InvalidToken), apperr.WithCause(err), apperr.WithoutStackTrace(), apperr.CodeNotFound)
+		}
+		return entity.PersonalAccessToken{}, apperr.New("find personal access token by hash", "failed to find token", apperr.WithCause(err))
+	}
+	return toPersonalAccessToken(row)
+}
+
+// Update updates revocation of given personal access token.
+func (a *PersonalAccessTokenAdaptor) Update(ctx context.Context, token entity.PersonalAccessToken) error {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/PersonalAccessTokenAdaptor/Update").End()
+
+	queries := a.queriesFromContext(ctx)
+	_, err := queries.RevokePersonalAccessToken(ctx, database.RevokePersonalAccessTokenParams{
+		RevokedAt: toNullTime(token.RevokedAt),
+		ID:        token.ID,
+	})
+	if err != nil {
+		return apperr.New(fmt.Sprintf("update personal access token %q", token.ID), "failed to update token", apperr.WithCause(err))
+	}
+	return nil
+}
+
+func toPersonalAccessToken(row database.PersonalAccessToken) (entity.PersonalAccessToken, error) {
+	uid, err := uuid.FromBytes(row.UserID)
+	if err != nil {
+		return entity.PersonalAccessToken{}, apperr.New(fmt.Sprintf("raw user id of personal access token %q to uuid", row.ID), "failed to find token", apperr.WithCause(err))
+	}
+	return entity.PersonalAccessToken{
+		ID:        row.ID,
+		UserID:    uid,
+		Name:      row.Name,
+		Hash:      row.TokenHash,
+		Scopes:    strings.Fields(row.Scopes),
+		ExpiresAt: row.ExpiresAt,
+		RevokedAt: row.RevokedAt.Time,
+		CreatedAt: row.CreatedAt,
+	}, nil
+}
+
+var _ repository.PersonalAccessTokenRepository = (*PersonalAccessTokenAdaptor)(nil)
@@ -0,0 +1,54 @@
+package datasource_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/datasource"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/testhelper"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersonalAccessTokenAdaptor(t *testing.T) {
+	userID := testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812")
+	now := time.Now().UTC().Truncate(time.Second)
+	adaptor := datasource.NewPersonalAccessTokenAdaptor(db)
+	runInTx(t, func(ctx context.Context) {
+		token, raw, err := entity.NewPersonalAccessToken(userID, "ci", []string{"read:tasks", "write:tasks"}, now.Add(24*time.Hour), now)
+		require.NoError(t, err)
+		require.NoError(t, adaptor.Create(ctx, token))
+
+		got, err := adaptor.FindByHash(ctx, entity.HashPersonalAccessToken(raw))
+		require.NoError(t, err)
+		assert.Equal(t, token, got)
+
+		tokens, err := adaptor.ListByUserID(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, []entity.PersonalAccessToken{token}, tokens)
+
+		locked, err := adaptor.FindByIDForUpdate(ctx, token.ID)
+		require.NoError(t, err)
+		require.NoError(t, locked.Revoke(now.Add(time.Hour)))
+		require.NoError(t, adaptor.Update(ctx, locked))
+
+		got, err = adaptor.FindByHash(ctx, entity.HashPersonalAccessToken(raw))
+		require.NoError(t, err)
+		assert.Equal(t, now.Add(time.Hour), got.RevokedAt)
+	})
+}
+
+func TestPersonalAccessTokenAdaptor_NotFound(t *testing.T) {
+	adaptor := datasource.NewPersonalAccessTokenAdaptor(db)
+	runInTx(t, func(ctx context.Context) {
+		_, err := adaptor.FindByHash(ctx, entity.HashPersonalAccessToken("pat_unknown"))
+		assert.EqualError(t, err, "find personal access token by hash but result set is zero: sql: no rows in result set")
+		assert.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+
+		_, err = adaptor.FindByIDForUpdate(ctx, "00000000-0000-7000-8000-000000000000")
+		assert.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+	})
+}
@@ -8,8 +8,10 @@ import (
 	"go-playground/cmd/api/internal/datasource/database"
 	"go-playground/cmd/api/internal/domain/entity"
 	"go-playground/cmd/api/internal/domain/repository"
+	"go-playground/cmd/api/internal/message"
 	"go-playground/pkg/apperr"
 
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/newrelic/go-agent/v3/newrelic"
 )
@@ -24,12 +26,16 @@ func NewTaskAdaptor(db *sqlx.DB) *TaskAdaptor {
 	return &TaskAdaptor{base: base{db: db}}
 }
 
-// ListTasks list all task.
+// ListTasks list all task of active organization.
 func (a *TaskAdaptor) ListTasks(ctx context.Context, next entity.TaskID, limit int32) (entity.Page[entity.Task], error) {
 	defer newrelic.FromContext(ctx).StartSegment("datasource/TaskAdaptor/ListTasks").End()
 
+	orgID, err := a.organizationFromContext(ctx)
+	if err != nil {
+		return entity.Page[entity.Task]{}, err
+	}
 	queries := a.queriesFromContext(ctx)
-	rows, err := queries.ListTasks(ctx, database.ListTasksParams{ID: next, Limit: limit + 1})
+	rows, err := queries.ListTasks(ctx, database.ListTasksParams{OrganizationID: orgID, ID: next, Limit: limit + 1})
 	if err != nil {
 		return entity.Page[entity.Task]{}, apperr.New("list tasks", "failed to list tasks", apperr.WithCause(err))
 	}
@@ -45,34 +51,49 @@ func (a *TaskAdaptor) ListTasks(ctx context.Context, next entity.TaskID, limit i
 	return entity.NewPage(tasks, limit)
 }
 
-// FindByID select task from task record by given id. Error will be returned task is not found.
+// FindByID select task from task record by given id. Error will be returned task is not found in active organization.
 func (a *TaskAdaptor) FindByID(ctx context.Context, id entity.TaskID) (entity.Task, error) {
 	defer newrelic.FromContext(ctx).StartSegment("datasource/TaskAdaptor/FindByID").End()
 
+	orgID, err := a.organizationFromContext(ctx)
+	if err != nil {
+		return entity.Task{}, err
+	}
 	queries := a.queriesFromContext(ctx)
-	row, err := queries.FindTask(ctx, id)
+	row, err := queries.FindTask(ctx, database.FindTaskParams{ID: id, OrganizationID: orgID})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return entity.Task{}, apperr.New(fmt.Sprintf("find task by id %q", id), "not found task", apperr.WithCause(err), apperr.CodeNotFound)
+			return entity.Task{}, apperr.New(fmt.Sprintf("find task by id %q", id), "Task not found", apperr.WithMessageID(message.TaskNotFound), apperr.WithCause(err), apperr.CodeNotFound)
 		}
 		return entity.Task{}, apperr.New("find task", "failed to find task", apperr.WithCause(err))
 	}
+	ownerID, err := fromNullUserID(row.OwnerID)
+	if err != nil {
+		return entity.Task{}, apperr.New(fmt.Sprintf("raw owner id of task %q to uuid", id), "failed to find task", apperr.WithCause(err))
+	}
 	return entity.Task{
 		ID:        row.ID,
 		Content:   row.Content,
 		CreatedAt: row.CreatedAt,
 		UpdatedAt: row.UpdatedAt,
+		OwnerID:   ownerID,
 	}, nil
 }
 
-// Create inserts given task to task table.
+// Create inserts given task to task table as task of active organization.
 func (a *TaskAdaptor) Create(ctx context.Context, task entity.Task) error {
 	defer newrelic.FromContext(ctx).StartSegment("datasource/TaskAdaptor/Create").End()
 
+	orgID, err := a.organizationFromContext(ctx)
+	if err != nil {
+		return err
+	}
 	queries := a.queriesFromContext(ctx)
-	_, err := queries.CreateTask(ctx, database.CreateTaskParams{
-		ID:      task.ID,
-		Content: task.Content,
+	_, err = queries.CreateTask(ctx, database.CreateTaskParams{
+		ID:             task.ID,
+		OrganizationID: orgID,
+		OwnerID:        toNullUserID(task.OwnerID),
+		Content:        task.Content,
 	})
 	if err != nil {
 		return apperr.New("create new task", "failed to create new task", apperr.WithCause(err))
@@ -80,14 +101,19 @@ func (a *TaskAdaptor) Create(ctx context.Context, task entity.Task) error {
 	return nil
 }
 
-// Update task record by give task entity.
+// Update task record of active organization by give task entity.
 func (a *TaskAdaptor) Update(ctx context.Context, task entity.Task) error {
 	defer newrelic.FromContext(ctx).StartSegment("datasource/TaskAdaptor/Update").End()
 
+	orgID, err := a.organizationFromContext(ctx)
+	if err != nil {
+		return err
+	}
 	queries := a.queriesFromContext(ctx)
-	_, err := queries.UpdateTask(ctx, database.UpdateTaskParams{
-		ID:      task.ID,
-		Content: task.Content,
+	_, err = queries.UpdateTask(ctx, database.UpdateTaskParams{
+		ID:             task.ID,
+		OrganizationID: orgID,
+		Content:        task.Content,
 	})
 	if err != nil {
 		return apperr.New(fmt.Sprintf("update task by id %q", task.ID), "failed to update task", apperr.WithCause(err))
@@ -95,15 +121,39 @@ func (a *TaskAdaptor) Update(ctx context.Context, task entity.Task) error {
 	return nil
 }
 
-// Creates creates multiple tasks.
+// Creates creates multiple tasks of active organization.
 func (a *TaskAdaptor) Creates(ctx context.Context, tasks []entity.Task) error {
+	orgID, err := a.organizationFromContext(ctx)
+	if err != nil {
+		return err
+	}
 	ext := a.extFromContext(ctx)
 
-	_, err := sqlx.NamedExec(ext, `INSERT INTO tasks (id, content) VALUES(:id, :content)`, tasks)
+	rows := make([]map[string]any, len(tasks))
+	for i, task := range tasks {
+		rows[i] = map[string]any{"id": task.ID, "organization_id": orgID, "content": task.Content}
+	}
+	_, err = sqlx.NamedExec(ext, `INSERT INTO tasks (id, organization_id, content) VALUES(:id, :organization_id, :content)`, rows)
 	if err != nil {
 		return fmt.Errorf("named exec on creates: %w", err)
 	}
 	return nil
 }
 
+// toNullUserID converts zero id of user to NULL.
+func toNullUserID(id uuid.UUID) sql.NullString {
+	if id == uuid.Nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: string(id[:]), Valid: true}
+}
+
+// fromNullUserID converts NULL id of user to zero id.
+func fromNullUserID(id sql.NullString) (uuid.UUID, error) {
+	if !id.Valid {
+		return uuid.Nil, nil
+	}
+	return uuid.FromBytes([]byte(id.String))
+}
+
 var _ repository.TaskRepository = (*TaskAdaptor)(nil)
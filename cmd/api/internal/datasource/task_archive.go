@@ -0,0 +1,195 @@
+package datasource
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"go-playground/cmd/api/internal/datasource/database"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/domain/repository"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// TaskArchiveAdaptor is implementation of repository.TaskArchiveRepository.
+type TaskArchiveAdaptor struct {
+	base
+}
+
+// NewTaskArchiveAdaptor initializes TaskArchiveAdaptor.
+func NewTaskArchiveAdaptor(db *sqlx.DB) *TaskArchiveAdaptor {
+	return &TaskArchiveAdaptor{base: base{db: db}}
+}
+
+// ArchiveTasks moves tasks not updated since before from tasks table to tasks_archive table.
+// Tasks of all organizations are archived since this is run by batch.
+//
+// Target tasks are locked with SKIP LOCKED, so concurrent callers move disjoint tasks.
+func (a *TaskArchiveAdaptor) ArchiveTasks(ctx context.Context, before time.Time, limit int32) (int64, error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/TaskArchiveAdaptor/ArchiveTasks").End()
+
+	queries := a.queriesFromContext(ctx)
+	ids, err := queries.ListArchivableTaskIDs(ctx, database.ListArchivableTaskIDsParams{UpdatedAt: before, Limit: limit})
+	if err != nil {
+		return 0, apperr.New("list archivable task ids", "failed to archive tasks", apperr.WithCause(err))
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	if _, err := queries.CopyTasksToArchive(ctx, ids); err != nil {
+		return 0, apperr.New("copy tasks to archive", "failed to archive tasks", apperr.WithCause(err))
+	}
+	n, err := queries.DeleteTasks(ctx, ids)
+	if err != nil {
+		return 0, apperr.New("delete archived tasks", "failed to archive tasks", apperr.WithCause(err))
+	}
+	return n, nil
+}
+
+// Unarchive moves archived task of given id in active organization from tasks_archive table back to tasks table.
+func (a *TaskArchiveAdaptor) Unarchive(ctx context.Context, id entity.TaskID) error {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/TaskArchiveAdaptor/Unarchive").End()
+
+	orgID, err := a.organizationFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	queries := a.queriesFromContext(ctx)
+	_, err = queries.FindArchivedTaskForUpdate(ctx, database.FindArchivedTaskForUpdateParams{ID: id, OrganizationID: orgID})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperr.New(fmt.Sprintf("find archived task by id %q", id), "Archived task not found", apperr.WithMessageID(message.ArchivedTaskNotFound), apperr.WithCause(err), apperr.CodeNotFound)
+		}
+		return apperr.New("find archived task", "failed to unarchive task", apperr.WithCause(err))
+	}
+	if _, err := queries.CopyArchivedTaskToTasks(ctx, id); err != nil {
+		return apperr.New(fmt.Sprintf("copy archived task %q to tasks", id), "failed to unarchive task", apperr.WithCause(err))
+	}
+	if _, err := queries.DeleteArchivedTask(ctx, id); err != nil {
+		return apperr.New(fmt.Sprintf("delete archived task %q", id), "failed to unarchive task", apperr.WithCause(err))
+	}
+	return nil
+}
+
+// ArchiveOwnedTasks moves tasks of given owner in all organizations from tasks table to tasks_archive table as archived at given time.
+func (a *TaskArchiveAdaptor) ArchiveOwnedTasks(ctx context.Context, ownerID uuid.UUID, at time.Time) (int64, error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/TaskArchiveAdaptor/ArchiveOwnedTasks").End()
+
+	queries := a.queriesFromContext(ctx)
+	ids, err := queries.ListOwnedTaskIDsForUpdate(ctx, toNullUserID(ownerID))
+	if err != nil {
+		return 0, apperr.New(fmt.Sprintf("list task ids owned by %q", ownerID), "failed to archive tasks", apperr.WithCause(err))
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	if _, err := queries.CopyTasksToArchiveAt(ctx, database.CopyTasksToArchiveAtParams{ArchivedAt: at.UTC(), Ids: ids}); err != nil {
+		return 0, apperr.New(fmt.Sprintf("copy tasks owned by %q to archive", ownerID), "failed to archive tasks", apperr.WithCause(err))
+	}
+	n, err := queries.DeleteTasks(ctx, ids)
+	if err != nil {
+		return 0, apperr.New(fmt.Sprintf("delete archived tasks owned by %q", ownerID), "failed to archive tasks", apperr.WithCause(err))
+	}
+	return n, nil
+}
+
+// UnarchiveOwnedTasks moves tasks of given owner archived at or after since from tasks_archive table back to tasks table.
+func (a *TaskArchiveAdaptor) UnarchiveOwnedTasks(ctx context.Context, ownerID uuid.UUID, since time.Time) (int64, error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/TaskArchiveAdaptor/UnarchiveOwnedTasks").End()
+
+	queries := a.queriesFromContext(ctx)
+	ids, err := queries.ListOwnedArchivedTaskIDsForUpdate(ctx, database.ListOwnedArchivedTaskIDsForUpdateParams{OwnerID: toNullUserID(ownerID), ArchivedAt: since.UTC()})
+	if err != nil {
+		return 0, apperr.New(fmt.Sprintf("list archived task ids owned by %q", ownerID), "failed to unarchive tasks", apperr.WithCause(err))
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	if _, err := queries.CopyArchivedTasksToTasks(ctx, ids); err != nil {
+		return 0, apperr.New(fmt.Sprintf("copy archived tasks owned by %q to tasks", ownerID), "failed to unarchive tasks", apperr.WithCause(err))
+	}
+	n, err := queries.DeleteArchivedTasks(ctx, ids)
+	if err != nil {
+		return 0, apperr.New(fmt.Sprintf("delete unarchived tasks owned by %q", ownerID), "failed to unarchive tasks", apperr.WithCause(err))
+	}
+	return n, nil
+}
+
+// FindByID finds task of given id from both active and archived tasks.
+// Tasks of all organizations are looked up since this is for support of service admins.
+func (a *TaskArchiveAdaptor) FindByID(ctx context.Context, id entity.TaskID) (entity.Task, error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/TaskArchiveAdaptor/FindByID").End()
+
+	queries := a.queriesFromContext(ctx)
+	row, err := queries.FindTaskIncludingArchived(ctx, database.FindTaskIncludingArchivedParams{ID: id})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Task{}, apperr.New(fmt.Sprintf("find task including archived by id %q", id), "Task not found", apperr.WithMessageID(message.TaskNotFound), apperr.WithCause(err), apperr.CodeNotFound)
+		}
+		return entity.Task{}, apperr.New(fmt.Sprintf("find task including archived by id %q", id), "failed to find task", apperr.WithCause(err))
+	}
+	return entity.Task{
+		ID:        row.ID,
+		Content:   row.Content,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+		Archived:  row.Archived != 0,
+	}, nil
+}
+
+// ListTasks lists both active and archived tasks of active organization.
+func (a *TaskArchiveAdaptor) ListTasks(ctx context.Context, next entity.TaskID, limit int32) (entity.Page[entity.Task], error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/TaskArchiveAdaptor/ListTasks").End()
+
+	orgID, err := a.organizationFromContext(ctx)
+	if err != nil {
+		return entity.Page[entity.Task]{}, err
+	}
+	queries := a.queriesFromContext(ctx)
+	rows, err := queries.ListTasksIncludingArchived(ctx, database.ListTasksIncludingArchivedParams{OrganizationID: orgID, ID: next, Limit: limit + 1})
+	if err != nil {
+		return entity.Page[entity.Task]{}, apperr.New("list tasks including archived", "failed to list tasks", apperr.WithCause(err))
+	}
+	tasks := make([]entity.Task, len(rows))
+	for i, r := range rows {
+		tasks[i] = entity.Task{
+			ID:        r.ID,
+			Content:   r.Content,
+			CreatedAt: r.CreatedAt,
+			UpdatedAt: r.UpdatedAt,
+			Archived:  r.Archived != 0,
+		}
+	}
+	return entity.NewPage(tasks, limit)
+}
+
+var _ repository.TaskArchiveRepository = (*TaskArchiveAdaptor)(nil)
+
+// ListOwnedTasks finds all tasks of given owner including archived tasks in all organizations.
+func (a *TaskArchiveAdaptor) ListOwnedTasks(ctx context.Context, ownerID uuid.UUID) ([]entity.Task, error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/TaskArchiveAdaptor/ListOwnedTasks").End()
+
+	queries := a.queriesFromContext(ctx)
+	rows, err := queries.ListOwnedTasksIncludingArchived(ctx, database.ListOwnedTasksIncludingArchivedParams{OwnerID: toNullUserID(ownerID)})
+	if err != nil {
+		return nil, apperr.New(fmt.Sprintf("list owned tasks including archived of %q", ownerID), "failed to list tasks", apperr.WithCause(err))
+	}
+	tasks := make([]entity.Task, len(rows))
+	for i, r := range rows {
+		tasks[i] = entity.Task{
+			ID:        r.ID,
+			Content:   r.Content,
+			CreatedAt: r.CreatedAt,
+			UpdatedAt: r.UpdatedAt,
+			Archived:  r.Archived != 0,
+			OwnerID:   ownerID,
+		}
+	}
+	return tasks, nil
+}
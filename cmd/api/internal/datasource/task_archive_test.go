@@ -0,0 +1,311 @@
+package datasource_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/datasource"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"go-playground/pkg/testhelper"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskArchiveAdaptor_ArchiveTasks(t *testing.T) {
+	type input struct {
+		before time.Time
+		limit  int32
+	}
+	type want struct {
+		n        int64
+		archived []string
+	}
+	tests := map[string]struct {
+		input input
+		want  want
+	}{
+		"archive all old tasks": {
+			input: input{before: time.Date(2024, 7, 30, 0, 0, 0, 0, time.UTC), limit: 10},
+			want:  want{n: 2, archived: []string{"0190fe5b-1f83-7024-a233-c8a18935f5dc", "0190fe59-6618-7811-8b28-a3e67969a4ef"}},
+		},
+		"archive up to limit": {
+			input: input{before: time.Date(2024, 7, 30, 0, 0, 0, 0, time.UTC), limit: 1},
+			want:  want{n: 1, archived: []string{"0190fe59-6618-7811-8b28-a3e67969a4ef"}},
+		},
+		"nothing to archive": {
+			input: input{before: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), limit: 10},
+			want:  want{n: 0},
+		},
+	}
+	adaptor := datasource.NewTaskArchiveAdaptor(db)
+	taskAdaptor := datasource.NewTaskAdaptor(db)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			runInTx(t, func(ctx context.Context) {
+				got, err := adaptor.ArchiveTasks(ctx, tc.input.before, tc.input.limit)
+
+				require.NoError(t, err)
+				assert.Equal(t, tc.want.n, got)
+				for _, id := range tc.want.archived {
+					_, err := taskAdaptor.FindByID(ctx, id)
+					assert.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+				}
+				page, err := adaptor.ListTasks(ctx, "", 10)
+				require.NoError(t, err)
+				var archived []string
+				for _, task := range page.Items {
+					if task.Archived && task.ID != "018f5c1e-2b3a-7c4d-8e5f-6a7b8c9d0e1f" {
+						archived = append(archived, task.ID)
+					}
+				}
+				assert.Equal(t, tc.want.archived, archived)
+			})
+		})
+	}
+}
+
+func TestTaskArchiveAdaptor_Unarchive(t *testing.T) {
+	type want struct {
+		err     string
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		input string
+		orgID string
+		want  want
+	}{
+		"success": {
+			input: "018f5c1e-2b3a-7c4d-8e5f-6a7b8c9d0e1f",
+		},
+		"failure archived task of other organization": {
+			input: "018f5c1e-2b3a-7c4d-8e5f-6a7b8c9d0e1f",
+			orgID: testOtherOrganizationID,
+			want: want{
+				err:     `find archived task by id "018f5c1e-2b3a-7c4d-8e5f-6a7b8c9d0e1f": sql: no rows in result set`,
+				errCode: apperr.CodeNotFound,
+			},
+		},
+		"failure not archived": {
+			input: "0190fe59-6618-7811-8b28-a3e67969a4ef",
+			want: want{
+				err:     `find archived task by id "0190fe59-6618-7811-8b28-a3e67969a4ef": sql: no rows in result set`,
+				errCode: apperr.CodeNotFound,
+			},
+		},
+	}
+	adaptor := datasource.NewTaskArchiveAdaptor(db)
+	taskAdaptor := datasource.NewTaskAdaptor(db)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			runInTx(t, func(ctx context.Context) {
+				if tc.orgID != "" {
+					ctx = ctxhelper.WithOrganizationID(ctx, tc.orgID)
+				}
+				err := adaptor.Unarchive(ctx, tc.input)
+
+				if tc.want.err != "" {
+					assert.EqualError(t, err, tc.want.err)
+					assert.True(t, apperr.IsCode(err, tc.want.errCode))
+				} else {
+					require.NoError(t, err)
+					got, err := taskAdaptor.FindByID(ctx, tc.input)
+					require.NoError(t, err)
+					assert.WithinDuration(t, time.Now(), got.UpdatedAt, time.Minute, "unarchived task must be updated now")
+					got.UpdatedAt = time.Time{}
+					assert.Equal(t, entity.Task{
+						ID:        "018f5c1e-2b3a-7c4d-8e5f-6a7b8c9d0e1f",
+						Content:   "this is archived test",
+						CreatedAt: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC),
+					}, got)
+				}
+			})
+		})
+	}
+}
+
+func TestTaskArchiveAdaptor_ArchiveTasks_afterUnarchive(t *testing.T) {
+	id := "0190fe59-6618-7811-8b28-a3e67969a4ef"
+	before := time.Date(2024, 7, 30, 0, 0, 0, 0, time.UTC)
+	adaptor := datasource.NewTaskArchiveAdaptor(db)
+	taskAdaptor := datasource.NewTaskAdaptor(db)
+	runInTx(t, func(ctx context.Context) {
+		_, err := adaptor.ArchiveTasks(ctx, before, 10)
+		require.NoError(t, err)
+		require.NoError(t, adaptor.Unarchive(ctx, id))
+
+		_, err = adaptor.ArchiveTasks(ctx, before, 10)
+		require.NoError(t, err)
+
+		got, err := taskAdaptor.FindByID(ctx, id)
+		require.NoError(t, err, "unarchived task must be kept active")
+		assert.False(t, got.Archived)
+	})
+}
+
+func TestTaskArchiveAdaptor_ArchiveOwnedTasks(t *testing.T) {
+	owner := testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812")
+	task := entity.Task{
+		ID:      "019a2b3c-0000-7000-8000-000000000001",
+		Content: "owned task",
+		OwnerID: owner,
+	}
+	deletedAt := time.Date(2024, 11, 8, 0, 0, 0, 0, time.UTC)
+	adaptor := datasource.NewTaskArchiveAdaptor(db)
+	taskAdaptor := datasource.NewTaskAdaptor(db)
+	runInTx(t, func(ctx context.Context) {
+		require.NoError(t, taskAdaptor.Create(ctx, task))
+
+		n, err := adaptor.ArchiveOwnedTasks(ctx, owner, deletedAt)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+		_, err = taskAdaptor.FindByID(ctx, task.ID)
+		assert.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+
+		n, err = adaptor.UnarchiveOwnedTasks(ctx, owner, deletedAt.Add(time.Second))
+		require.NoError(t, err)
+		assert.Zero(t, n, "tasks archived before deletion must be kept archived")
+
+		n, err = adaptor.UnarchiveOwnedTasks(ctx, owner, deletedAt)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+		got, err := taskAdaptor.FindByID(ctx, task.ID)
+		require.NoError(t, err)
+		assert.Equal(t, owner, got.OwnerID)
+	})
+}
+
+func TestTaskArchiveAdaptor_ListOwnedTasks(t *testing.T) {
+	owner := testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812")
+	archived := entity.Task{
+		ID:      "019a2b3c-0000-7000-8000-000000000001",
+		Content: "owned archived task",
+		OwnerID: owner,
+	}
+	active := entity.Task{
+		ID:      "019a2b3c-0000-7000-8000-000000000002",
+		Content: "owned active task",
+		OwnerID: owner,
+	}
+	adaptor := datasource.NewTaskArchiveAdaptor(db)
+	taskAdaptor := datasource.NewTaskAdaptor(db)
+	runInTx(t, func(ctx context.Context) {
+		require.NoError(t, taskAdaptor.Create(ctx, archived))
+		_, err := adaptor.ArchiveOwnedTasks(ctx, owner, time.Date(2024, 11, 8, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.NoError(t, taskAdaptor.Create(ctx, active))
+
+		got, err := adaptor.ListOwnedTasks(ctx, owner)
+
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, []entity.TaskID{archived.ID, active.ID}, []entity.TaskID{got[0].ID, got[1].ID})
+		assert.True(t, got[0].Archived)
+		assert.False(t, got[1].Archived)
+		assert.Equal(t, owner, got[1].OwnerID)
+	})
+}
+
+func TestTaskArchiveAdaptor_ListTasks(t *testing.T) {
+	type input struct {
+		limit int32
+		token string
+	}
+	tests := map[string]struct {
+		input input
+		want  entity.Page[entity.Task]
+	}{
+		"active and archived": {
+			input: input{token: "0190fe59-6618-7811-8b28-a3e67969a4ef", limit: 1},
+			want: entity.Page[entity.Task]{
+				Items: []entity.Task{
+					{
+						ID:        "0190fe59-6618-7811-8b28-a3e67969a4ef",
+						Content:   "this is test 1",
+						CreatedAt: time.Date(2024, 7, 29, 20, 56, 30, 0, time.UTC),
+						UpdatedAt: time.Date(2024, 7, 29, 20, 56, 30, 0, time.UTC),
+					},
+				},
+				HasNext:   true,
+				NextToken: "eyJpZCI6IjAxOGY1YzFlLTJiM2EtN2M0ZC04ZTVmLTZhN2I4YzlkMGUxZiJ9",
+			},
+		},
+		"archived only": {
+			input: input{token: "018f5c1e-2b3a-7c4d-8e5f-6a7b8c9d0e1f", limit: 2},
+			want: entity.Page[entity.Task]{
+				Items: []entity.Task{
+					{
+						ID:        "018f5c1e-2b3a-7c4d-8e5f-6a7b8c9d0e1f",
+						Content:   "this is archived test",
+						CreatedAt: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC),
+						Archived:  true,
+					},
+				},
+			},
+		},
+	}
+	adaptor := datasource.NewTaskArchiveAdaptor(db)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			runInTx(t, func(ctx context.Context) {
+				got, err := adaptor.ListTasks(ctx, tc.input.token, tc.input.limit)
+
+				require.NoError(t, err)
+				assert.Equal(t, tc.want, got)
+			})
+		})
+	}
+}
+
+func TestTaskArchiveAdaptor_FindByID(t *testing.T) {
+	type want struct {
+		task    entity.Task
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		input string
+		want  want
+	}{
+		"active task": {
+			input: "0190fe59-6618-7811-8b28-a3e67969a4ef",
+			want: want{task: entity.Task{
+				ID:        "0190fe59-6618-7811-8b28-a3e67969a4ef",
+				Content:   "this is test 1",
+				CreatedAt: time.Date(2024, 7, 29, 20, 56, 30, 0, time.UTC),
+				UpdatedAt: time.Date(2024, 7, 29, 20, 56, 30, 0, time.UTC),
+			}},
+		},
+		"archived task": {
+			input: "018f5c1e-2b3a-7c4d-8e5f-6a7b8c9d0e1f",
+			want: want{task: entity.Task{
+				ID:        "018f5c1e-2b3a-7c4d-8e5f-6a7b8c9d0e1f",
+				Content:   "this is archived test",
+				CreatedAt: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC),
+				Archived:  true,
+			}},
+		},
+		"not found": {
+			input: "00000000-0000-7000-8000-000000000000",
+			want:  want{errCode: apperr.CodeNotFound},
+		},
+	}
+	adaptor := datasource.NewTaskArchiveAdaptor(db)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			runInTx(t, func(ctx context.Context) {
+				got, err := adaptor.FindByID(ctx, tc.input)
+
+				if tc.want.errCode != apperr.CodeUnknown {
+					assert.True(t, apperr.IsCode(err, tc.want.errCode))
+				} else {
+					require.NoError(t, err)
+				}
+				assert.Equal(t, tc.want.task, got)
+			})
+		})
+	}
+}
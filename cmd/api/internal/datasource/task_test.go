@@ -5,6 +5,7 @@ import (
 	"go-playground/cmd/api/internal/datasource"
 	"go-playground/cmd/api/internal/domain/entity"
 	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
 	"testing"
 	"time"
 
@@ -122,6 +123,45 @@ func TestTaskAdaptor_FindByID(t *testing.T) {
 	}
 }
 
+func TestTaskAdaptor_OrganizationScope(t *testing.T) {
+	adaptor := datasource.NewTaskAdaptor(db)
+	t.Run("tasks of other organization are invisible", func(t *testing.T) {
+		runInTx(t, func(ctx context.Context) {
+			ctx = ctxhelper.WithOrganizationID(ctx, testOtherOrganizationID)
+
+			page, err := adaptor.ListTasks(ctx, "", 10)
+			require.NoError(t, err)
+			assert.Empty(t, page.Items)
+
+			_, err = adaptor.FindByID(ctx, "0190fe59-6618-7811-8b28-a3e67969a4ef")
+			assert.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+		})
+	})
+	t.Run("task created in other organization is invisible", func(t *testing.T) {
+		runInTx(t, func(ctx context.Context) {
+			task := entity.Task{ID: "0194c3b0-1f2e-7d3c-8b4a-5e6f7a8b9c0d", Content: "other task"}
+			err := adaptor.Create(ctxhelper.WithOrganizationID(ctx, testOtherOrganizationID), task)
+			require.NoError(t, err)
+
+			_, err = adaptor.FindByID(ctx, task.ID)
+			assert.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+		})
+	})
+	t.Run("no organization is active", func(t *testing.T) {
+		runInTx(t, func(ctx context.Context) {
+			ctx = ctxhelper.WithOrganizationID(ctx, "")
+
+			_, err := adaptor.ListTasks(ctx, "", 10)
+			assert.EqualError(t, err, "query tenant data without active organization")
+			assert.True(t, apperr.IsCode(err, apperr.CodeInvalidArgument))
+			_, err = adaptor.FindByID(ctx, "0190fe59-6618-7811-8b28-a3e67969a4ef")
+			assert.True(t, apperr.IsCode(err, apperr.CodeInvalidArgument))
+			err = adaptor.Create(ctx, entity.Task{ID: "0194c3b0-1f2e-7d3c-8b4a-5e6f7a8b9c0d", Content: "task"})
+			assert.True(t, apperr.IsCode(err, apperr.CodeInvalidArgument))
+		})
+	})
+}
+
 func TestTaskAdaptor_Create(t *testing.T) {
 	task := entity.Task{
 		ID:      "0190f34a-e069-7873-8fe1-fdf871eb3919",
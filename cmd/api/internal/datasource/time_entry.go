@@ -0,0 +1,134 @@
+package datasource
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"go-playground/cmd/api/internal/datasource/database"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/domain/repository"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// TimeEntryAdaptor is implementation of [repository.TimeEntryRepository].
+type TimeEntryAdaptor struct {
+	base
+}
+
+// NewTimeEntryAdaptor initializes TimeEntryAdaptor.
+func NewTimeEntryAdaptor(db *sqlx.DB) *TimeEntryAdaptor {
+	return &TimeEntryAdaptor{base: base{db: db}}
+}
+
+// FindRunningForUpdate locks user row so that timers of the user are serialized, and finds running timer.
+// This must be called in transaction.
+func (a *TimeEntryAdaptor) FindRunningForUpdate(ctx context.Context, userID uuid.UUID) (entity.TimeEntry, error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/TimeEntryAdaptor/FindRunningForUpdate").End()
+
+	queries := a.queriesFromContext(ctx)
+	_, err := queries.LockUser(ctx, userID[:])
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.TimeEntry{}, apperr.New(fmt.Sprintf("lock user %q but user is not found", userID), "User not found", apperr.WithMessageID(message.UserNotFound), apperr.WithCause(err), apperr.CodeNotFound)
+		}
+		return entity.TimeEntry{}, apperr.New(fmt.Sprintf("lock user %q", userID), "failed to find running timer", apperr.WithCause(err))
+	}
+	row, err := queries.FindRunningTimeEntry(ctx, userID[:])
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.TimeEntry{}, apperr.New(fmt.Sprintf("find running timer of user %q", userID), "No timer is running on the task", apperr.WithMessageID(message.TimerNotRunning), apperr.WithCause(err), apperr.WithoutStackTrace(), apperr.CodeNotFound)
+		}
+		return entity.TimeEntry{}, apperr.New(fmt.Sprintf("find running timer of user %q", userID), "failed to find running timer", apperr.WithCause(err))
+	}
+	return toTimeEntry(row)
+}
+
+// ListInRange lists time entries of given user overlapping range [from, to).
+func (a *TimeEntryAdaptor) ListInRange(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]entity.TimeEntry, error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/TimeEntryAdaptor/ListInRange").End()
+
+	queries := a.queriesFromContext(ctx)
+	rows, err := queries.ListTimeEntriesInRange(ctx, database.ListTimeEntriesInRangeParams{
+		UserID: userID[:],
+		From:   sql.NullTime{Time: from.UTC(), Valid: true},
+		To:     to.UTC(),
+	})
+	if err != nil {
+		return nil, apperr.New(fmt.Sprintf("list time entries of user %q", userID), "failed to list time entries", apperr.WithCause(err))
+	}
+	entries := make([]entity.TimeEntry, len(rows))
+	for i, row := range rows {
+		entries[i], err = toTimeEntry(row)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+// Create inserts given time entry.
+func (a *TimeEntryAdaptor) Create(ctx context.Context, entry entity.TimeEntry) error {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/TimeEntryAdaptor/Create").End()
+
+	queries := a.queriesFromContext(ctx)
+	err := queries.CreateTimeEntry(ctx, database.CreateTimeEntryParams{
+		ID:        entry.ID,
+		TaskID:    entry.TaskID,
+		UserID:    entry.UserID[:],
+		StartedAt: entry.StartedAt.UTC(),
+		EndedAt:   toNullTime(entry.EndedAt),
+	})
+	if err != nil {
+		return apperr.New("create time entry", "failed to create time entry", apperr.WithCause(err))
+	}
+	return nil
+}
+
+// Update updates period of given time entry.
+func (a *TimeEntryAdaptor) Update(ctx context.Context, entry entity.TimeEntry) error {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/TimeEntryAdaptor/Update").End()
+
+	queries := a.queriesFromContext(ctx)
+	_, err := queries.UpdateTimeEntry(ctx, database.UpdateTimeEntryParams{
+		ID:        entry.ID,
+		StartedAt: entry.StartedAt.UTC(),
+		EndedAt:   toNullTime(entry.EndedAt),
+	})
+	if err != nil {
+		return apperr.New(fmt.Sprintf("update time entry by id %q", entry.ID), "failed to update time entry", apperr.WithCause(err))
+	}
+	return nil
+}
+
+func toTimeEntry(row database.TimeEntry) (entity.TimeEntry, error) {
+	uid, err := uuid.FromBytes(row.UserID)
+	if err != nil {
+		return entity.TimeEntry{}, apperr.New(fmt.Sprintf("raw user id(%s) to uuid", string(row.UserID)), "failed to find time entry", apperr.WithCause(err))
+	}
+	return entity.TimeEntry{
+		ID:        row.ID,
+		TaskID:    row.TaskID,
+		UserID:    uid,
+		StartedAt: row.StartedAt,
+		EndedAt:   row.EndedAt.Time,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}, nil
+}
+
+// toNullTime converts zero time to NULL.
+func toNullTime(t time.Time) sql.NullTime {
+	if t.IsZero() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t.UTC(), Valid: true}
+}
+
+var _ repository.TimeEntryRepository = (*TimeEntryAdaptor)(nil)
@@ -0,0 +1,124 @@
+package datasource_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/datasource"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/testhelper"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeEntryAdaptor_FindRunningForUpdate(t *testing.T) {
+	type want struct {
+		entry   entity.TimeEntry
+		err     string
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		input uuid.UUID
+		want  want
+	}{
+		"success": {
+			input: testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812"),
+			want: want{entry: entity.TimeEntry{
+				ID:        "0193a5b2-7400-7c1d-8e2f-4b9a1d8e3c02",
+				TaskID:    "0190fe5b-1f83-7024-a233-c8a18935f5dc",
+				UserID:    testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812"),
+				StartedAt: time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC),
+				CreatedAt: time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC),
+			}},
+		},
+		"failure user not found": {
+			input: testhelper.UUIDFromString(t, "00000000-0000-7000-8000-000000000000"),
+			want:  want{err: `lock user "00000000-0000-7000-8000-000000000000" but user is not found: sql: no rows in result set`, errCode: apperr.CodeNotFound},
+		},
+	}
+	adaptor := datasource.NewTimeEntryAdaptor(db)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			runInTx(t, func(ctx context.Context) {
+				got, err := adaptor.FindRunningForUpdate(ctx, tc.input)
+
+				if tc.want.err != "" {
+					assert.Zero(t, got)
+					assert.EqualError(t, err, tc.want.err)
+					assert.True(t, apperr.IsCode(err, tc.want.errCode))
+				} else {
+					assert.Equal(t, tc.want.entry, got)
+					assert.NoError(t, err)
+				}
+			})
+		})
+	}
+}
+
+func TestTimeEntryAdaptor_ListInRange(t *testing.T) {
+	uid := testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812")
+	tests := map[string]struct {
+		from, to time.Time
+		want     []string
+	}{
+		"overlapping both": {
+			from: time.Date(2024, 12, 1, 15, 0, 0, 0, time.UTC),
+			to:   time.Date(2024, 12, 3, 0, 0, 0, 0, time.UTC),
+			want: []string{"0193a1e0-5c00-7a3b-9f1e-3a8f0c7d2b01", "0193a5b2-7400-7c1d-8e2f-4b9a1d8e3c02"},
+		},
+		"running timer only": {
+			from: time.Date(2024, 12, 1, 16, 0, 0, 0, time.UTC),
+			to:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			want: []string{"0193a5b2-7400-7c1d-8e2f-4b9a1d8e3c02"},
+		},
+		"no result": {
+			from: time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2024, 12, 1, 14, 0, 0, 0, time.UTC),
+			want: []string{},
+		},
+	}
+	adaptor := datasource.NewTimeEntryAdaptor(db)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			runInTx(t, func(ctx context.Context) {
+				got, err := adaptor.ListInRange(ctx, uid, tc.from, tc.to)
+
+				require.NoError(t, err)
+				ids := make([]string, len(got))
+				for i, e := range got {
+					ids[i] = e.ID
+				}
+				assert.Equal(t, tc.want, ids)
+			})
+		})
+	}
+}
+
+func TestTimeEntryAdaptor_CreateAndUpdate(t *testing.T) {
+	uid := testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812")
+	adaptor := datasource.NewTimeEntryAdaptor(db)
+	runInTx(t, func(ctx context.Context) {
+		running, err := adaptor.FindRunningForUpdate(ctx, uid)
+		require.NoError(t, err)
+		require.NoError(t, running.Stop(time.Date(2024, 12, 2, 1, 0, 0, 0, time.UTC)))
+		err = adaptor.Update(ctx, running)
+		require.NoError(t, err)
+
+		_, err = adaptor.FindRunningForUpdate(ctx, uid)
+		assert.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+
+		entry, err := entity.StartTimeEntry("019102ca-b58b-7b46-8e27-d63485a70574", uid, time.Date(2024, 12, 2, 2, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		err = adaptor.Create(ctx, entry)
+		require.NoError(t, err)
+
+		got, err := adaptor.FindRunningForUpdate(ctx, uid)
+		require.NoError(t, err)
+		assert.Equal(t, entry.ID, got.ID)
+		assert.Equal(t, entry.StartedAt, got.StartedAt)
+	})
+}
@@ -8,7 +8,10 @@ import (
 	"go-playground/cmd/api/internal/datasource/database"
 	"go-playground/cmd/api/internal/domain/entity"
 	"go-playground/cmd/api/internal/domain/repository"
+	"go-playground/cmd/api/internal/message"
 	"go-playground/pkg/apperr"
+	"strings"
+	"time"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/google/uuid"
@@ -34,24 +37,28 @@ func (a *UserAdaptor) FindBySub(ctx context.Context, sub string) (entity.User, e
 	row, err := txq.FindUserBySub(ctx, sub)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return entity.User{}, apperr.New("find user by sub but result set is zero", "user is not found", apperr.WithCause(err), apperr.CodeNotFound)
+			// Users not registered yet are looked up on every request, so stack trace is not worth its cost.
+			return entity.User{}, apperr.New("find user by sub but result set is zero", "User not found", apperr.WithMessageID(message.UserNotFound), apperr.WithCause(err), apperr.WithoutStackTrace(), apperr.CodeNotFound)
 		}
-		return entity.User{}, apperr.New("find user by sub", "failed to find user", apperr.WithCause(err), apperr.CodeNotFound)
+		return entity.User{}, apperr.New("find user by sub", "User not found", apperr.WithMessageID(message.UserNotFound), apperr.WithCause(err), apperr.CodeNotFound)
 	}
-	uid, err := uuid.FromBytes(row.ID)
+	return toUser(row)
+}
+
+// FindByID finds user by given id.
+func (a *UserAdaptor) FindByID(ctx context.Context, id uuid.UUID) (entity.User, error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/UserAdaptor/FindByID").End()
+
+	txq := a.queriesFromContext(ctx)
+
+	row, err := txq.FindUserByID(ctx, id[:])
 	if err != nil {
-		return entity.User{}, apperr.New(fmt.Sprintf("raw user id(%s) to uuid", string(row.ID)), "failed to find user", apperr.WithCause(err))
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.User{}, apperr.New(fmt.Sprintf("find user by id %q but result set is zero", id), "User not found", apperr.WithMessageID(message.UserNotFound), apperr.WithCause(err), apperr.CodeNotFound)
+		}
+		return entity.User{}, apperr.New(fmt.Sprintf("find user by id %q", id), "failed to find user", apperr.WithCause(err))
 	}
-	return entity.User{
-		ID:            uid,
-		Sub:           row.Sub,
-		FamilyName:    row.FamilyName,
-		GivenName:     row.GivenName,
-		Email:         row.Email,
-		EmailVerified: row.EmailVerified,
-		CreatedAt:     row.CreatedAt,
-		UpdatedAt:     row.UpdatedAt,
-	}, nil
+	return toUser(row)
 }
 
 // Create creates with given user
@@ -71,11 +78,160 @@ func (a *UserAdaptor) Create(ctx context.Context, user entity.User) error {
 	if err != nil {
 		var mysqlErr *mysql.MySQLError
 		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 /* duplicate entry */ {
-			return apperr.New("create user but user is already exist", "user is already exist", apperr.WithCause(err), apperr.CodeInvalidArgument)
+			return apperr.New("create user but user is already exist", "User already exists", apperr.WithMessageID(message.UserAlreadyExists), apperr.WithCause(err), apperr.CodeAlreadyExists)
 		}
 		return apperr.New("create user", "failed to create user", apperr.WithCause(err))
 	}
 	return nil
 }
 
+// ListByEmails finds users with given emails.
+func (a *UserAdaptor) ListByEmails(ctx context.Context, emails []string) ([]entity.User, error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/UserAdaptor/ListByEmails").End()
+
+	if len(emails) == 0 {
+		return []entity.User{}, nil
+	}
+	txq := a.queriesFromContext(ctx)
+
+	rows, err := txq.ListUsersByEmails(ctx, emails)
+	if err != nil {
+		return nil, apperr.New("list users by emails", "failed to find users", apperr.WithCause(err))
+	}
+	users := make([]entity.User, len(rows))
+	for i, row := range rows {
+		users[i], err = toUser(row)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return users, nil
+}
+
+// FindBySubForUpdate finds and locks user by given sub.
+func (a *UserAdaptor) FindBySubForUpdate(ctx context.Context, sub string) (entity.User, error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/UserAdaptor/FindBySubForUpdate").End()
+
+	txq := a.queriesFromContext(ctx)
+
+	row, err := txq.FindUserBySubForUpdate(ctx, sub)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.User{}, apperr.New("find user by sub for update but result set is zero", "User not found", apperr.WithMessageID(message.UserNotFound), apperr.WithCause(err), apperr.CodeNotFound)
+		}
+		return entity.User{}, apperr.New("find user by sub for update", "failed to find user", apperr.WithCause(err))
+	}
+	return toUser(row)
+}
+
+// Update updates given user.
+func (a *UserAdaptor) Update(ctx context.Context, user entity.User) error {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/UserAdaptor/Update").End()
+
+	txq := a.queriesFromContext(ctx)
+
+	_, err := txq.UpdateUser(ctx, database.UpdateUserParams{
+		GivenName:     user.GivenName,
+		FamilyName:    user.FamilyName,
+		Email:         user.Email,
+		EmailVerified: user.EmailVerified,
+		DeletedAt:     toNullTime(user.DeletedAt),
+		Role:          string(user.Role),
+		DisabledAt:    toNullTime(user.DisabledAt),
+		ID:            user.ID[:],
+	})
+	if err != nil {
+		return apperr.New(fmt.Sprintf("update user %q", user.ID), "failed to update user", apperr.WithCause(err))
+	}
+	return nil
+}
+
+// Search finds users whose email, given name or family name starts with given query.
+func (a *UserAdaptor) Search(ctx context.Context, query string, next uuid.UUID, limit int32) (entity.Page[entity.User], error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/UserAdaptor/Search").End()
+
+	txq := a.queriesFromContext(ctx)
+
+	// Empty id means first page.
+	id := []byte{}
+	if next != uuid.Nil {
+		id = next[:]
+	}
+	rows, err := txq.SearchUsers(ctx, database.SearchUsersParams{
+		ID:      id,
+		Pattern: likeEscaper.Replace(query) + "%",
+		Limit:   limit + 1,
+	})
+	if err != nil {
+		return entity.Page[entity.User]{}, apperr.New("search users", "failed to search users", apperr.WithCause(err))
+	}
+	users := make([]entity.User, len(rows))
+	for i, row := range rows {
+		users[i], err = toUser(row)
+		if err != nil {
+			return entity.Page[entity.User]{}, err
+		}
+	}
+	return entity.NewPage(users, limit)
+}
+
+// likeEscaper escapes wildcards of LIKE so that query matches literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// PurgeDeleted permanently deletes users deleted before given time.
+//
+// Target users are locked with SKIP LOCKED, so concurrent callers purge disjoint users.
+// Rows owned by users are deleted by cascade of foreign keys, and rows referring their tasks are deleted explicitly.
+func (a *UserAdaptor) PurgeDeleted(ctx context.Context, before time.Time, limit int32) (int64, error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/UserAdaptor/PurgeDeleted").End()
+
+	txq := a.queriesFromContext(ctx)
+
+	ids, err := txq.ListPurgeableUserIDs(ctx, database.ListPurgeableUserIDsParams{DeletedAt: toNullTime(before), Limit: limit})
+	if err != nil {
+		return 0, apperr.New("list purgeable user ids", "failed to purge users", apperr.WithCause(err))
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	// Tasks owned by users are deleted by cascade, but rows referring them are not since they have no foreign key to tasks.
+	ownerIDs := make([]sql.NullString, len(ids))
+	for i, id := range ids {
+		ownerIDs[i] = sql.NullString{String: string(id), Valid: true}
+	}
+	err = txq.DeleteTaskMentionsOfOwners(ctx, database.DeleteTaskMentionsOfOwnersParams{OwnerIds: ownerIDs, ArchiveOwnerIds: ownerIDs})
+	if err != nil {
+		return 0, apperr.New("delete mentions in tasks of purgeable users", "failed to purge users", apperr.WithCause(err))
+	}
+	err = txq.DeleteTimeEntriesOfOwners(ctx, database.DeleteTimeEntriesOfOwnersParams{OwnerIds: ownerIDs, ArchiveOwnerIds: ownerIDs})
+	if err != nil {
+		return 0, apperr.New("delete time entries on tasks of purgeable users", "failed to purge users", apperr.WithCause(err))
+	}
+	n, err := txq.DeleteUsers(ctx, ids)
+	if err != nil {
+		return 0, apperr.New("delete purgeable users", "failed to purge users", apperr.WithCause(err))
+	}
+	return n, nil
+}
+
+func toUser(row database.User) (entity.User, error) {
+	uid, err := uuid.FromBytes(row.ID)
+	if err != nil {
+		return entity.User{}, apperr.New(fmt.Sprintf("raw user id(%s) to uuid", string(row.ID)), "failed to find user", apperr.WithCause(err))
+	}
+	return entity.User{
+		ID:            uid,
+		Sub:           row.Sub,
+		FamilyName:    row.FamilyName,
+		GivenName:     row.GivenName,
+		Email:         row.Email,
+		EmailVerified: row.EmailVerified,
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+		DeletedAt:     row.DeletedAt.Time,
+		Role:          entity.Role(row.Role),
+		DisabledAt:    row.DisabledAt.Time,
+	}, nil
+}
+
 var _ repository.UserRepository = (*UserAdaptor)(nil)
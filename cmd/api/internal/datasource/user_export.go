@@ -0,0 +1,106 @@
+package datasource
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"go-playground/cmd/api/internal/datasource/database"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/domain/repository"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// UserExportAdaptor is implementation of [repository.UserExportRepository].
+type UserExportAdaptor struct {
+	base
+}
+
+// NewUserExportAdaptor creates UserExportAdaptor.
+func NewUserExportAdaptor(db *sqlx.DB) *UserExportAdaptor {
+	return &UserExportAdaptor{base: base{db: db}}
+}
+
+// Create inserts given user export.
+func (a *UserExportAdaptor) Create(ctx context.Context, export entity.UserExport) error {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/UserExportAdaptor/Create").End()
+
+	queries := a.queriesFromContext(ctx)
+	_, err := queries.CreateUserExport(ctx, database.CreateUserExportParams{
+		ID:     export.ID,
+		UserID: export.UserID[:],
+		Status: string(export.Status),
+	})
+	if err != nil {
+		return apperr.New(fmt.Sprintf("create user export %q", export.ID), "failed to request export", apperr.WithCause(err))
+	}
+	return nil
+}
+
+// FindByID finds user export by given id.
+func (a *UserExportAdaptor) FindByID(ctx context.Context, id entity.UserExportID) (entity.UserExport, error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/UserExportAdaptor/FindByID").End()
+
+	queries := a.queriesFromContext(ctx)
+	row, err := queries.FindUserExport(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.UserExport{}, apperr.New(fmt.Sprintf("find user export by id %q", id), "Export not found", apperr.WithMessageID(message.ExportNotFound), apperr.WithCause(err), apperr.CodeNotFound)
+		}
+		return entity.UserExport{}, apperr.New(fmt.Sprintf("find user export by id %q", id), "failed to find export", apperr.WithCause(err))
+	}
+	return toUserExport(row)
+}
+
+// FindPendingForUpdate finds and locks oldest pending user export.
+func (a *UserExportAdaptor) FindPendingForUpdate(ctx context.Context) (entity.UserExport, error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/UserExportAdaptor/FindPendingForUpdate").End()
+
+	queries := a.queriesFromContext(ctx)
+	row, err := queries.FindPendingUserExportForUpdate(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.UserExport{}, apperr.New("find pending user export but no export is pending", "Export not found", apperr.WithMessageID(message.ExportNotFound), apperr.WithCause(err), apperr.WithoutStackTrace(), apperr.CodeNotFound)
+		}
+		return entity.UserExport{}, apperr.New("find pending user export", "failed to find export", apperr.WithCause(err))
+	}
+	return toUserExport(row)
+}
+
+// Update updates status and content of given user export.
+func (a *UserExportAdaptor) Update(ctx context.Context, export entity.UserExport) error {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/UserExportAdaptor/Update").End()
+
+	queries := a.queriesFromContext(ctx)
+	_, err := queries.UpdateUserExport(ctx, database.UpdateUserExportParams{
+		Status:  string(export.Status),
+		Content: export.Content,
+		ID:      export.ID,
+	})
+	if err != nil {
+		return apperr.New(fmt.Sprintf("update user export %q", export.ID), "failed to update export", apperr.WithCause(err))
+	}
+	return nil
+}
+
+func toUserExport(row database.UserExport) (entity.UserExport, error) {
+	uid, err := uuid.FromBytes(row.UserID)
+	if err != nil {
+		return entity.UserExport{}, apperr.New(fmt.Sprintf("raw user id of user export %q to uuid", row.ID), "failed to find export", apperr.WithCause(err))
+	}
+	return entity.UserExport{
+		ID:        row.ID,
+		UserID:    uid,
+		Status:    entity.UserExportStatus(row.Status),
+		Content:   row.Content,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}, nil
+}
+
+var _ repository.UserExportRepository = (*UserExportAdaptor)(nil)
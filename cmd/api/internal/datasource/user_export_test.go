@@ -0,0 +1,54 @@
+package datasource_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/datasource"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/testhelper"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserExportAdaptor(t *testing.T) {
+	user := entity.User{ID: testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812")}
+	adaptor := datasource.NewUserExportAdaptor(db)
+	runInTx(t, func(ctx context.Context) {
+		_, err := adaptor.FindPendingForUpdate(ctx)
+		assert.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+
+		export, err := entity.NewUserExport(user.ID)
+		require.NoError(t, err)
+		require.NoError(t, adaptor.Create(ctx, export))
+
+		pending, err := adaptor.FindPendingForUpdate(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, cmp.Diff(export, pending, cmpopts.IgnoreFields(entity.UserExport{}, "CreatedAt", "UpdatedAt")))
+
+		require.NoError(t, pending.Complete(entity.NewUserExportContent(user, nil, nil, nil, time.Date(2024, 12, 3, 0, 0, 0, 0, time.UTC))))
+		require.NoError(t, adaptor.Update(ctx, pending))
+
+		got, err := adaptor.FindByID(ctx, export.ID)
+		require.NoError(t, err)
+		assert.Equal(t, entity.UserExportStatusReady, got.Status)
+		assert.JSONEq(t, string(pending.Content), string(got.Content))
+		_, err = adaptor.FindPendingForUpdate(ctx)
+		assert.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+	})
+}
+
+func TestUserExportAdaptor_FindByID_NotFound(t *testing.T) {
+	adaptor := datasource.NewUserExportAdaptor(db)
+	runInTx(t, func(ctx context.Context) {
+		got, err := adaptor.FindByID(ctx, "00000000-0000-7000-8000-000000000000")
+
+		assert.Zero(t, got)
+		assert.EqualError(t, err, `find user export by id "00000000-0000-7000-8000-000000000000": sql: no rows in result set`)
+		assert.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+	})
+}
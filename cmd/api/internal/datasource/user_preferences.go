@@ -0,0 +1,71 @@
+package datasource
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"go-playground/cmd/api/internal/datasource/database"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/domain/repository"
+	"go-playground/pkg/apperr"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// UserPreferencesAdaptor is implementation of [repository.UserPreferencesRepository].
+type UserPreferencesAdaptor struct {
+	base
+}
+
+// NewUserPreferencesAdaptor creates UserPreferencesAdaptor.
+func NewUserPreferencesAdaptor(db *sqlx.DB) *UserPreferencesAdaptor {
+	return &UserPreferencesAdaptor{base: base{db: db}}
+}
+
+// Find finds preferences of given user.
+func (a *UserPreferencesAdaptor) Find(ctx context.Context, userID uuid.UUID) (entity.UserPreferences, error) {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/UserPreferencesAdaptor/Find").End()
+
+	queries := a.queriesFromContext(ctx)
+	row, err := queries.FindUserPreferences(ctx, userID[:])
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// Most users never save preferences, and they are looked up on every request.
+			return entity.UserPreferences{}, apperr.New(fmt.Sprintf("find preferences of user %q", userID), "not found preferences", apperr.WithCause(err), apperr.WithoutStackTrace(), apperr.CodeNotFound)
+		}
+		return entity.UserPreferences{}, apperr.New(fmt.Sprintf("find preferences of user %q", userID), "failed to find preferences", apperr.WithCause(err))
+	}
+	return entity.UserPreferences{
+		UserID:    userID,
+		Timezone:  row.Timezone,
+		Locale:    row.Locale,
+		PageSize:  row.PageSize,
+		WeekStart: time.Weekday(row.WeekStart),
+		UpdatedAt: row.UpdatedAt,
+	}, nil
+}
+
+// Save inserts or updates given preferences.
+func (a *UserPreferencesAdaptor) Save(ctx context.Context, p entity.UserPreferences) error {
+	defer newrelic.FromContext(ctx).StartSegment("datasource/UserPreferencesAdaptor/Save").End()
+
+	queries := a.queriesFromContext(ctx)
+	err := queries.SaveUserPreferences(ctx, database.SaveUserPreferencesParams{
+		UserID:    p.UserID[:],
+		Timezone:  p.Timezone,
+		Locale:    p.Locale,
+		PageSize:  p.PageSize,
+		WeekStart: int8(p.WeekStart),
+		UpdatedAt: p.UpdatedAt,
+	})
+	if err != nil {
+		return apperr.New(fmt.Sprintf("save preferences of user %q", p.UserID), "failed to save preferences", apperr.WithCause(err))
+	}
+	return nil
+}
+
+var _ repository.UserPreferencesRepository = (*UserPreferencesAdaptor)(nil)
@@ -0,0 +1,38 @@
+package datasource_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/datasource"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/testhelper"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserPreferencesAdaptor(t *testing.T) {
+	userID := testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812")
+	now := time.Now().UTC().Truncate(time.Second)
+	adaptor := datasource.NewUserPreferencesAdaptor(db)
+	runInTx(t, func(ctx context.Context) {
+		_, err := adaptor.Find(ctx, userID)
+		assert.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+
+		p, err := entity.NewUserPreferences(userID, "America/New_York", "en-US", 50, time.Sunday, now)
+		require.NoError(t, err)
+		require.NoError(t, adaptor.Save(ctx, p))
+		got, err := adaptor.Find(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, p, got)
+
+		p, err = entity.NewUserPreferences(userID, "Europe/Paris", "fr-FR", 20, time.Monday, now.Add(time.Minute))
+		require.NoError(t, err)
+		require.NoError(t, adaptor.Save(ctx, p))
+		got, err = adaptor.Find(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, p, got)
+	})
+}
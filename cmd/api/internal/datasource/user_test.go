@@ -2,6 +2,7 @@ package datasource_test
 
 import (
 	"context"
+	"fmt"
 	"go-playground/cmd/api/internal/datasource"
 	"go-playground/cmd/api/internal/domain/entity"
 	"go-playground/cmd/api/internal/domain/entity/entitytest"
@@ -12,6 +13,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -41,6 +44,7 @@ func TestUserAdaptor_FindByID(t *testing.T) {
 					EmailVerified: true,
 					CreatedAt:     time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC),
 					UpdatedAt:     time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC),
+					Role:          entity.RoleUser,
 				},
 			},
 		},
@@ -85,7 +89,7 @@ func TestUserAdaptor_Create(t *testing.T) {
 		},
 		"failure duplicate sub": {
 			input: input{user: entitytest.TestUser(t, func(u *entity.User) { u.Sub = "80dbb87a-5ce8-4b45-85a0-3b8aec488b7a" })},
-			want:  want{err: "create user but user is already exist: Error 1062 (23000): Duplicate entry '80dbb87a-5ce8-4b45-85a0-3b8aec488b7a' for key 'users.idx_sub'", errCode: apperr.CodeInvalidArgument},
+			want:  want{err: "create user but user is already exist: Error 1062 (23000): Duplicate entry '80dbb87a-5ce8-4b45-85a0-3b8aec488b7a' for key 'users.idx_sub'", errCode: apperr.CodeAlreadyExists},
 		},
 	}
 	adaptor := datasource.NewUserAdaptor(db)
@@ -109,3 +113,232 @@ func TestUserAdaptor_Create(t *testing.T) {
 	}
 
 }
+
+func TestUserAdaptor_ListByEmails(t *testing.T) {
+	tests := map[string]struct {
+		input []string
+		want  []uuid.UUID
+	}{
+		"success": {
+			input: []string{"jonathan74@example.com", "unknown@example.com"},
+			want:  []uuid.UUID{testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812")},
+		},
+		"empty": {
+			input: nil,
+			want:  []uuid.UUID{},
+		},
+	}
+	adaptor := datasource.NewUserAdaptor(db)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			runInTx(t, func(ctx context.Context) {
+				got, err := adaptor.ListByEmails(ctx, tc.input)
+
+				require.NoError(t, err)
+				ids := make([]uuid.UUID, len(got))
+				for i, u := range got {
+					ids[i] = u.ID
+				}
+				assert.Equal(t, tc.want, ids)
+			})
+		})
+	}
+}
+
+func TestUserAdaptor_Update(t *testing.T) {
+	adaptor := datasource.NewUserAdaptor(db)
+	runInTx(t, func(ctx context.Context) {
+		user, err := adaptor.FindBySubForUpdate(ctx, "80dbb87a-5ce8-4b45-85a0-3b8aec488b7a")
+		require.NoError(t, err)
+		email := "Jonathan75@example.com"
+		_, err = user.ApplyProfilePatch(entity.UserProfilePatch{Email: &email})
+		require.NoError(t, err)
+
+		err = adaptor.Update(ctx, user)
+
+		require.NoError(t, err)
+		got, err := adaptor.FindBySub(ctx, "80dbb87a-5ce8-4b45-85a0-3b8aec488b7a")
+		require.NoError(t, err)
+		diff := cmp.Diff(user, got, cmpopts.IgnoreFields(entity.User{}, "UpdatedAt"))
+		assert.Empty(t, diff)
+		assert.False(t, got.EmailVerified)
+	})
+}
+
+func TestUserAdaptor_FindBySubForUpdate_NotFound(t *testing.T) {
+	adaptor := datasource.NewUserAdaptor(db)
+	runInTx(t, func(ctx context.Context) {
+		got, err := adaptor.FindBySubForUpdate(ctx, "invalid-sub")
+
+		assert.Zero(t, got)
+		assert.EqualError(t, err, "find user by sub for update but result set is zero: sql: no rows in result set")
+		assert.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+	})
+}
+
+func TestUserAdaptor_PurgeDeleted(t *testing.T) {
+	tests := map[string]struct {
+		before time.Time
+		want   int64
+	}{
+		"purge deleted user": {
+			before: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			want:   1,
+		},
+		"nothing to purge": {
+			before: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			want:   0,
+		},
+	}
+	adaptor := datasource.NewUserAdaptor(db)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			runInTx(t, func(ctx context.Context) {
+				got, err := adaptor.PurgeDeleted(ctx, tc.before, 10)
+
+				require.NoError(t, err)
+				assert.Equal(t, tc.want, got)
+				_, err = adaptor.FindBySub(ctx, "5b0f4a3e-8d1c-4c6e-9f2a-7e3d1b5a9c04")
+				assert.Equal(t, tc.want == 1, apperr.IsCode(err, apperr.CodeNotFound))
+				_, err = adaptor.FindBySub(ctx, "80dbb87a-5ce8-4b45-85a0-3b8aec488b7a")
+				assert.NoError(t, err)
+			})
+		})
+	}
+}
+
+func TestUserAdaptor_PurgeDeleted_taskReferences(t *testing.T) {
+	deleted := testhelper.UUIDFromString(t, "01930c3b-e82b-700a-b41a-6f58b5c2b813")
+	other := testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812")
+	archived := entity.Task{ID: "019a2b3c-0000-7000-8000-000000000001", Content: "archived task of deleted user", OwnerID: deleted}
+	active := entity.Task{ID: "019a2b3c-0000-7000-8000-000000000002", Content: "active task of deleted user", OwnerID: deleted}
+	adaptor := datasource.NewUserAdaptor(db)
+	taskAdaptor := datasource.NewTaskAdaptor(db)
+	taskArchiveAdaptor := datasource.NewTaskArchiveAdaptor(db)
+	mentionAdaptor := datasource.NewMentionAdaptor(db)
+	timeEntryAdaptor := datasource.NewTimeEntryAdaptor(db)
+	runInTx(t, func(ctx context.Context) {
+		require.NoError(t, taskAdaptor.Create(ctx, archived))
+		_, err := taskArchiveAdaptor.ArchiveOwnedTasks(ctx, deleted, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.NoError(t, taskAdaptor.Create(ctx, active))
+		for i, task := range []entity.Task{archived, active} {
+			require.NoError(t, mentionAdaptor.ReplaceTaskMentions(ctx, task.ID, []uuid.UUID{other}))
+			require.NoError(t, timeEntryAdaptor.Create(ctx, entity.TimeEntry{
+				ID:        fmt.Sprintf("019a2b3d-0000-7000-8000-00000000000%d", i),
+				TaskID:    task.ID,
+				UserID:    other,
+				StartedAt: time.Date(2024, 12, 3, i, 0, 0, 0, time.UTC),
+				EndedAt:   time.Date(2024, 12, 3, i, 30, 0, 0, time.UTC),
+			}))
+		}
+
+		got, err := adaptor.PurgeDeleted(ctx, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), got)
+		tx := ctx.Value(datasource.TransactionContextKey{}).(*sqlx.Tx)
+		var mentions, entries int
+		require.NoError(t, tx.GetContext(ctx, &mentions, "SELECT COUNT(*) FROM task_mentions WHERE task_id IN (?, ?)", archived.ID, active.ID))
+		assert.Zero(t, mentions, "mentions in tasks of purged user must be deleted")
+		require.NoError(t, tx.GetContext(ctx, &entries, "SELECT COUNT(*) FROM time_entries WHERE task_id IN (?, ?)", archived.ID, active.ID))
+		assert.Zero(t, entries, "time entries on tasks of purged user must be deleted")
+		require.NoError(t, tx.GetContext(ctx, &mentions, "SELECT COUNT(*) FROM task_mentions"))
+		assert.Equal(t, 2, mentions, "mentions in tasks of other users must be kept")
+	})
+}
+
+func TestUserAdaptor_FindByID_DeletedUser(t *testing.T) {
+	adaptor := datasource.NewUserAdaptor(db)
+	runInTx(t, func(ctx context.Context) {
+		got, err := adaptor.FindByID(ctx, testhelper.UUIDFromString(t, "01930c3b-e82b-700a-b41a-6f58b5c2b813"))
+
+		require.NoError(t, err)
+		assert.Equal(t, entity.User{
+			ID:            testhelper.UUIDFromString(t, "01930c3b-e82b-700a-b41a-6f58b5c2b813"),
+			Sub:           "5b0f4a3e-8d1c-4c6e-9f2a-7e3d1b5a9c04",
+			GivenName:     "Deleted",
+			FamilyName:    "User",
+			Email:         "deleted@example.com",
+			EmailVerified: true,
+			CreatedAt:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:     time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			DeletedAt:     time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			Role:          entity.RoleUser,
+		}, got)
+
+		_, err = adaptor.FindByID(ctx, testhelper.UUIDFromString(t, "00000000-0000-7000-8000-000000000000"))
+		assert.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+	})
+}
+
+func TestUserAdaptor_Search(t *testing.T) {
+	type input struct {
+		query string
+		next  uuid.UUID
+		limit int32
+	}
+	type want struct {
+		ids     []uuid.UUID
+		hasNext bool
+	}
+	tests := map[string]struct {
+		input input
+		want  want
+	}{
+		"all users": {
+			input: input{limit: 10},
+			want: want{ids: []uuid.UUID{
+				testhelper.UUIDFromString(t, "01930c3c-e82b-700a-b41a-6f58b5c2b814"),
+				testhelper.UUIDFromString(t, "01930c3b-e82b-700a-b41a-6f58b5c2b813"),
+				testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812"),
+			}},
+		},
+		"paginated": {
+			input: input{next: testhelper.UUIDFromString(t, "01930c3b-e82b-700a-b41a-6f58b5c2b813"), limit: 1},
+			want: want{
+				ids:     []uuid.UUID{testhelper.UUIDFromString(t, "01930c3b-e82b-700a-b41a-6f58b5c2b813")},
+				hasNext: true,
+			},
+		},
+		"match email prefix": {
+			input: input{query: "jonathan", limit: 10},
+			want:  want{ids: []uuid.UUID{testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812")}},
+		},
+		"match family name prefix": {
+			input: input{query: "Kozey", limit: 10},
+			want:  want{ids: []uuid.UUID{testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812")}},
+		},
+		"wildcard is matched literally": {
+			input: input{query: "%", limit: 10},
+			want:  want{ids: []uuid.UUID{}},
+		},
+	}
+	adaptor := datasource.NewUserAdaptor(db)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			runInTx(t, func(ctx context.Context) {
+				got, err := adaptor.Search(ctx, tc.input.query, tc.input.next, tc.input.limit)
+
+				require.NoError(t, err)
+				ids := make([]uuid.UUID, len(got.Items))
+				for i, u := range got.Items {
+					ids[i] = u.ID
+				}
+				assert.Equal(t, tc.want.ids, ids)
+				assert.Equal(t, tc.want.hasNext, got.HasNext)
+			})
+		})
+	}
+}
+
+func TestUserAdaptor_FindBySub_DisabledUser(t *testing.T) {
+	adaptor := datasource.NewUserAdaptor(db)
+	runInTx(t, func(ctx context.Context) {
+		got, err := adaptor.FindBySub(ctx, "c2a7d3e1-6f4b-4a8e-b1d2-9e8f7a6b5c43")
+
+		require.NoError(t, err)
+		assert.Equal(t, entity.RoleSupport, got.Role)
+		assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), got.DisabledAt)
+	})
+}
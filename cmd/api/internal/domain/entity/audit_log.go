@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"go-playground/pkg/apperr"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditAction is kind of action recorded in audit log.
+type AuditAction string
+
+const (
+	// AuditActionUserEmailChanged records user changed own email.
+	AuditActionUserEmailChanged AuditAction = "user.email_changed"
+	// AuditActionUserEmailVerified records user verified own email.
+	AuditActionUserEmailVerified AuditAction = "user.email_verified"
+	// AuditActionUserDeleted records user requested own deletion.
+	AuditActionUserDeleted AuditAction = "user.deleted"
+	// AuditActionUserRestored records user canceled own deletion.
+	AuditActionUserRestored AuditAction = "user.restored"
+	// AuditActionPersonalAccessTokenCreated records user created own personal access token.
+	AuditActionPersonalAccessTokenCreated AuditAction = "personal_access_token.created"
+	// AuditActionPersonalAccessTokenRevoked records user revoked own personal access token.
+	AuditActionPersonalAccessTokenRevoked AuditAction = "personal_access_token.revoked"
+	// AuditActionOrganizationCreated records user created organization.
+	AuditActionOrganizationCreated AuditAction = "organization.created"
+	// AuditActionOrganizationMemberInvited records member invited user to organization.
+	AuditActionOrganizationMemberInvited AuditAction = "organization.member_invited"
+	// AuditActionOrganizationMemberRemoved records member removed member from organization, or left it.
+	AuditActionOrganizationMemberRemoved AuditAction = "organization.member_removed"
+	// AuditActionAdminUsersSearched records admin listed or searched users.
+	AuditActionAdminUsersSearched AuditAction = "admin.users_searched"
+	// AuditActionAdminUserDisabled records admin disabled user.
+	AuditActionAdminUserDisabled AuditAction = "admin.user_disabled"
+	// AuditActionAdminUserEnabled records admin enabled disabled user.
+	AuditActionAdminUserEnabled AuditAction = "admin.user_enabled"
+	// AuditActionAdminTaskViewed records admin viewed task.
+	AuditActionAdminTaskViewed AuditAction = "admin.task_viewed"
+)
+
+// AuditLog is record of security relevant action.
+//
+// Audit log refers actor and target by id without relation, so that it outlives them.
+type AuditLog struct {
+	ID string
+	// ActorID is id of user who did the action.
+	ActorID uuid.UUID
+	Action  AuditAction
+	// TargetID is id of resource which the action was done to.
+	TargetID string
+	// Detail is additional information depending on action.
+	// Personal data such as email must not be put, since it is kept after user is purged.
+	Detail    map[string]string
+	CreatedAt time.Time
+}
+
+// NewAuditLog creates new audit log.
+func NewAuditLog(actorID uuid.UUID, action AuditAction, targetID string, detail map[string]string) (AuditLog, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return AuditLog{}, apperr.New("uuid new v7 for audit log id", "Failed to record audit log", apperr.WithCause(err))
+	}
+	return AuditLog{
+		ID:        id.String(),
+		ActorID:   actorID,
+		Action:    action,
+		TargetID:  targetID,
+		Detail:    detail,
+		CreatedAt: time.Now(),
+	}, nil
+}
@@ -0,0 +1,90 @@
+package entity
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmailVerificationLifetime is how long email verification token is valid.
+const EmailVerificationLifetime = 24 * time.Hour
+
+// EmailVerificationMinSecretLength is the shortest secret to sign email verification token.
+const EmailVerificationMinSecretLength = 32
+
+// EmailVerification is claim that user owns email, which is sent to the email as signed token.
+//
+// Token is not stored but signed by HMAC-SHA256, so it can not be revoked before it expires.
+// Token is bound to email, so it is useless once user changes email.
+type EmailVerification struct {
+	UserID    uuid.UUID `json:"uid"`
+	Email     string    `json:"email"`
+	ExpiresAt time.Time `json:"exp"`
+}
+
+// NewEmailVerification creates email verification of current email of given user.
+func NewEmailVerification(user User, now time.Time) (EmailVerification, error) {
+	if user.EmailVerified {
+		return EmailVerification{}, apperr.New(fmt.Sprintf("request email verification but email of user %q is already verified", user.ID), "Email is already verified", apperr.WithMessageID(message.EmailAlreadyVerified), apperr.CodeFailedPrecondition)
+	}
+	return EmailVerification{
+		UserID:    user.ID,
+		Email:     user.Email,
+		ExpiresAt: now.Add(EmailVerificationLifetime),
+	}, nil
+}
+
+// Sign signs email verification with secret and returns token.
+func (v EmailVerification) Sign(secret []byte) (string, error) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return "", apperr.New("marshal email verification", "Failed to create email verification", apperr.WithCause(err))
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(signEmailVerification(encoded, secret)), nil
+}
+
+// ParseEmailVerification verifies signature and expiry of token signed with secret and returns email verification.
+func ParseEmailVerification(token string, secret []byte, now time.Time) (EmailVerification, error) {
+	encoded, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return EmailVerification{}, invalidEmailVerification("email verification token is malformed", nil)
+	}
+	decodedSig, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil {
+		return EmailVerification{}, invalidEmailVerification("decode signature of email verification token", err)
+	}
+	if !hmac.Equal(decodedSig, signEmailVerification(encoded, secret)) {
+		return EmailVerification{}, invalidEmailVerification("signature of email verification token is invalid", nil)
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return EmailVerification{}, invalidEmailVerification("decode payload of email verification token", err)
+	}
+	var v EmailVerification
+	if err := json.Unmarshal(payload, &v); err != nil {
+		return EmailVerification{}, invalidEmailVerification("unmarshal payload of email verification token", err)
+	}
+	if !now.Before(v.ExpiresAt) {
+		return EmailVerification{}, invalidEmailVerification(fmt.Sprintf("email verification token expired at %s", v.ExpiresAt), nil)
+	}
+	return v, nil
+}
+
+func signEmailVerification(encoded string, secret []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(encoded))
+	return mac.Sum(nil)
+}
+
+func invalidEmailVerification(text string, cause error) error {
+	return apperr.New(text, "Invalid or expired verification token", apperr.WithMessageID(message.InvalidVerificationToken), apperr.WithCause(cause), apperr.CodeInvalidArgument)
+}
@@ -0,0 +1,96 @@
+package entity_test
+
+import (
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/pkg/apperr"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEmailVerification(t *testing.T) {
+	now := time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC)
+	userID := uuid.MustParse("0193196b-28c4-7337-a891-e728860339cd")
+
+	t.Run("success", func(t *testing.T) {
+		got, err := entity.NewEmailVerification(entity.User{ID: userID, Email: "user@example.com"}, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, entity.EmailVerification{UserID: userID, Email: "user@example.com", ExpiresAt: now.Add(entity.EmailVerificationLifetime)}, got)
+	})
+	t.Run("failure already verified", func(t *testing.T) {
+		_, err := entity.NewEmailVerification(entity.User{ID: userID, Email: "user@example.com", EmailVerified: true}, now)
+
+		assert.EqualError(t, err, `request email verification but email of user "0193196b-28c4-7337-a891-e728860339cd" is already verified`)
+		assert.True(t, apperr.IsCode(err, apperr.CodeFailedPrecondition))
+	})
+}
+
+func TestParseEmailVerification(t *testing.T) {
+	now := time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC)
+	secret := []byte(strings.Repeat("s", entity.EmailVerificationMinSecretLength))
+	verification := entity.EmailVerification{
+		UserID:    uuid.MustParse("0193196b-28c4-7337-a891-e728860339cd"),
+		Email:     "user@example.com",
+		ExpiresAt: now.Add(time.Hour),
+	}
+	token, err := verification.Sign(secret)
+	require.NoError(t, err)
+	payload, _, _ := strings.Cut(token, ".")
+
+	tests := map[string]struct {
+		token   string
+		secret  []byte
+		now     time.Time
+		wantErr string
+	}{
+		"success": {
+			token:  token,
+			secret: secret,
+			now:    now,
+		},
+		"failure expired": {
+			token:   token,
+			secret:  secret,
+			now:     now.Add(time.Hour),
+			wantErr: "email verification token expired at 2024-11-08 15:40:33 +0000 UTC",
+		},
+		"failure signed with other secret": {
+			token:   token,
+			secret:  []byte(strings.Repeat("o", entity.EmailVerificationMinSecretLength)),
+			now:     now,
+			wantErr: "signature of email verification token is invalid",
+		},
+		"failure tampered payload": {
+			token:   "eyJ1aWQiOiIwMTkzMTk2Yi0yOGM0LTczMzctYTg5MS1lNzI4ODYwMzM5Y2QiLCJlbWFpbCI6Im90aGVyQGV4YW1wbGUuY29tIn0" + strings.TrimPrefix(token, payload),
+			secret:  secret,
+			now:     now,
+			wantErr: "signature of email verification token is invalid",
+		},
+		"failure malformed": {
+			token:   "malformed",
+			secret:  secret,
+			now:     now,
+			wantErr: "email verification token is malformed",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := entity.ParseEmailVerification(tc.token, tc.secret, tc.now)
+
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				assert.True(t, apperr.IsCode(err, apperr.CodeInvalidArgument))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, verification.UserID, got.UserID)
+			assert.Equal(t, verification.Email, got.Email)
+			assert.True(t, verification.ExpiresAt.Equal(got.ExpiresAt))
+		})
+	}
+}
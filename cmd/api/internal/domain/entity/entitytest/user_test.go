@@ -22,6 +22,7 @@ func TestTestUser(t *testing.T) {
 				FamilyName:    "Sons",
 				Email:         "Emmett.Veum61@example.com",
 				EmailVerified: true,
+				Role:          entity.RoleUser,
 			},
 		},
 		"success with override": {
@@ -40,6 +41,7 @@ func TestTestUser(t *testing.T) {
 				FamilyName:    "override-familyName",
 				Email:         "Miguel41@example.com",
 				EmailVerified: false,
+				Role:          entity.RoleUser,
 			},
 		},
 	}
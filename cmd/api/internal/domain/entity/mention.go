@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"strings"
+
+	"golang.org/x/text/width"
+)
+
+// Mention is user mentioned in text by "@username" or "@email".
+type Mention struct {
+	// Username is set if user is mentioned by username.
+	Username string
+	// Email is set if user is mentioned by email.
+	Email string
+}
+
+// ParseMentions extracts unique mentions from given text in order of appearance.
+//
+// Full-width characters such as "＠" are folded to ASCII before parsing.
+// Mention does not need preceding space since Japanese text has no spaces between words,
+// but "@" preceded by ASCII word character is a part of email and not a mention.
+// Mentions are normalized to lower case.
+//
+// Both "@username" and "@email" are parsed, but only mentions by email are resolved to users since users have no username yet.
+func ParseMentions(text string) []Mention {
+	text = width.Fold.String(text)
+	var mentions []Mention
+	seen := make(map[Mention]struct{})
+	for i := 0; i < len(text); i++ {
+		if text[i] != '@' || (i > 0 && isLocalPartByte(text[i-1])) {
+			continue
+		}
+		local := takeWhile(text[i+1:], isLocalPartByte)
+		end := i + 1 + len(local)
+		var m Mention
+		if end < len(text) && text[end] == '@' && local != "" {
+			domain := strings.TrimRight(takeWhile(text[end+1:], isDomainByte), ".-")
+			if strings.Contains(domain, ".") {
+				m.Email = strings.ToLower(local + "@" + domain)
+				end += 1 + len(domain)
+			}
+		}
+		if m.Email == "" {
+			username := strings.TrimRight(local, ".")
+			if username == "" {
+				continue
+			}
+			m.Username = strings.ToLower(username)
+		}
+		if _, ok := seen[m]; !ok {
+			seen[m] = struct{}{}
+			mentions = append(mentions, m)
+		}
+		i = end - 1
+	}
+	return mentions
+}
+
+// isLocalPartByte reports whether b is allowed in username or local part of email.
+func isLocalPartByte(b byte) bool {
+	return isAlnumByte(b) || strings.IndexByte("._%+-", b) >= 0
+}
+
+func isDomainByte(b byte) bool {
+	return isAlnumByte(b) || b == '.' || b == '-'
+}
+
+func isAlnumByte(b byte) bool {
+	return 'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z' || '0' <= b && b <= '9'
+}
+
+func takeWhile(s string, f func(byte) bool) string {
+	for i := 0; i < len(s); i++ {
+		if !f(s[i]) {
+			return s[:i]
+		}
+	}
+	return s
+}
@@ -0,0 +1,49 @@
+package entity_test
+
+import (
+	"go-playground/cmd/api/internal/domain/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMentions(t *testing.T) {
+	tests := map[string]struct {
+		input string
+		want  []entity.Mention
+	}{
+		"username": {
+			input: "ask @Taro and @hanako.",
+			want:  []entity.Mention{{Username: "taro"}, {Username: "hanako"}},
+		},
+		"email": {
+			input: "cc @Jonathan74@example.com.",
+			want:  []entity.Mention{{Email: "jonathan74@example.com"}},
+		},
+		"full-width at sign in japanese text": {
+			input: "確認お願いします＠taroさん、＠ｊｏｎａｔｈａｎ７４＠ｅｘａｍｐｌｅ．ｃｏｍ",
+			want:  []entity.Mention{{Username: "taro"}, {Email: "jonathan74@example.com"}},
+		},
+		"plain email is not mention": {
+			input: "send to jonathan74@example.com",
+		},
+		"username followed by at sign without domain": {
+			input: "@taro@ is here",
+			want:  []entity.Mention{{Username: "taro"}},
+		},
+		"duplicated": {
+			input: "@taro @TARO ＠taro",
+			want:  []entity.Mention{{Username: "taro"}},
+		},
+		"at sign only": {
+			input: "@ ＠",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := entity.ParseMentions(tc.input)
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
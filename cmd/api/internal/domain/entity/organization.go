@@ -0,0 +1,131 @@
+package entity
+
+import (
+	"fmt"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+// OrganizationID is identifier of organization entity.
+type OrganizationID = string
+
+// MaxOrganizationNameLength is max number of characters of organization name.
+const MaxOrganizationNameLength = 100
+
+// PersonalOrganizationName is name of organization created with user, so that new user can work on tasks at once.
+const PersonalOrganizationName = "Personal"
+
+// Organization is tenancy boundary. Tasks belong to organization and are shared by its members.
+type Organization struct {
+	ID        OrganizationID
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewOrganization creates new organization.
+func NewOrganization(name string, now time.Time) (Organization, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return Organization{}, apperr.New("uuid new v7 for organization id", "Failed to create organization", apperr.WithCause(err))
+	}
+	org := Organization{
+		ID:        id.String(),
+		Name:      strings.TrimSpace(name),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	err = validation.ValidateStruct(
+		&org,
+		validation.Field(&org.Name, validation.Required, validation.RuneLength(1, MaxOrganizationNameLength)),
+	)
+	if err != nil {
+		return Organization{}, validationError("validate organization entity", err)
+	}
+	return org, nil
+}
+
+// OrganizationRole is role of member in organization.
+type OrganizationRole string
+
+const (
+	// OrganizationRoleOwner is creator of organization. Owner can manage any member except owner.
+	OrganizationRoleOwner OrganizationRole = "owner"
+	// OrganizationRoleAdmin can invite members and remove members who are not admin.
+	OrganizationRoleAdmin OrganizationRole = "admin"
+	// OrganizationRoleMember can only work on tasks of organization.
+	OrganizationRoleMember OrganizationRole = "member"
+)
+
+// rank orders roles by privilege. Unknown role has the lowest rank.
+func (r OrganizationRole) rank() int {
+	switch r {
+	case OrganizationRoleOwner:
+		return 3
+	case OrganizationRoleAdmin:
+		return 2
+	case OrganizationRoleMember:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// OrganizationMember is membership of user in organization.
+type OrganizationMember struct {
+	OrganizationID OrganizationID
+	UserID         uuid.UUID
+	Role           OrganizationRole
+	CreatedAt      time.Time
+}
+
+// NewOrganizationOwner creates membership of user who creates organization.
+func NewOrganizationOwner(org Organization, userID uuid.UUID) OrganizationMember {
+	return OrganizationMember{
+		OrganizationID: org.ID,
+		UserID:         userID,
+		Role:           OrganizationRoleOwner,
+		CreatedAt:      org.CreatedAt,
+	}
+}
+
+// Invite creates membership of given user invited by this member with given role.
+//
+// Owner and admin can invite members, and role higher than inviter can not be granted.
+// Owner role can not be granted since organization has only one owner.
+func (m OrganizationMember) Invite(userID uuid.UUID, role OrganizationRole, now time.Time) (OrganizationMember, error) {
+	if role != OrganizationRoleAdmin && role != OrganizationRoleMember {
+		return OrganizationMember{}, apperr.New(fmt.Sprintf("invite member to organization %q with role %q", m.OrganizationID, role), "Role must be admin or member", apperr.WithMessageID(message.InvalidOrganizationRole), apperr.CodeInvalidArgument)
+	}
+	if m.Role.rank() < OrganizationRoleAdmin.rank() || m.Role.rank() < role.rank() {
+		return OrganizationMember{}, apperr.New(
+			fmt.Sprintf("user %q of role %q invites member to organization %q with role %q", m.UserID, m.Role, m.OrganizationID, role),
+			"You are not allowed to invite member with this role",
+			apperr.WithMessageID(message.InviteRoleNotAllowed),
+			apperr.CodeUnAuthz,
+		)
+	}
+	return OrganizationMember{
+		OrganizationID: m.OrganizationID,
+		UserID:         userID,
+		Role:           role,
+		CreatedAt:      now,
+	}, nil
+}
+
+// CanRemove reports whether this member can remove target member from organization.
+// Member can leave organization by oneself except owner, and can remove only members of lower role.
+func (m OrganizationMember) CanRemove(target OrganizationMember) bool {
+	if target.Role == OrganizationRoleOwner {
+		return false
+	}
+	if m.UserID == target.UserID {
+		return true
+	}
+	return m.Role.rank() >= OrganizationRoleAdmin.rank() && m.Role.rank() > target.Role.rank()
+}
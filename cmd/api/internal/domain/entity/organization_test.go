@@ -0,0 +1,130 @@
+package entity_test
+
+import (
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/pkg/apperr"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOrganization(t *testing.T) {
+	now := time.Date(2026, 10, 26, 9, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		name     string
+		wantName string
+		wantErr  bool
+	}{
+		"success":                       {name: "Platform team", wantName: "Platform team"},
+		"success: name is trimmed":      {name: "  Platform team ", wantName: "Platform team"},
+		"success: name of max length":   {name: strings.Repeat("a", entity.MaxOrganizationNameLength), wantName: strings.Repeat("a", entity.MaxOrganizationNameLength)},
+		"failure: name is empty":        {name: " ", wantErr: true},
+		"failure: name exceeds maximum": {name: strings.Repeat("a", entity.MaxOrganizationNameLength+1), wantErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := entity.NewOrganization(tc.name, now)
+
+			if tc.wantErr {
+				require.Error(t, err)
+				assert.True(t, apperr.IsCode(err, apperr.CodeInvalidArgument))
+				return
+			}
+			require.NoError(t, err)
+			assert.NoError(t, uuid.Validate(got.ID))
+			assert.Equal(t, tc.wantName, got.Name)
+			assert.Equal(t, now, got.CreatedAt)
+			assert.Equal(t, now, got.UpdatedAt)
+		})
+	}
+}
+
+func TestOrganizationMember_Invite(t *testing.T) {
+	now := time.Date(2026, 10, 26, 9, 0, 0, 0, time.UTC)
+	invitee := uuid.MustParse("0194c3a1-6f0e-7c2b-9d1a-0a1b2c3d4e5f")
+	tests := map[string]struct {
+		inviter  entity.OrganizationRole
+		role     entity.OrganizationRole
+		wantErr  bool
+		wantCode apperr.Code
+	}{
+		"success: owner invites admin":        {inviter: entity.OrganizationRoleOwner, role: entity.OrganizationRoleAdmin},
+		"success: owner invites member":       {inviter: entity.OrganizationRoleOwner, role: entity.OrganizationRoleMember},
+		"success: admin invites admin":        {inviter: entity.OrganizationRoleAdmin, role: entity.OrganizationRoleAdmin},
+		"success: admin invites member":       {inviter: entity.OrganizationRoleAdmin, role: entity.OrganizationRoleMember},
+		"failure: member invites member":      {inviter: entity.OrganizationRoleMember, role: entity.OrganizationRoleMember, wantErr: true, wantCode: apperr.CodeUnAuthz},
+		"failure: owner role can not grant":   {inviter: entity.OrganizationRoleOwner, role: entity.OrganizationRoleOwner, wantErr: true, wantCode: apperr.CodeInvalidArgument},
+		"failure: unknown role can not grant": {inviter: entity.OrganizationRoleOwner, role: "root", wantErr: true, wantCode: apperr.CodeInvalidArgument},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			inviter := entity.OrganizationMember{
+				OrganizationID: "0194c3a1-0000-7000-8000-000000000001",
+				UserID:         uuid.MustParse("01928120-055d-7edb-a12a-2d290512266e"),
+				Role:           tc.inviter,
+			}
+
+			got, err := inviter.Invite(invitee, tc.role, now)
+
+			if tc.wantErr {
+				require.Error(t, err)
+				assert.True(t, apperr.IsCode(err, tc.wantCode))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, entity.OrganizationMember{
+				OrganizationID: inviter.OrganizationID,
+				UserID:         invitee,
+				Role:           tc.role,
+				CreatedAt:      now,
+			}, got)
+		})
+	}
+}
+
+func TestOrganizationMember_CanRemove(t *testing.T) {
+	self := uuid.MustParse("01928120-055d-7edb-a12a-2d290512266e")
+	other := uuid.MustParse("0194c3a1-6f0e-7c2b-9d1a-0a1b2c3d4e5f")
+	tests := map[string]struct {
+		actor  entity.OrganizationMember
+		target entity.OrganizationMember
+		want   bool
+	}{
+		"owner removes admin": {
+			actor:  entity.OrganizationMember{UserID: self, Role: entity.OrganizationRoleOwner},
+			target: entity.OrganizationMember{UserID: other, Role: entity.OrganizationRoleAdmin},
+			want:   true,
+		},
+		"admin removes member": {
+			actor:  entity.OrganizationMember{UserID: self, Role: entity.OrganizationRoleAdmin},
+			target: entity.OrganizationMember{UserID: other, Role: entity.OrganizationRoleMember},
+			want:   true,
+		},
+		"member leaves": {
+			actor:  entity.OrganizationMember{UserID: self, Role: entity.OrganizationRoleMember},
+			target: entity.OrganizationMember{UserID: self, Role: entity.OrganizationRoleMember},
+			want:   true,
+		},
+		"admin can not remove admin": {
+			actor:  entity.OrganizationMember{UserID: self, Role: entity.OrganizationRoleAdmin},
+			target: entity.OrganizationMember{UserID: other, Role: entity.OrganizationRoleAdmin},
+		},
+		"member can not remove member": {
+			actor:  entity.OrganizationMember{UserID: self, Role: entity.OrganizationRoleMember},
+			target: entity.OrganizationMember{UserID: other, Role: entity.OrganizationRoleMember},
+		},
+		"owner can not leave": {
+			actor:  entity.OrganizationMember{UserID: self, Role: entity.OrganizationRoleOwner},
+			target: entity.OrganizationMember{UserID: self, Role: entity.OrganizationRoleOwner},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.actor.CanRemove(tc.target))
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package entity
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/collection"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+// PersonalAccessTokenID is identifier of personal access token entity.
+type PersonalAccessTokenID = string
+
+// PersonalAccessTokenPrefix is prefix of personal access token to distinguish it from JWT.
+const PersonalAccessTokenPrefix = "pat_"
+
+// PersonalAccessTokenMaxLifetime is the longest lifetime of personal access token.
+const PersonalAccessTokenMaxLifetime = 365 * 24 * time.Hour
+
+// PersonalAccessTokenScopes are scopes which can be granted to personal access token.
+var PersonalAccessTokenScopes = []string{"read:tasks", "write:tasks", "read:users", "write:users"}
+
+// PersonalAccessToken is long-lived token issued by user for scripts and CI jobs.
+//
+// Only hash of token is stored, so raw token is shown to user only once when it is created.
+type PersonalAccessToken struct {
+	ID     PersonalAccessTokenID
+	UserID uuid.UUID
+	Name   string
+	// Hash is SHA-256 of raw token.
+	Hash      []byte
+	Scopes    []string
+	ExpiresAt time.Time
+	// RevokedAt is when token is revoked. Zero means token is not revoked.
+	RevokedAt time.Time
+	CreatedAt time.Time
+}
+
+// NewPersonalAccessToken creates new personal access token of given user and returns it with raw token.
+func NewPersonalAccessToken(userID uuid.UUID, name string, scopes []string, expiresAt, now time.Time) (PersonalAccessToken, string, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return PersonalAccessToken{}, "", apperr.New("uuid new v7 for personal access token id", "Failed to create token", apperr.WithCause(err))
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return PersonalAccessToken{}, "", apperr.New("read random bytes for personal access token", "Failed to create token", apperr.WithCause(err))
+	}
+	raw := PersonalAccessTokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+	token := PersonalAccessToken{
+		ID:        id.String(),
+		UserID:    userID,
+		Name:      name,
+		Hash:      HashPersonalAccessToken(raw),
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}
+	if err := token.validate(now); err != nil {
+		return PersonalAccessToken{}, "", err
+	}
+	return token, raw, nil
+}
+
+// HashPersonalAccessToken returns hash of raw token to look up stored token.
+func HashPersonalAccessToken(raw string) []byte {
+	sum := sha256.Sum256([]byte(raw))
+	return sum[:]
+}
+
+// IsPersonalAccessToken reports whether raw bearer token is personal access token.
+func IsPersonalAccessToken(raw string) bool {
+	return strings.HasPrefix(raw, PersonalAccessTokenPrefix)
+}
+
+// Revoked reports whether token is revoked.
+func (t PersonalAccessToken) Revoked() bool {
+	return !t.RevokedAt.IsZero()
+}
+
+// Expired reports whether token is expired at now.
+func (t PersonalAccessToken) Expired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// Revoke revokes token at now. Revoked token can not be used anymore.
+func (t *PersonalAccessToken) Revoke(now time.Time) error {
+	if t.Revoked() {
+		return apperr.New(fmt.Sprintf("revoke personal access token %q but token is already revoked", t.ID), "Token is already revoked", apperr.WithMessageID(message.TokenAlreadyRevoked), apperr.CodeFailedPrecondition)
+	}
+	t.RevokedAt = now
+	return nil
+}
+
+// Verify verifies token can be used at now.
+func (t PersonalAccessToken) Verify(now time.Time) error {
+	if t.Revoked() {
+		return apperr.New(fmt.Sprintf("personal access token %q is revoked", t.ID), "Invalid token", apperr.WithMessageID(message.InvalidToken), apperr.CodeUnAuthn)
+	}
+	if t.Expired(now) {
+		return apperr.New(fmt.Sprintf("personal access token %q is expired at %s", t.ID, t.ExpiresAt), "Invalid token", apperr.WithMessageID(message.InvalidToken), apperr.CodeUnAuthn)
+	}
+	return nil
+}
+
+// validate validates personal access token entity created at now.
+func (t PersonalAccessToken) validate(now time.Time) error {
+	err := validation.ValidateStruct(
+		&t,
+		validation.Field(&t.Name, validation.Required, validation.RuneLength(1, 100)),
+		validation.Field(&t.Scopes, validation.Required, validation.Each(validation.In(collection.SMap(PersonalAccessTokenScopes, func(s string) any { return s })...))),
+		validation.Field(
+			&t.ExpiresAt,
+			validation.Required,
+			validation.Min(now).Exclusive().Error("must be in the future"),
+			validation.Max(now.Add(PersonalAccessTokenMaxLifetime)).Error("must be within a year"),
+		),
+	)
+	if err != nil {
+		return validationError("validate personal access token entity", err)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package entity_test
+
+import (
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/pkg/apperr"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPersonalAccessToken(t *testing.T) {
+	now := time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC)
+	userID := uuid.MustParse("0193196b-28c4-7337-a891-e728860339cd")
+	type input struct {
+		name      string
+		scopes    []string
+		expiresAt time.Time
+	}
+	tests := map[string]struct {
+		input   input
+		wantErr []apperr.FieldError
+	}{
+		"success": {
+			input: input{name: "ci", scopes: []string{"read:tasks", "write:tasks"}, expiresAt: now.Add(30 * 24 * time.Hour)},
+		},
+		"failure blank name": {
+			input:   input{scopes: []string{"read:tasks"}, expiresAt: now.Add(time.Hour)},
+			wantErr: []apperr.FieldError{{Field: "name", Rule: "required", Message: "cannot be blank"}},
+		},
+		"failure no scopes": {
+			input:   input{name: "ci", expiresAt: now.Add(time.Hour)},
+			wantErr: []apperr.FieldError{{Field: "scopes", Rule: "required", Message: "cannot be blank"}},
+		},
+		"failure unknown scope": {
+			input:   input{name: "ci", scopes: []string{"manage:users"}, expiresAt: now.Add(time.Hour)},
+			wantErr: []apperr.FieldError{{Field: "scopes.0", Rule: "in_invalid", Message: "must be a valid value"}},
+		},
+		"failure expired": {
+			input:   input{name: "ci", scopes: []string{"read:tasks"}, expiresAt: now},
+			wantErr: []apperr.FieldError{{Field: "expiresAt", Rule: "min_greater_than_required", Message: "must be in the future"}},
+		},
+		"failure too long lifetime": {
+			input:   input{name: "ci", scopes: []string{"read:tasks"}, expiresAt: now.Add(entity.PersonalAccessTokenMaxLifetime + time.Second)},
+			wantErr: []apperr.FieldError{{Field: "expiresAt", Rule: "max_less_equal_than_required", Message: "must be within a year"}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, raw, err := entity.NewPersonalAccessToken(userID, tc.input.name, tc.input.scopes, tc.input.expiresAt, now)
+
+			if tc.wantErr != nil {
+				assert.Zero(t, got)
+				assert.Empty(t, raw)
+				var appErr *apperr.Error
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, "Some fields are invalid", appErr.ClientMessage())
+				assert.Equal(t, tc.wantErr, appErr.FieldErrors())
+				assert.True(t, apperr.IsCode(err, apperr.CodeInvalidArgument))
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(raw, "pat_"))
+			assert.True(t, entity.IsPersonalAccessToken(raw))
+			assert.Equal(t, entity.HashPersonalAccessToken(raw), got.Hash)
+			assert.Equal(t, userID, got.UserID)
+			assert.Equal(t, tc.input.name, got.Name)
+			assert.Equal(t, tc.input.scopes, got.Scopes)
+			assert.Equal(t, tc.input.expiresAt, got.ExpiresAt)
+			assert.Equal(t, now, got.CreatedAt)
+			assert.False(t, got.Revoked())
+		})
+	}
+}
+
+func TestPersonalAccessToken_Verify(t *testing.T) {
+	now := time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC)
+	tests := map[string]struct {
+		token   entity.PersonalAccessToken
+		wantErr string
+	}{
+		"valid": {
+			token: entity.PersonalAccessToken{ID: "id1", ExpiresAt: now.Add(time.Second)},
+		},
+		"revoked": {
+			token:   entity.PersonalAccessToken{ID: "id1", ExpiresAt: now.Add(time.Second), RevokedAt: now.Add(-time.Second)},
+			wantErr: `personal access token "id1" is revoked`,
+		},
+		"expired": {
+			token:   entity.PersonalAccessToken{ID: "id1", ExpiresAt: now},
+			wantErr: `personal access token "id1" is expired at 2024-11-08 14:40:33 +0000 UTC`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.token.Verify(now)
+
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				assert.True(t, apperr.IsCode(err, apperr.CodeUnAuthn))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPersonalAccessToken_Revoke(t *testing.T) {
+	now := time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC)
+	token := entity.PersonalAccessToken{ID: "id1"}
+
+	err := token.Revoke(now)
+
+	require.NoError(t, err)
+	assert.Equal(t, now, token.RevokedAt)
+
+	err = token.Revoke(now.Add(time.Hour))
+
+	assert.EqualError(t, err, `revoke personal access token "id1" but token is already revoked`)
+	assert.True(t, apperr.IsCode(err, apperr.CodeFailedPrecondition))
+	assert.Equal(t, now, token.RevokedAt)
+}
@@ -0,0 +1,37 @@
+package entity
+
+import "slices"
+
+// Role is role of user which decides what user can do in admin API.
+type Role string
+
+const (
+	// RoleUser is default role. User can only access own resources.
+	RoleUser Role = "user"
+	// RoleSupport can look up users and tasks for support cases.
+	RoleSupport Role = "support"
+	// RoleAdmin can also manage users.
+	RoleAdmin Role = "admin"
+)
+
+// Permission is operation allowed to role in admin API.
+type Permission string
+
+const (
+	// PermissionReadUsers allows listing and searching any users.
+	PermissionReadUsers Permission = "read:users"
+	// PermissionReadTasks allows viewing any task including archived one.
+	PermissionReadTasks Permission = "read:tasks"
+	// PermissionManageUsers allows disabling and enabling users.
+	PermissionManageUsers Permission = "manage:users"
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleSupport: {PermissionReadUsers, PermissionReadTasks},
+	RoleAdmin:   {PermissionReadUsers, PermissionReadTasks, PermissionManageUsers},
+}
+
+// Allows reports whether role is granted given permission.
+func (r Role) Allows(p Permission) bool {
+	return slices.Contains(rolePermissions[r], p)
+}
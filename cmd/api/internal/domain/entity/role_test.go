@@ -0,0 +1,28 @@
+package entity_test
+
+import (
+	"go-playground/cmd/api/internal/domain/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRole_Allows(t *testing.T) {
+	tests := map[string]struct {
+		role       entity.Role
+		permission entity.Permission
+		want       bool
+	}{
+		"user can not read users":      {role: entity.RoleUser, permission: entity.PermissionReadUsers},
+		"support can read users":       {role: entity.RoleSupport, permission: entity.PermissionReadUsers, want: true},
+		"support can read tasks":       {role: entity.RoleSupport, permission: entity.PermissionReadTasks, want: true},
+		"support can not manage users": {role: entity.RoleSupport, permission: entity.PermissionManageUsers},
+		"admin can manage users":       {role: entity.RoleAdmin, permission: entity.PermissionManageUsers, want: true},
+		"unknown role can do nothing":  {role: "root", permission: entity.PermissionReadUsers},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.role.Allows(tc.permission))
+		})
+	}
+}
@@ -3,9 +3,12 @@ package entity
 import (
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
+	"go-playground/cmd/api/internal/message"
 	"go-playground/pkg/apperr"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 )
@@ -13,17 +16,26 @@ import (
 // TaskID is identifier of task entity.
 type TaskID = string
 
+// MaxTaskContentLength is max number of characters of task content.
+// Task content is markdown text.
+const MaxTaskContentLength = 10000
+
 // Task is domain entity.
 type Task struct {
 	ID        TaskID    `json:"id"`
 	Content   string    `json:"content"`
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
+	// Archived reports whether task is moved to archive. Archived task is read only until it is unarchived.
+	Archived bool `json:"archived"`
+	// OwnerID is id of user who created task. It is zero for tasks created before tasks have owners.
+	// Tasks of owner are archived while owner is deleted, and purged with the owner.
+	OwnerID uuid.UUID `json:"-"`
 }
 
-// NewTask creates new task.
-// Return error if content is empty.
-func NewTask(content string) (Task, error) {
+// NewTask creates new task owned by user of given id.
+// Return error if content is empty or too long.
+func NewTask(content string, ownerID uuid.UUID) (Task, error) {
 	err := validateTask(content)
 	if err != nil {
 		return Task{}, err
@@ -38,6 +50,7 @@ func NewTask(content string) (Task, error) {
 		Content:   content,
 		CreatedAt: now,
 		UpdatedAt: now,
+		OwnerID:   ownerID,
 	}, nil
 }
 
@@ -53,7 +66,26 @@ func (t *Task) UpdateContent(newContent string) error {
 
 func validateTask(content string) error {
 	if trimmed := strings.TrimSpace(content); trimmed == "" {
-		return apperr.New("task content must be non empty", "Task content must be non empty", apperr.CodeInvalidArgument)
+		return apperr.New(
+			"task content must be non empty",
+			"Task content must not be empty",
+			apperr.WithMessageID(message.TaskContentEmpty),
+			apperr.WithFieldErrors(apperr.FieldError{Field: "content", Rule: "required", Message: "cannot be blank"}),
+			apperr.CodeInvalidArgument,
+		)
+	}
+	if n := utf8.RuneCountInString(content); n > MaxTaskContentLength {
+		return apperr.New(
+			fmt.Sprintf("task content length %d exceeds %d", n, MaxTaskContentLength),
+			fmt.Sprintf("Task content must be at most %d characters", MaxTaskContentLength),
+			apperr.WithMessageID(message.TaskContentTooLong, MaxTaskContentLength),
+			apperr.WithFieldErrors(apperr.FieldError{
+				Field:   "content",
+				Rule:    "length_too_long",
+				Message: fmt.Sprintf("the length must be no more than %d", MaxTaskContentLength),
+			}),
+			apperr.CodeInvalidArgument,
+		)
 	}
 	return nil
 }
@@ -79,12 +111,12 @@ func DecodeTaskCursor(token string) (TaskCursor, error) {
 	}
 	b, err := base64.StdEncoding.DecodeString(token)
 	if err != nil {
-		return TaskCursor{}, apperr.New("decode task cursor by base64", "invalid task cursor", apperr.WithCause(err), apperr.CodeInvalidArgument)
+		return TaskCursor{}, apperr.New("decode task cursor by base64", "Invalid cursor", apperr.WithMessageID(message.InvalidCursor), apperr.WithCause(err), apperr.CodeInvalidArgument)
 	}
 	var cursor TaskCursor
 	err = json.Unmarshal(b, &cursor)
 	if err != nil {
-		return TaskCursor{}, apperr.New("decode task cursor by json", "invalid task cursor", apperr.WithCause(err), apperr.CodeInvalidArgument)
+		return TaskCursor{}, apperr.New("decode task cursor by json", "Invalid cursor", apperr.WithMessageID(message.InvalidCursor), apperr.WithCause(err), apperr.CodeInvalidArgument)
 	}
 	return cursor, nil
 }
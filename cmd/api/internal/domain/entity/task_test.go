@@ -4,11 +4,13 @@ import (
 	"go-playground/cmd/api/internal/domain/entity"
 	"go-playground/pkg/apperr"
 	"go-playground/pkg/testhelper"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -27,16 +29,24 @@ func TestNewTask(t *testing.T) {
 	}{
 		"success to new": {
 			input: input{content: "test"},
-			want:  want{task: entity.Task{Content: "test"}},
+			want:  want{task: entity.Task{Content: "test", OwnerID: uuid.MustParse("01930c3a-e82b-700a-b41a-6f58b5c2b812")}},
 		},
 		"failure on validation": {
 			input: input{},
 			want:  want{err: "task content must be non empty", errCode: apperr.CodeInvalidArgument},
 		},
+		"success with max length multibyte content": {
+			input: input{content: strings.Repeat("あ", entity.MaxTaskContentLength)},
+			want:  want{task: entity.Task{Content: strings.Repeat("あ", entity.MaxTaskContentLength), OwnerID: uuid.MustParse("01930c3a-e82b-700a-b41a-6f58b5c2b812")}},
+		},
+		"failure on too long content": {
+			input: input{content: strings.Repeat("a", entity.MaxTaskContentLength+1)},
+			want:  want{err: "task content length 10001 exceeds 10000", errCode: apperr.CodeInvalidArgument},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			got, err := entity.NewTask(tc.input.content)
+			got, err := entity.NewTask(tc.input.content, uuid.MustParse("01930c3a-e82b-700a-b41a-6f58b5c2b812"))
 
 			if tc.want.err != "" {
 				assert.Zero(t, got)
@@ -87,6 +97,15 @@ func TestTask_UpdateContent(t *testing.T) {
 				err: "task content must be non empty", errCode: apperr.CodeInvalidArgument,
 			},
 		},
+		"failure to update too long content": {
+			input: input{
+				task:       entity.Task{ID: "0193d862-8182-7997-971d-175160c7f7d6", Content: "do test", CreatedAt: now, UpdatedAt: now},
+				newContent: strings.Repeat("a", entity.MaxTaskContentLength+1),
+			},
+			want: want{
+				err: "task content length 10001 exceeds 10000", errCode: apperr.CodeInvalidArgument,
+			},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
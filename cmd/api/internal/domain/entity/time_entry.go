@@ -0,0 +1,187 @@
+package entity
+
+import (
+	"fmt"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TimeEntryID is identifier of time entry entity.
+type TimeEntryID = string
+
+// TimeEntry is time tracked by user on task.
+// TimeEntry whose EndedAt is zero is running timer.
+type TimeEntry struct {
+	ID        TimeEntryID
+	TaskID    TaskID
+	UserID    uuid.UUID
+	StartedAt time.Time
+	EndedAt   time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// StartTimeEntry creates time entry of running timer started at now.
+func StartTimeEntry(taskID TaskID, userID uuid.UUID, now time.Time) (TimeEntry, error) {
+	return newTimeEntry(taskID, userID, now, time.Time{})
+}
+
+// NewTimeEntry creates time entry tracked manually.
+// Return error if endedAt is not after startedAt or period is in the future.
+func NewTimeEntry(taskID TaskID, userID uuid.UUID, startedAt, endedAt time.Time) (TimeEntry, error) {
+	if !endedAt.After(startedAt) {
+		return TimeEntry{}, apperr.New(
+			fmt.Sprintf("time entry ended at %s is not after started at %s", endedAt, startedAt),
+			"Time entry must end after it starts",
+			apperr.WithMessageID(message.TimeEntryEndsBeforeStart),
+			apperr.CodeInvalidArgument,
+		)
+	}
+	if endedAt.After(time.Now()) {
+		return TimeEntry{}, apperr.New(
+			fmt.Sprintf("time entry ended at %s is in the future", endedAt),
+			"Time entry must not end in the future",
+			apperr.WithMessageID(message.TimeEntryEndsInFuture),
+			apperr.CodeInvalidArgument,
+		)
+	}
+	return newTimeEntry(taskID, userID, startedAt, endedAt)
+}
+
+func newTimeEntry(taskID TaskID, userID uuid.UUID, startedAt, endedAt time.Time) (TimeEntry, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return TimeEntry{}, apperr.New("uuid new v7 for time entry id", "Failed to create new time entry", apperr.WithCause(err))
+	}
+	now := time.Now()
+	return TimeEntry{
+		ID:        id.String(),
+		TaskID:    taskID,
+		UserID:    userID,
+		StartedAt: startedAt.Truncate(time.Second),
+		EndedAt:   endedAt.Truncate(time.Second),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// Running reports whether time entry is running timer.
+func (e TimeEntry) Running() bool {
+	return e.EndedAt.IsZero()
+}
+
+// Stop stops running timer at now.
+func (e *TimeEntry) Stop(now time.Time) error {
+	if !e.Running() {
+		return apperr.New(fmt.Sprintf("time entry %q is already stopped", e.ID), "Timer is already stopped", apperr.WithMessageID(message.TimerAlreadyStopped), apperr.CodeFailedPrecondition)
+	}
+	now = now.Truncate(time.Second)
+	if now.Before(e.StartedAt) {
+		return apperr.New(fmt.Sprintf("stop time entry %q at %s before it started", e.ID, now), "Timer can not be stopped before it starts", apperr.WithMessageID(message.TimerStoppedBeforeStart), apperr.CodeInvalidArgument)
+	}
+	e.EndedAt = now
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// Duration returns tracked time. Running timer is tracked until now.
+func (e TimeEntry) Duration(now time.Time) time.Duration {
+	return e.end(now).Sub(e.StartedAt)
+}
+
+func (e TimeEntry) end(now time.Time) time.Time {
+	if e.Running() {
+		return now
+	}
+	return e.EndedAt
+}
+
+// TimeReportGroupBy is key to group time entries in time report.
+type TimeReportGroupBy string
+
+const (
+	// TimeReportGroupByDay groups time by day in location of report. Key is date formatted as 2006-01-02.
+	TimeReportGroupByDay TimeReportGroupBy = "day"
+	// TimeReportGroupByWeek groups time by week in location of report. Key is first date of week formatted as 2006-01-02.
+	TimeReportGroupByWeek TimeReportGroupBy = "week"
+	// TimeReportGroupByTask groups time by task. Key is task id.
+	TimeReportGroupByTask TimeReportGroupBy = "task"
+)
+
+// TimeReport is time summed by group in location.
+type TimeReport struct {
+	// Location is location which days and weeks of report are computed in.
+	Location *time.Location
+	Items    []TimeReportItem
+}
+
+// TimeReportItem is total time of group.
+type TimeReportItem struct {
+	Key      string
+	Duration time.Duration
+}
+
+// NewTimeReport sums time of given entries in range [from, to) by group.
+// Time of entries out of range is excluded, and running timers are counted until now.
+// Day boundaries are computed in location of from, and weeks begin on weekStart. Items are sorted by key.
+func NewTimeReport(entries []TimeEntry, from, to time.Time, groupBy TimeReportGroupBy, weekStart time.Weekday, now time.Time) ([]TimeReportItem, error) {
+	if groupBy != TimeReportGroupByDay && groupBy != TimeReportGroupByWeek && groupBy != TimeReportGroupByTask {
+		return nil, apperr.New(fmt.Sprintf("unsupported time report group %q", groupBy), "Invalid group_by parameter", apperr.WithMessageID(message.InvalidGroupBy), apperr.CodeInvalidArgument)
+	}
+	totals := make(map[string]time.Duration)
+	for _, e := range entries {
+		start, end := maxTime(e.StartedAt, from), minTime(e.end(now), to)
+		if !end.After(start) {
+			continue
+		}
+		if groupBy == TimeReportGroupByTask {
+			totals[e.TaskID] += end.Sub(start)
+			continue
+		}
+		for day := startOfDay(start.In(from.Location())); day.Before(end); {
+			next := day.AddDate(0, 0, 1)
+			if d := minTime(next, end).Sub(maxTime(day, start)); d > 0 {
+				key := day
+				if groupBy == TimeReportGroupByWeek {
+					key = startOfWeek(day, weekStart)
+				}
+				totals[key.Format(time.DateOnly)] += d
+			}
+			day = next
+		}
+	}
+	items := make([]TimeReportItem, 0, len(totals))
+	for k, d := range totals {
+		items = append(items, TimeReportItem{Key: k, Duration: d})
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Key < items[j].Key })
+	return items, nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// startOfWeek returns first day of week including given day.
+func startOfWeek(day time.Time, weekStart time.Weekday) time.Time {
+	return day.AddDate(0, 0, -((int(day.Weekday()) - int(weekStart) + 7) % 7))
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
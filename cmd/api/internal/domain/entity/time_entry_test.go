@@ -0,0 +1,172 @@
+package entity_test
+
+import (
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/timex"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTimeEntry(t *testing.T) {
+	uid := uuid.MustParse("01930c3a-e82b-700a-b41a-6f58b5c2b812")
+	type input struct {
+		startedAt, endedAt time.Time
+	}
+	type want struct {
+		err     string
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		input input
+		want  want
+	}{
+		"success": {
+			input: input{
+				startedAt: time.Date(2024, 12, 1, 9, 0, 0, 0, time.UTC),
+				endedAt:   time.Date(2024, 12, 1, 10, 30, 0, 0, time.UTC),
+			},
+		},
+		"failure ended before started": {
+			input: input{
+				startedAt: time.Date(2024, 12, 1, 9, 0, 0, 0, time.UTC),
+				endedAt:   time.Date(2024, 12, 1, 9, 0, 0, 0, time.UTC),
+			},
+			want: want{err: "time entry ended at 2024-12-01 09:00:00 +0000 UTC is not after started at 2024-12-01 09:00:00 +0000 UTC", errCode: apperr.CodeInvalidArgument},
+		},
+		"failure ended in the future": {
+			input: input{
+				startedAt: time.Date(2024, 12, 1, 9, 0, 0, 0, time.UTC),
+				endedAt:   time.Date(9999, 12, 1, 9, 0, 0, 0, time.UTC),
+			},
+			want: want{err: "time entry ended at 9999-12-01 09:00:00 +0000 UTC is in the future", errCode: apperr.CodeInvalidArgument},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := entity.NewTimeEntry("0190fe59-6618-7811-8b28-a3e67969a4ef", uid, tc.input.startedAt, tc.input.endedAt)
+
+			if tc.want.err != "" {
+				assert.Zero(t, got)
+				assert.EqualError(t, err, tc.want.err)
+				assert.True(t, apperr.IsCode(err, tc.want.errCode))
+			} else {
+				require.NoError(t, err)
+				assert.NotZero(t, got.ID)
+				assert.False(t, got.Running())
+				assert.Equal(t, 90*time.Minute, got.Duration(time.Now()))
+			}
+		})
+	}
+}
+
+func TestTimeEntry_Stop(t *testing.T) {
+	startedAt := time.Date(2024, 12, 1, 9, 0, 0, 0, time.UTC)
+	entry, err := entity.StartTimeEntry("0190fe59-6618-7811-8b28-a3e67969a4ef", uuid.MustParse("01930c3a-e82b-700a-b41a-6f58b5c2b812"), startedAt)
+	require.NoError(t, err)
+	require.True(t, entry.Running())
+	assert.Equal(t, time.Hour, entry.Duration(startedAt.Add(time.Hour)))
+
+	err = entry.Stop(startedAt.Add(-time.Second))
+	assert.EqualError(t, err, `stop time entry "`+entry.ID+`" at 2024-12-01 08:59:59 +0000 UTC before it started`)
+
+	err = entry.Stop(startedAt.Add(30 * time.Minute))
+	require.NoError(t, err)
+	assert.False(t, entry.Running())
+	assert.Equal(t, 30*time.Minute, entry.Duration(startedAt.Add(time.Hour)))
+
+	err = entry.Stop(startedAt.Add(time.Hour))
+	assert.EqualError(t, err, `time entry "`+entry.ID+`" is already stopped`)
+	assert.True(t, apperr.IsCode(err, apperr.CodeFailedPrecondition))
+}
+
+func TestNewTimeReport(t *testing.T) {
+	entries := []entity.TimeEntry{
+		{
+			// 2024-12-01 23:00 - 2024-12-02 01:00 in JST
+			TaskID:    "task1",
+			StartedAt: time.Date(2024, 12, 1, 14, 0, 0, 0, time.UTC),
+			EndedAt:   time.Date(2024, 12, 1, 16, 0, 0, 0, time.UTC),
+		},
+		{
+			// 2024-12-02 09:00 - running in JST
+			TaskID:    "task2",
+			StartedAt: time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			// 2024-11-30 23:30 - 2024-12-01 00:30 in JST, starts before range
+			TaskID:    "task2",
+			StartedAt: time.Date(2024, 11, 30, 14, 30, 0, 0, time.UTC),
+			EndedAt:   time.Date(2024, 11, 30, 15, 30, 0, 0, time.UTC),
+		},
+	}
+	from := time.Date(2024, 12, 1, 0, 0, 0, 0, timex.JST())
+	to := time.Date(2024, 12, 3, 0, 0, 0, 0, timex.JST())
+	now := time.Date(2024, 12, 2, 0, 45, 0, 0, time.UTC)
+	tests := map[string]struct {
+		entries   []entity.TimeEntry
+		groupBy   entity.TimeReportGroupBy
+		weekStart time.Weekday
+		want      []entity.TimeReportItem
+		err       string
+	}{
+		"group by day": {
+			entries: entries,
+			groupBy: entity.TimeReportGroupByDay,
+			want: []entity.TimeReportItem{
+				{Key: "2024-12-01", Duration: 90 * time.Minute},
+				{Key: "2024-12-02", Duration: 105 * time.Minute},
+			},
+		},
+		"group by week starting on sunday": {
+			entries:   entries,
+			groupBy:   entity.TimeReportGroupByWeek,
+			weekStart: time.Sunday,
+			want: []entity.TimeReportItem{
+				{Key: "2024-12-01", Duration: 195 * time.Minute},
+			},
+		},
+		"group by week starting on monday": {
+			entries:   entries,
+			groupBy:   entity.TimeReportGroupByWeek,
+			weekStart: time.Monday,
+			want: []entity.TimeReportItem{
+				{Key: "2024-11-25", Duration: 90 * time.Minute},
+				{Key: "2024-12-02", Duration: 105 * time.Minute},
+			},
+		},
+		"group by task": {
+			entries: entries,
+			groupBy: entity.TimeReportGroupByTask,
+			want: []entity.TimeReportItem{
+				{Key: "task1", Duration: 2 * time.Hour},
+				{Key: "task2", Duration: 75 * time.Minute},
+			},
+		},
+		"no entries": {
+			groupBy: entity.TimeReportGroupByDay,
+			want:    []entity.TimeReportItem{},
+		},
+		"unsupported group": {
+			groupBy: "label",
+			err:     `unsupported time report group "label"`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := entity.NewTimeReport(tc.entries, from, to, tc.groupBy, tc.weekStart, now)
+
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				assert.True(t, apperr.IsCode(err, apperr.CodeInvalidArgument))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package entity
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"go-playground/cmd/api/internal/message"
 	"go-playground/pkg/apperr"
 	"time"
 
@@ -17,8 +21,17 @@ type User struct {
 	Email                 string
 	EmailVerified         bool
 	CreatedAt, UpdatedAt  time.Time
+	// DeletedAt is when user requested deletion. Zero means user is not deleted.
+	DeletedAt time.Time
+	Role      Role
+	// DisabledAt is when user is disabled by admin. Zero means user is not disabled.
+	DisabledAt time.Time
 }
 
+// UserDeletionGracePeriod is period during which deleted user can be restored.
+// User is purged permanently after this period.
+const UserDeletionGracePeriod = 30 * 24 * time.Hour
+
 // NewUser creates new user.
 func NewUser(
 	sub string,
@@ -40,6 +53,7 @@ func NewUser(
 		EmailVerified: emailVerified,
 		CreatedAt:     now,
 		UpdatedAt:     now,
+		Role:          RoleUser,
 	}
 	err = user.validate()
 	if err != nil {
@@ -48,6 +62,177 @@ func NewUser(
 	return user, nil
 }
 
+// UserProfilePatch is partial update of user profile. Nil field is left unchanged.
+type UserProfilePatch struct {
+	GivenName, FamilyName *string
+	Email                 *string
+}
+
+// ApplyProfilePatch updates user profile with given patch and reports whether email is changed.
+// Changed email is no longer verified.
+// User is left unchanged if patched user is invalid.
+func (u *User) ApplyProfilePatch(patch UserProfilePatch) (bool, error) {
+	patched := *u
+	if patch.GivenName != nil {
+		patched.GivenName = *patch.GivenName
+	}
+	if patch.FamilyName != nil {
+		patched.FamilyName = *patch.FamilyName
+	}
+	emailChanged := patch.Email != nil && *patch.Email != u.Email
+	if emailChanged {
+		patched.Email = *patch.Email
+		patched.EmailVerified = false
+	}
+	if err := patched.validate(); err != nil {
+		return false, err
+	}
+	patched.UpdatedAt = time.Now()
+	*u = patched
+	return emailChanged, nil
+}
+
+// SyncClaims updates user profile with claims issued by identity provider at now.
+// It reports whether user is changed and whether email is changed.
+// Email verified by [User.VerifyEmail] is kept verified unless email is changed.
+// User is left unchanged if synced user is invalid.
+func (u *User) SyncClaims(givenName, familyName, email string, emailVerified bool, now time.Time) (bool, bool, error) {
+	synced := *u
+	synced.GivenName = givenName
+	synced.FamilyName = familyName
+	synced.Email = email
+	synced.EmailVerified = emailVerified || (u.EmailVerified && email == u.Email)
+	if synced == *u {
+		return false, false, nil
+	}
+	if err := synced.validate(); err != nil {
+		return false, false, err
+	}
+	synced.UpdatedAt = now
+	emailChanged := synced.Email != u.Email
+	*u = synced
+	return true, emailChanged, nil
+}
+
+// VerifyEmail marks email verified by given verification at now.
+// Error will be returned if verification is of other user or of email before change.
+func (u *User) VerifyEmail(v EmailVerification, now time.Time) error {
+	if v.UserID != u.ID || v.Email != u.Email {
+		return apperr.New(
+			fmt.Sprintf("verify email of user %q by verification of user %q and email %q", u.ID, v.UserID, v.Email),
+			"Invalid or expired verification token",
+			apperr.WithMessageID(message.InvalidVerificationToken),
+			apperr.CodeInvalidArgument,
+		)
+	}
+	if u.EmailVerified {
+		return apperr.New(fmt.Sprintf("verify email of user %q but email is already verified", u.ID), "Email is already verified", apperr.WithMessageID(message.EmailAlreadyVerified), apperr.CodeFailedPrecondition)
+	}
+	u.EmailVerified = true
+	u.UpdatedAt = now
+	return nil
+}
+
+// Deleted reports whether user requested deletion.
+func (u User) Deleted() bool {
+	return !u.DeletedAt.IsZero()
+}
+
+// Delete marks user as deleted at now. User can be restored until grace period ends.
+func (u *User) Delete(now time.Time) error {
+	if u.Deleted() {
+		return apperr.New("delete user but user is already deleted", "User is already deleted", apperr.WithMessageID(message.UserAlreadyDeleted), apperr.CodeFailedPrecondition)
+	}
+	u.DeletedAt = now
+	u.UpdatedAt = now
+	return nil
+}
+
+// Restore cancels deletion of user. Error will be returned if user is not deleted or grace period has ended.
+func (u *User) Restore(now time.Time) error {
+	if !u.Deleted() {
+		return apperr.New("restore user but user is not deleted", "User is not deleted", apperr.WithMessageID(message.UserNotDeleted), apperr.CodeFailedPrecondition)
+	}
+	if !now.Before(u.PurgeAt()) {
+		return apperr.New(
+			fmt.Sprintf("restore user deleted at %s but grace period has ended", u.DeletedAt),
+			"Grace period to restore user has ended",
+			apperr.WithMessageID(message.RestorePeriodEnded),
+			apperr.CodeFailedPrecondition,
+		)
+	}
+	u.DeletedAt = time.Time{}
+	u.UpdatedAt = now
+	return nil
+}
+
+// Disabled reports whether user is disabled by admin.
+func (u User) Disabled() bool {
+	return !u.DisabledAt.IsZero()
+}
+
+// Disable disables user at now. Disabled user can not call API even if access token is valid.
+func (u *User) Disable(now time.Time) error {
+	if u.Disabled() {
+		return apperr.New(fmt.Sprintf("disable user %q but user is already disabled", u.ID), "User is already disabled", apperr.WithMessageID(message.UserAlreadyDisabled), apperr.CodeFailedPrecondition)
+	}
+	u.DisabledAt = now
+	u.UpdatedAt = now
+	return nil
+}
+
+// Enable enables disabled user.
+func (u *User) Enable(now time.Time) error {
+	if !u.Disabled() {
+		return apperr.New(fmt.Sprintf("enable user %q but user is not disabled", u.ID), "User is not disabled", apperr.WithMessageID(message.UserNotDisabled), apperr.CodeFailedPrecondition)
+	}
+	u.DisabledAt = time.Time{}
+	u.UpdatedAt = now
+	return nil
+}
+
+// EncodeCursor encodes user cursor token.
+func (u User) EncodeCursor() (string, error) {
+	c := UserCursor{ID: u.ID.String()}
+	buf, err := json.Marshal(c)
+	if err != nil {
+		return "", apperr.New("marshal user cursor", "Failed to create user metadata", apperr.WithCause(err))
+	}
+	return base64.StdEncoding.EncodeToString(buf), nil
+}
+
+type UserCursor struct {
+	ID string `json:"id"`
+}
+
+// DecodeUserCursor decodes token to user cursor.
+func DecodeUserCursor(token string) (UserCursor, error) {
+	if token == "" {
+		return UserCursor{}, nil
+	}
+	b, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return UserCursor{}, apperr.New("decode user cursor by base64", "Invalid cursor", apperr.WithMessageID(message.InvalidCursor), apperr.WithCause(err), apperr.CodeInvalidArgument)
+	}
+	var cursor UserCursor
+	err = json.Unmarshal(b, &cursor)
+	if err != nil {
+		return UserCursor{}, apperr.New("decode user cursor by json", "Invalid cursor", apperr.WithMessageID(message.InvalidCursor), apperr.WithCause(err), apperr.CodeInvalidArgument)
+	}
+	if _, err := uuid.Parse(cursor.ID); err != nil {
+		return UserCursor{}, apperr.New("parse id of user cursor", "Invalid cursor", apperr.WithMessageID(message.InvalidCursor), apperr.WithCause(err), apperr.CodeInvalidArgument)
+	}
+	return cursor, nil
+}
+
+// PurgeAt returns when deleted user is purged. Zero is returned if user is not deleted.
+func (u User) PurgeAt() time.Time {
+	if !u.Deleted() {
+		return time.Time{}
+	}
+	return u.DeletedAt.Add(UserDeletionGracePeriod)
+}
+
 // validate validates user entity.
 func (u User) validate() error {
 	err := validation.ValidateStruct(
@@ -57,9 +242,10 @@ func (u User) validate() error {
 		validation.Field(&u.FamilyName, validation.Required),
 		// is.Email resolves MX/A records over the network. Format check only.
 		validation.Field(&u.Email, validation.Required, is.EmailFormat),
+		validation.Field(&u.Role, validation.In(RoleUser, RoleSupport, RoleAdmin)),
 	)
 	if err != nil {
-		return apperr.New("validate user entity", err.Error(), apperr.WithCause(err), apperr.CodeInvalidArgument)
+		return validationError("validate user entity", err)
 	}
 
 	return nil
@@ -0,0 +1,140 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-playground/pkg/apperr"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserExportID is identifier of user export entity.
+type UserExportID = string
+
+// UserExportStatus is status of [UserExport].
+type UserExportStatus string
+
+const (
+	// UserExportStatusPending means export is waiting to be built.
+	UserExportStatusPending UserExportStatus = "pending"
+	// UserExportStatusReady means export is built and can be downloaded.
+	UserExportStatusReady UserExportStatus = "ready"
+	// UserExportStatusFailed means export could not be built.
+	UserExportStatusFailed UserExportStatus = "failed"
+)
+
+// UserExport is personal data export requested by user. Export is built asynchronously.
+type UserExport struct {
+	ID     UserExportID
+	UserID uuid.UUID
+	Status UserExportStatus
+	// Content is JSON of [UserExportContent]. This is present only when status is ready.
+	Content   []byte
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// UserExportContent is personal data of user included in export.
+type UserExportContent struct {
+	Profile        UserExportProfile     `json:"profile"`
+	OwnedTasks     []Task                `json:"ownedTasks"`
+	MentionedTasks []Task                `json:"mentionedTasks"`
+	TimeEntries    []UserExportTimeEntry `json:"timeEntries"`
+	ExportedAt     time.Time             `json:"exportedAt"`
+}
+
+// UserExportProfile is profile of user included in export.
+type UserExportProfile struct {
+	ID            string    `json:"id"`
+	Sub           string    `json:"sub"`
+	GivenName     string    `json:"givenName"`
+	FamilyName    string    `json:"familyName"`
+	Email         string    `json:"email"`
+	EmailVerified bool      `json:"emailVerified"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+// UserExportTimeEntry is time entry included in export.
+type UserExportTimeEntry struct {
+	ID        TimeEntryID `json:"id"`
+	TaskID    TaskID      `json:"taskId"`
+	StartedAt time.Time   `json:"startedAt"`
+	EndedAt   *time.Time  `json:"endedAt,omitempty"`
+}
+
+// NewUserExport creates pending export of given user.
+func NewUserExport(userID uuid.UUID) (UserExport, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return UserExport{}, apperr.New("uuid new v7 for user export id", "Failed to request export", apperr.WithCause(err))
+	}
+	now := time.Now()
+	return UserExport{
+		ID:        id.String(),
+		UserID:    userID,
+		Status:    UserExportStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// NewUserExportContent collects personal data of user.
+func NewUserExportContent(user User, ownedTasks, mentionedTasks []Task, entries []TimeEntry, now time.Time) UserExportContent {
+	timeEntries := make([]UserExportTimeEntry, len(entries))
+	for i, e := range entries {
+		timeEntries[i] = UserExportTimeEntry{ID: e.ID, TaskID: e.TaskID, StartedAt: e.StartedAt}
+		if !e.Running() {
+			endedAt := e.EndedAt
+			timeEntries[i].EndedAt = &endedAt
+		}
+	}
+	if ownedTasks == nil {
+		ownedTasks = []Task{}
+	}
+	if mentionedTasks == nil {
+		mentionedTasks = []Task{}
+	}
+	return UserExportContent{
+		Profile: UserExportProfile{
+			ID:            user.ID.String(),
+			Sub:           user.Sub,
+			GivenName:     user.GivenName,
+			FamilyName:    user.FamilyName,
+			Email:         user.Email,
+			EmailVerified: user.EmailVerified,
+			CreatedAt:     user.CreatedAt,
+		},
+		OwnedTasks:     ownedTasks,
+		MentionedTasks: mentionedTasks,
+		TimeEntries:    timeEntries,
+		ExportedAt:     now,
+	}
+}
+
+// Complete stores given content and makes export ready.
+func (e *UserExport) Complete(content UserExportContent) error {
+	if e.Status != UserExportStatusPending {
+		return apperr.New(fmt.Sprintf("complete user export %q in status %s", e.ID, e.Status), "Export is already processed")
+	}
+	b, err := json.Marshal(content)
+	if err != nil {
+		return apperr.New(fmt.Sprintf("marshal content of user export %q", e.ID), "Failed to build export", apperr.WithCause(err))
+	}
+	e.Status = UserExportStatusReady
+	e.Content = b
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// Fail marks export as failed.
+func (e *UserExport) Fail() {
+	e.Status = UserExportStatusFailed
+	e.Content = nil
+	e.UpdatedAt = time.Now()
+}
+
+// Ready reports whether export can be downloaded.
+func (e UserExport) Ready() bool {
+	return e.Status == UserExportStatusReady
+}
@@ -0,0 +1,86 @@
+package entity_test
+
+import (
+	"go-playground/cmd/api/internal/domain/entity"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserExport_Complete(t *testing.T) {
+	uid := uuid.MustParse("01930c3a-e82b-700a-b41a-6f58b5c2b812")
+	e, err := entity.NewUserExport(uid)
+	require.NoError(t, err)
+	require.Equal(t, entity.UserExportStatusPending, e.Status)
+	user := entity.User{
+		ID:            uid,
+		Sub:           "80dbb87a-5ce8-4b45-85a0-3b8aec488b7a",
+		GivenName:     "Dibbert",
+		FamilyName:    "Kozey",
+		Email:         "Jonathan74@example.com",
+		EmailVerified: true,
+		CreatedAt:     time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC),
+	}
+	entries := []entity.TimeEntry{
+		{
+			ID:        "0193a1e0-5c00-7a3b-9f1e-3a8f0c7d2b01",
+			TaskID:    "0190fe59-6618-7811-8b28-a3e67969a4ef",
+			StartedAt: time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+			EndedAt:   time.Date(2024, 12, 1, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        "0193a5b2-7400-7c1d-8e2f-4b9a1d8e3c02",
+			TaskID:    "0190fe5b-1f83-7024-a233-c8a18935f5dc",
+			StartedAt: time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	content := entity.NewUserExportContent(user, nil, nil, entries, time.Date(2024, 12, 3, 0, 0, 0, 0, time.UTC))
+
+	err = e.Complete(content)
+
+	require.NoError(t, err)
+	assert.True(t, e.Ready())
+	assert.JSONEq(t, `{
+  "profile": {
+    "id": "01930c3a-e82b-700a-b41a-6f58b5c2b812",
+    "sub": "80dbb87a-5ce8-4b45-85a0-3b8aec488b7a",
+    "givenName": "Dibbert",
+    "familyName": "Kozey",
+    "email": "Jonathan74@example.com",
+    "emailVerified": true,
+    "createdAt": "2024-11-08T14:40:33Z"
+  },
+  "ownedTasks": [],
+  "mentionedTasks": [],
+  "timeEntries": [
+    {
+      "id": "0193a1e0-5c00-7a3b-9f1e-3a8f0c7d2b01",
+      "taskId": "0190fe59-6618-7811-8b28-a3e67969a4ef",
+      "startedAt": "2024-12-01T00:00:00Z",
+      "endedAt": "2024-12-01T01:00:00Z"
+    },
+    {
+      "id": "0193a5b2-7400-7c1d-8e2f-4b9a1d8e3c02",
+      "taskId": "0190fe5b-1f83-7024-a233-c8a18935f5dc",
+      "startedAt": "2024-12-02T00:00:00Z"
+    }
+  ],
+  "exportedAt": "2024-12-03T00:00:00Z"
+}`, string(e.Content))
+
+	err = e.Complete(content)
+	assert.EqualError(t, err, `complete user export "`+e.ID+`" in status ready`)
+}
+
+func TestUserExport_Fail(t *testing.T) {
+	e, err := entity.NewUserExport(uuid.MustParse("01930c3a-e82b-700a-b41a-6f58b5c2b812"))
+	require.NoError(t, err)
+
+	e.Fail()
+
+	assert.Equal(t, entity.UserExportStatusFailed, e.Status)
+	assert.False(t, e.Ready())
+}
@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"go-playground/pkg/timex"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"golang.org/x/text/language"
+)
+
+const (
+	// DefaultTimezone is timezone of user who has never saved preferences.
+	DefaultTimezone = "Asia/Tokyo"
+	// DefaultLocale is locale of user who has never saved preferences.
+	DefaultLocale = "en-US"
+	// DefaultPageSize is page size of user who has never saved preferences.
+	DefaultPageSize int32 = 10
+	// MaxPageSize is the largest page size which user can prefer.
+	MaxPageSize int32 = 100
+)
+
+// UserPreferences is preferences of user how dates and lists are rendered.
+type UserPreferences struct {
+	UserID uuid.UUID
+	// Timezone is IANA time zone name such as Asia/Tokyo.
+	Timezone string
+	// Locale is BCP 47 language tag such as ja-JP.
+	Locale string
+	// PageSize is default size of list pages when request does not specify it.
+	PageSize  int32
+	WeekStart time.Weekday
+	UpdatedAt time.Time
+}
+
+// DefaultUserPreferences returns preferences of given user who has never saved them.
+func DefaultUserPreferences(userID uuid.UUID) UserPreferences {
+	return UserPreferences{
+		UserID:    userID,
+		Timezone:  DefaultTimezone,
+		Locale:    DefaultLocale,
+		PageSize:  DefaultPageSize,
+		WeekStart: time.Monday,
+	}
+}
+
+// NewUserPreferences creates preferences of given user. Locale is canonicalized.
+func NewUserPreferences(userID uuid.UUID, timezone, locale string, pageSize int32, weekStart time.Weekday, now time.Time) (UserPreferences, error) {
+	p := UserPreferences{
+		UserID:    userID,
+		Timezone:  timezone,
+		Locale:    locale,
+		PageSize:  pageSize,
+		WeekStart: weekStart,
+		UpdatedAt: now,
+	}
+	err := validation.ValidateStruct(
+		&p,
+		validation.Field(&p.Timezone, validation.Required, validation.By(validateTimezone)),
+		validation.Field(&p.Locale, validation.Required, validation.By(validateLocale)),
+		validation.Field(&p.PageSize, validation.Required, validation.Min(int32(1)), validation.Max(MaxPageSize)),
+		validation.Field(&p.WeekStart, validation.Min(time.Sunday), validation.Max(time.Saturday)),
+	)
+	if err != nil {
+		return UserPreferences{}, validationError("validate user preferences entity", err)
+	}
+	p.Locale = language.Make(locale).String()
+	return p, nil
+}
+
+// Location returns location of preferred timezone. Default timezone is used if timezone is unknown to this host.
+func (p UserPreferences) Location() *time.Location {
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return timex.JST()
+	}
+	return loc
+}
+
+func validateTimezone(value any) error {
+	name, _ := value.(string)
+	// Local is accepted by time.LoadLocation but it depends on server, so it is not timezone of user.
+	if _, err := time.LoadLocation(name); err != nil || name == "Local" {
+		return validation.NewError("validation_timezone", "must be IANA time zone name")
+	}
+	return nil
+}
+
+func validateLocale(value any) error {
+	tag, _ := value.(string)
+	if _, err := language.Parse(tag); err != nil {
+		return validation.NewError("validation_locale", "must be BCP 47 language tag")
+	}
+	return nil
+}
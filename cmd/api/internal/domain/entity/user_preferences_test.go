@@ -0,0 +1,96 @@
+package entity_test
+
+import (
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/pkg/apperr"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUserPreferences(t *testing.T) {
+	userID := uuid.MustParse("01928120-055d-7edb-a12a-2d290512266e")
+	now := time.Date(2026, 10, 27, 9, 0, 0, 0, time.UTC)
+	type input struct {
+		timezone  string
+		locale    string
+		pageSize  int32
+		weekStart time.Weekday
+	}
+	tests := map[string]struct {
+		input   input
+		want    entity.UserPreferences
+		wantErr []apperr.FieldError
+	}{
+		"success": {
+			input: input{timezone: "America/New_York", locale: "en-US", pageSize: 50, weekStart: time.Sunday},
+			want: entity.UserPreferences{
+				UserID:    userID,
+				Timezone:  "America/New_York",
+				Locale:    "en-US",
+				PageSize:  50,
+				WeekStart: time.Sunday,
+				UpdatedAt: now,
+			},
+		},
+		"success: locale is canonicalized": {
+			input: input{timezone: "UTC", locale: "EN_gb", pageSize: 1, weekStart: time.Saturday},
+			want: entity.UserPreferences{
+				UserID:    userID,
+				Timezone:  "UTC",
+				Locale:    "en-GB",
+				PageSize:  1,
+				WeekStart: time.Saturday,
+				UpdatedAt: now,
+			},
+		},
+		"failure: unknown timezone": {
+			input:   input{timezone: "Asia/Atlantis", locale: "ja-JP", pageSize: 10, weekStart: time.Monday},
+			wantErr: []apperr.FieldError{{Field: "timezone", Rule: "timezone", Message: "must be IANA time zone name"}},
+		},
+		"failure: timezone of server": {
+			input:   input{timezone: "Local", locale: "ja-JP", pageSize: 10, weekStart: time.Monday},
+			wantErr: []apperr.FieldError{{Field: "timezone", Rule: "timezone", Message: "must be IANA time zone name"}},
+		},
+		"failure: invalid locale": {
+			input:   input{timezone: "Asia/Tokyo", locale: "not a locale", pageSize: 10, weekStart: time.Monday},
+			wantErr: []apperr.FieldError{{Field: "locale", Rule: "locale", Message: "must be BCP 47 language tag"}},
+		},
+		"failure: page size exceeds maximum": {
+			input:   input{timezone: "Asia/Tokyo", locale: "ja-JP", pageSize: entity.MaxPageSize + 1, weekStart: time.Monday},
+			wantErr: []apperr.FieldError{{Field: "pageSize", Rule: "max_less_equal_than_required", Message: "must be no greater than 100"}},
+		},
+		"failure: unknown weekday": {
+			input:   input{timezone: "Asia/Tokyo", locale: "ja-JP", pageSize: 10, weekStart: 7},
+			wantErr: []apperr.FieldError{{Field: "weekStart", Rule: "max_less_equal_than_required", Message: "must be no greater than Saturday"}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := entity.NewUserPreferences(userID, tc.input.timezone, tc.input.locale, tc.input.pageSize, tc.input.weekStart, now)
+
+			if tc.wantErr != nil {
+				assert.Zero(t, got)
+				var appErr *apperr.Error
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, "Some fields are invalid", appErr.ClientMessage())
+				assert.Equal(t, tc.wantErr, appErr.FieldErrors())
+				assert.True(t, apperr.IsCode(err, apperr.CodeInvalidArgument))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestUserPreferences_Location(t *testing.T) {
+	p := entity.DefaultUserPreferences(uuid.MustParse("01928120-055d-7edb-a12a-2d290512266e"))
+	assert.Equal(t, "Asia/Tokyo", p.Location().String())
+
+	p.Timezone = "Europe/Paris"
+	assert.Equal(t, "Europe/Paris", p.Location().String())
+}
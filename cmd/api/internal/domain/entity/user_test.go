@@ -4,9 +4,11 @@ import (
 	"go-playground/cmd/api/internal/domain/entity"
 	"go-playground/pkg/apperr"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -42,6 +44,7 @@ func TestNewUser(t *testing.T) {
 					FamilyName:    "Sons",
 					Email:         "Domingo63@example.com",
 					EmailVerified: true,
+					Role:          entity.RoleUser,
 				},
 			},
 		},
@@ -132,3 +135,385 @@ func TestNewUser(t *testing.T) {
 	}
 
 }
+
+func TestUser_ApplyProfilePatch(t *testing.T) {
+	var (
+		givenName = "Alice"
+		blank     = ""
+		newEmail  = "Alice@example.com"
+		sameEmail = "Domingo63@example.com"
+		badEmail  = "Alice..@example.com"
+	)
+	original := entity.User{
+		Sub:           "f828f57a-b082-4af2-afb4-f3d0fe2c8697",
+		GivenName:     "Walter",
+		FamilyName:    "Sons",
+		Email:         "Domingo63@example.com",
+		EmailVerified: true,
+	}
+	type want struct {
+		user         entity.User
+		emailChanged bool
+		err          string
+		errCode      apperr.Code
+	}
+	tests := map[string]struct {
+		input entity.UserProfilePatch
+		want  want
+	}{
+		"success update name": {
+			input: entity.UserProfilePatch{GivenName: &givenName},
+			want: want{user: entity.User{
+				Sub:           "f828f57a-b082-4af2-afb4-f3d0fe2c8697",
+				GivenName:     "Alice",
+				FamilyName:    "Sons",
+				Email:         "Domingo63@example.com",
+				EmailVerified: true,
+			}},
+		},
+		"success change email resets verification": {
+			input: entity.UserProfilePatch{Email: &newEmail},
+			want: want{
+				user: entity.User{
+					Sub:        "f828f57a-b082-4af2-afb4-f3d0fe2c8697",
+					GivenName:  "Walter",
+					FamilyName: "Sons",
+					Email:      "Alice@example.com",
+				},
+				emailChanged: true,
+			},
+		},
+		"success same email keeps verification": {
+			input: entity.UserProfilePatch{Email: &sameEmail},
+			want:  want{user: original},
+		},
+		"failure blank family name": {
+			input: entity.UserProfilePatch{GivenName: &givenName, FamilyName: &blank},
+			want: want{
+				user:    original,
+				err:     "validate user entity: FamilyName: cannot be blank.",
+				errCode: apperr.CodeInvalidArgument,
+			},
+		},
+		"failure invalid email": {
+			input: entity.UserProfilePatch{Email: &badEmail},
+			want: want{
+				user:    original,
+				err:     "validate user entity: Email: must be a valid email address.",
+				errCode: apperr.CodeInvalidArgument,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			u := original
+
+			got, err := u.ApplyProfilePatch(tc.input)
+
+			assert.Equal(t, tc.want.emailChanged, got)
+			if tc.want.err != "" {
+				assert.EqualError(t, err, tc.want.err)
+				assert.True(t, apperr.IsCode(err, tc.want.errCode))
+				assert.Equal(t, tc.want.user, u)
+			} else {
+				require.NoError(t, err)
+				diff := cmp.Diff(tc.want.user, u, cmpopts.IgnoreFields(entity.User{}, "UpdatedAt"))
+				assert.Empty(t, diff)
+				assert.False(t, u.UpdatedAt.IsZero())
+			}
+		})
+	}
+}
+
+func TestUser_SyncClaims(t *testing.T) {
+	now := time.Date(2024, 11, 8, 0, 0, 0, 0, time.UTC)
+	original := entity.User{
+		Sub:           "f828f57a-b082-4af2-afb4-f3d0fe2c8697",
+		GivenName:     "Walter",
+		FamilyName:    "Sons",
+		Email:         "Domingo63@example.com",
+		EmailVerified: true,
+	}
+	type input struct {
+		givenName, familyName string
+		email                 string
+		emailVerified         bool
+	}
+	type want struct {
+		user         entity.User
+		changed      bool
+		emailChanged bool
+		err          string
+	}
+	tests := map[string]struct {
+		input input
+		want  want
+	}{
+		"success nothing changed": {
+			input: input{givenName: "Walter", familyName: "Sons", email: "Domingo63@example.com", emailVerified: true},
+			want:  want{user: original},
+		},
+		"success name changed": {
+			input: input{givenName: "Alice", familyName: "Sons", email: "Domingo63@example.com", emailVerified: true},
+			want: want{
+				user: entity.User{
+					Sub:           "f828f57a-b082-4af2-afb4-f3d0fe2c8697",
+					GivenName:     "Alice",
+					FamilyName:    "Sons",
+					Email:         "Domingo63@example.com",
+					EmailVerified: true,
+					UpdatedAt:     now,
+				},
+				changed: true,
+			},
+		},
+		"success email changed": {
+			input: input{givenName: "Walter", familyName: "Sons", email: "Alice@example.com", emailVerified: false},
+			want: want{
+				user: entity.User{
+					Sub:        "f828f57a-b082-4af2-afb4-f3d0fe2c8697",
+					GivenName:  "Walter",
+					FamilyName: "Sons",
+					Email:      "Alice@example.com",
+					UpdatedAt:  now,
+				},
+				changed:      true,
+				emailChanged: true,
+			},
+		},
+		"success verified email is kept even if claim is not verified": {
+			input: input{givenName: "Walter", familyName: "Sons", email: "Domingo63@example.com", emailVerified: false},
+			want:  want{user: original},
+		},
+		"failure blank family name": {
+			input: input{givenName: "Walter", email: "Domingo63@example.com", emailVerified: true},
+			want: want{
+				user: original,
+				err:  "validate user entity: FamilyName: cannot be blank.",
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			u := original
+
+			changed, emailChanged, err := u.SyncClaims(tc.input.givenName, tc.input.familyName, tc.input.email, tc.input.emailVerified, now)
+
+			assert.Equal(t, tc.want.changed, changed)
+			assert.Equal(t, tc.want.emailChanged, emailChanged)
+			if tc.want.err != "" {
+				assert.EqualError(t, err, tc.want.err)
+				assert.True(t, apperr.IsCode(err, apperr.CodeInvalidArgument))
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.want.user, u)
+		})
+	}
+}
+
+func TestUser_DeleteAndRestore(t *testing.T) {
+	deletedAt := time.Date(2024, 11, 8, 0, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		user    entity.User
+		action  func(u *entity.User) error
+		want    entity.User
+		wantErr string
+	}{
+		"delete": {
+			user:   entity.User{},
+			action: func(u *entity.User) error { return u.Delete(deletedAt) },
+			want:   entity.User{DeletedAt: deletedAt, UpdatedAt: deletedAt},
+		},
+		"delete deleted user": {
+			user:    entity.User{DeletedAt: deletedAt},
+			action:  func(u *entity.User) error { return u.Delete(deletedAt.Add(time.Hour)) },
+			want:    entity.User{DeletedAt: deletedAt},
+			wantErr: "delete user but user is already deleted",
+		},
+		"restore in grace period": {
+			user: entity.User{DeletedAt: deletedAt},
+			action: func(u *entity.User) error {
+				return u.Restore(deletedAt.Add(entity.UserDeletionGracePeriod - time.Second))
+			},
+			want: entity.User{UpdatedAt: deletedAt.Add(entity.UserDeletionGracePeriod - time.Second)},
+		},
+		"restore after grace period": {
+			user:    entity.User{DeletedAt: deletedAt},
+			action:  func(u *entity.User) error { return u.Restore(deletedAt.Add(entity.UserDeletionGracePeriod)) },
+			want:    entity.User{DeletedAt: deletedAt},
+			wantErr: "restore user deleted at 2024-11-08 00:00:00 +0000 UTC but grace period has ended",
+		},
+		"restore not deleted user": {
+			user:    entity.User{},
+			action:  func(u *entity.User) error { return u.Restore(deletedAt) },
+			want:    entity.User{},
+			wantErr: "restore user but user is not deleted",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			u := tc.user
+
+			err := tc.action(&u)
+
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				assert.True(t, apperr.IsCode(err, apperr.CodeFailedPrecondition))
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.want, u)
+		})
+	}
+}
+
+func TestUser_PurgeAt(t *testing.T) {
+	assert.Zero(t, entity.User{}.PurgeAt())
+	deletedAt := time.Date(2024, 11, 8, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, 12, 8, 0, 0, 0, 0, time.UTC), entity.User{DeletedAt: deletedAt}.PurgeAt())
+}
+
+func TestUser_DisableAndEnable(t *testing.T) {
+	disabledAt := time.Date(2024, 11, 8, 0, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		user    entity.User
+		action  func(u *entity.User) error
+		want    entity.User
+		wantErr string
+	}{
+		"disable": {
+			user:   entity.User{},
+			action: func(u *entity.User) error { return u.Disable(disabledAt) },
+			want:   entity.User{DisabledAt: disabledAt, UpdatedAt: disabledAt},
+		},
+		"disable disabled user": {
+			user:    entity.User{DisabledAt: disabledAt},
+			action:  func(u *entity.User) error { return u.Disable(disabledAt) },
+			want:    entity.User{DisabledAt: disabledAt},
+			wantErr: `disable user "00000000-0000-0000-0000-000000000000" but user is already disabled`,
+		},
+		"enable": {
+			user:   entity.User{DisabledAt: disabledAt},
+			action: func(u *entity.User) error { return u.Enable(disabledAt.Add(time.Hour)) },
+			want:   entity.User{UpdatedAt: disabledAt.Add(time.Hour)},
+		},
+		"enable not disabled user": {
+			user:    entity.User{},
+			action:  func(u *entity.User) error { return u.Enable(disabledAt) },
+			want:    entity.User{},
+			wantErr: `enable user "00000000-0000-0000-0000-000000000000" but user is not disabled`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			u := tc.user
+
+			err := tc.action(&u)
+
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				assert.True(t, apperr.IsCode(err, apperr.CodeFailedPrecondition))
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.want, u)
+		})
+	}
+}
+
+func TestUser_VerifyEmail(t *testing.T) {
+	now := time.Date(2024, 11, 8, 0, 0, 0, 0, time.UTC)
+	userID := uuid.MustParse("0193196b-28c4-7337-a891-e728860339cd")
+	tests := map[string]struct {
+		user         entity.User
+		verification entity.EmailVerification
+		want         entity.User
+		wantErr      string
+		wantCode     apperr.Code
+	}{
+		"verify": {
+			user:         entity.User{ID: userID, Email: "user@example.com"},
+			verification: entity.EmailVerification{UserID: userID, Email: "user@example.com"},
+			want:         entity.User{ID: userID, Email: "user@example.com", EmailVerified: true, UpdatedAt: now},
+		},
+		"verification of other user": {
+			user:         entity.User{ID: userID, Email: "user@example.com"},
+			verification: entity.EmailVerification{Email: "user@example.com"},
+			want:         entity.User{ID: userID, Email: "user@example.com"},
+			wantErr:      `verify email of user "0193196b-28c4-7337-a891-e728860339cd" by verification of user "00000000-0000-0000-0000-000000000000" and email "user@example.com"`,
+			wantCode:     apperr.CodeInvalidArgument,
+		},
+		"verification of email before change": {
+			user:         entity.User{ID: userID, Email: "new@example.com"},
+			verification: entity.EmailVerification{UserID: userID, Email: "old@example.com"},
+			want:         entity.User{ID: userID, Email: "new@example.com"},
+			wantErr:      `verify email of user "0193196b-28c4-7337-a891-e728860339cd" by verification of user "0193196b-28c4-7337-a891-e728860339cd" and email "old@example.com"`,
+			wantCode:     apperr.CodeInvalidArgument,
+		},
+		"already verified": {
+			user:         entity.User{ID: userID, Email: "user@example.com", EmailVerified: true},
+			verification: entity.EmailVerification{UserID: userID, Email: "user@example.com"},
+			want:         entity.User{ID: userID, Email: "user@example.com", EmailVerified: true},
+			wantErr:      `verify email of user "0193196b-28c4-7337-a891-e728860339cd" but email is already verified`,
+			wantCode:     apperr.CodeFailedPrecondition,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			u := tc.user
+
+			err := u.VerifyEmail(tc.verification, now)
+
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				assert.True(t, apperr.IsCode(err, tc.wantCode))
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.want, u)
+		})
+	}
+}
+
+func TestUserCursor(t *testing.T) {
+	user := entity.User{ID: uuid.MustParse("0193196b-28c4-7337-a891-e728860339cd")}
+
+	token, err := user.EncodeCursor()
+	require.NoError(t, err)
+	got, err := entity.DecodeUserCursor(token)
+
+	require.NoError(t, err)
+	assert.Equal(t, entity.UserCursor{ID: "0193196b-28c4-7337-a891-e728860339cd"}, got)
+}
+
+func TestDecodeUserCursor(t *testing.T) {
+	tests := map[string]struct {
+		token   string
+		want    entity.UserCursor
+		wantErr string
+	}{
+		"empty token": {},
+		"invalid base64": {
+			token:   "!",
+			wantErr: "decode user cursor by base64: illegal base64 data at input byte 0",
+		},
+		"invalid id": {
+			token:   "eyJpZCI6InRhc2sifQ==",
+			wantErr: "parse id of user cursor: invalid UUID length: 4",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := entity.DecodeUserCursor(tc.token)
+
+			assert.Equal(t, tc.want, got)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				assert.True(t, apperr.IsCode(err, apperr.CodeInvalidArgument))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"errors"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+	"maps"
+	"slices"
+	"strings"
+	"unicode"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// validationError converts err returned by ozzo-validation into [apperr.Error] which tells client each invalid field.
+// Client message is generic one, since message of err is in English and names fields of Go struct. Details are in field errors.
+func validationError(text string, err error) error {
+	return apperr.New(
+		text,
+		"Some fields are invalid",
+		apperr.WithMessageID(message.InvalidFields),
+		apperr.WithCause(err),
+		apperr.WithFieldErrors(fieldErrors("", err)...),
+		apperr.CodeInvalidArgument,
+	)
+}
+
+// fieldErrors flattens err of field at given path. Nested fields and elements are joined by dot.
+func fieldErrors(path string, err error) []apperr.FieldError {
+	var errs validation.Errors
+	if errors.As(err, &errs) {
+		var fields []apperr.FieldError
+		// Errors is map, so fields are sorted to be reported in stable order.
+		for _, name := range slices.Sorted(maps.Keys(errs)) {
+			fields = append(fields, fieldErrors(joinFieldPath(path, fieldName(name)), errs[name])...)
+		}
+		return fields
+	}
+	rule := "invalid"
+	var ruleErr validation.Error
+	if errors.As(err, &ruleErr) {
+		rule = strings.TrimPrefix(ruleErr.Code(), "validation_")
+	}
+	return []apperr.FieldError{{Field: path, Rule: rule, Message: err.Error()}}
+}
+
+// fieldName converts name of Go struct field into name of API field in lower camel case, such as GivenName to givenName and ID to id.
+func fieldName(name string) string {
+	r := []rune(name)
+	n := 0
+	for n < len(r) && unicode.IsUpper(r[n]) {
+		n++
+	}
+	// Last upper case letter of acronym followed by word is head of the word, such as P of URLPath.
+	if n > 1 && n < len(r) {
+		n--
+	}
+	for i := range n {
+		r[i] = unicode.ToLower(r[i])
+	}
+	return string(r)
+}
+
+func joinFieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
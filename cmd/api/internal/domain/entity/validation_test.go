@@ -0,0 +1,71 @@
+package entity_test
+
+import (
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/pkg/apperr"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldErrors(t *testing.T) {
+	userID := uuid.MustParse("01928120-055d-7edb-a12a-2d290512266e")
+	now := time.Date(2026, 10, 27, 9, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		validate func() error
+		want     []apperr.FieldError
+	}{
+		"fields are named in lower camel case and sorted": {
+			validate: func() error {
+				_, err := entity.NewUser("f828f57a-b082-4af2-afb4-f3d0fe2c8697", "", "", "not email", true)
+				return err
+			},
+			want: []apperr.FieldError{
+				{Field: "email", Rule: "is_email", Message: "must be a valid email address"},
+				{Field: "familyName", Rule: "required", Message: "cannot be blank"},
+				{Field: "givenName", Rule: "required", Message: "cannot be blank"},
+			},
+		},
+		"elements are indexed": {
+			validate: func() error {
+				_, _, err := entity.NewPersonalAccessToken(userID, "ci", []string{"read:tasks", "write:everything"}, now.Add(time.Hour), now)
+				return err
+			},
+			want: []apperr.FieldError{
+				{Field: "scopes.1", Rule: "in_invalid", Message: "must be a valid value"},
+			},
+		},
+		"custom rule": {
+			validate: func() error {
+				_, err := entity.NewUserPreferences(userID, "Asia/Atlantis", "ja-JP", 10, time.Monday, now)
+				return err
+			},
+			want: []apperr.FieldError{
+				{Field: "timezone", Rule: "timezone", Message: "must be IANA time zone name"},
+			},
+		},
+		"task content": {
+			validate: func() error {
+				_, err := entity.NewTask(strings.Repeat("a", entity.MaxTaskContentLength+1), uuid.Nil)
+				return err
+			},
+			want: []apperr.FieldError{
+				{Field: "content", Rule: "length_too_long", Message: "the length must be no more than 10000"},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.validate()
+
+			var appErr *apperr.Error
+			require.ErrorAs(t, err, &appErr)
+			assert.True(t, apperr.IsCode(err, apperr.CodeInvalidArgument))
+			assert.Equal(t, tc.want, appErr.FieldErrors())
+		})
+	}
+}
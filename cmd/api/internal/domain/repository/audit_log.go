@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+)
+
+// AuditLogRepository records audit logs.
+type AuditLogRepository interface {
+	// Create appends given audit log.
+	Create(context.Context, entity.AuditLog) error
+	// ListByTarget finds audit logs of given target in order of newest first.
+	ListByTarget(context.Context, string) ([]entity.AuditLog, error)
+}
@@ -0,0 +1,9 @@
+package repository
+
+import "context"
+
+// MailRepository sends mails to users.
+type MailRepository interface {
+	// Send sends plain text mail to given address.
+	Send(ctx context.Context, to, subject, body string) error
+}
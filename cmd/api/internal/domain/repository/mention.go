@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// MentionRepository manipulates users mentioned in tasks.
+type MentionRepository interface {
+	// ReplaceTaskMentions replaces users mentioned in given task with given user ids.
+	ReplaceTaskMentions(context.Context, entity.TaskID, []uuid.UUID) error
+	// ListMentionedTasks finds paginated tasks mentioning given user.
+	ListMentionedTasks(context.Context, uuid.UUID, entity.TaskID, int32) (entity.Page[entity.Task], error)
+}
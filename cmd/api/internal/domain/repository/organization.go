@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// OrganizationRepository manipulates organizations and their members.
+type OrganizationRepository interface {
+	// Create creates given organization.
+	Create(context.Context, entity.Organization) error
+	// ListByUserID lists organizations which given user belongs to.
+	ListByUserID(context.Context, uuid.UUID) ([]entity.Organization, error)
+	// AddMember adds given member to organization.
+	AddMember(context.Context, entity.OrganizationMember) error
+	// FindMember finds member of given user in given organization. Error will be returned if user is not member.
+	FindMember(ctx context.Context, orgID entity.OrganizationID, userID uuid.UUID) (entity.OrganizationMember, error)
+	// ListMembers lists members of given organization.
+	ListMembers(context.Context, entity.OrganizationID) ([]entity.OrganizationMember, error)
+	// RemoveMember removes given user from given organization. Error will be returned if user is not member.
+	RemoveMember(ctx context.Context, orgID entity.OrganizationID, userID uuid.UUID) error
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// PersonalAccessTokenRepository manipulates personal access token datastore.
+type PersonalAccessTokenRepository interface {
+	Create(context.Context, entity.PersonalAccessToken) error
+	// ListByUserID lists tokens of given user from newest. Revoked and expired tokens are included.
+	ListByUserID(context.Context, uuid.UUID) ([]entity.PersonalAccessToken, error)
+	// FindByIDForUpdate finds and locks token by given id. This must be called in transaction.
+	FindByIDForUpdate(context.Context, entity.PersonalAccessTokenID) (entity.PersonalAccessToken, error)
+	// FindByHash finds token by hash of raw token. Error will be returned if token is not found.
+	FindByHash(context.Context, []byte) (entity.PersonalAccessToken, error)
+	// Update updates revocation of given token.
+	Update(context.Context, entity.PersonalAccessToken) error
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskArchiveRepository is interface to move tasks between active and archive datasource.
+type TaskArchiveRepository interface {
+	// ArchiveTasks moves at most limit tasks not updated since before to archive and returns number of moved tasks.
+	// This must be called in transaction. Tasks being archived by other transaction are skipped.
+	ArchiveTasks(ctx context.Context, before time.Time, limit int32) (int64, error)
+	// Unarchive moves archived task of given id back. Error will be returned if task is not archived.
+	// This must be called in transaction.
+	Unarchive(context.Context, entity.TaskID) error
+	// ArchiveOwnedTasks moves all tasks of given owner to archive as archived at given time and returns number of moved tasks.
+	// This must be called in transaction.
+	ArchiveOwnedTasks(ctx context.Context, ownerID uuid.UUID, at time.Time) (int64, error)
+	// UnarchiveOwnedTasks moves tasks of given owner archived at or after since back and returns number of moved tasks.
+	// This must be called in transaction.
+	UnarchiveOwnedTasks(ctx context.Context, ownerID uuid.UUID, since time.Time) (int64, error)
+	// FindByID finds task by given id from both active and archived tasks. Error will be returned if task is not found.
+	FindByID(context.Context, entity.TaskID) (entity.Task, error)
+	// ListTasks finds paginated tasks including archived tasks.
+	ListTasks(context.Context, entity.TaskID, int32) (entity.Page[entity.Task], error)
+	// ListOwnedTasks finds all tasks of given owner including archived tasks in all organizations.
+	ListOwnedTasks(ctx context.Context, ownerID uuid.UUID) ([]entity.Task, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TimeEntryRepository manipulates time entries datastore.
+type TimeEntryRepository interface {
+	// FindRunningForUpdate locks time entries of given user until end of transaction and finds running timer.
+	// Error will be returned if no timer is running.
+	FindRunningForUpdate(context.Context, uuid.UUID) (entity.TimeEntry, error)
+	// ListInRange finds time entries of given user overlapping range [from, to).
+	ListInRange(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]entity.TimeEntry, error)
+	Create(context.Context, entity.TimeEntry) error
+	Update(context.Context, entity.TimeEntry) error
+}
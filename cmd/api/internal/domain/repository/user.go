@@ -3,6 +3,9 @@ package repository
 import (
 	"context"
 	"go-playground/cmd/api/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 // UserRepository manipulates user datastore.
@@ -10,4 +13,18 @@ type UserRepository interface {
 	// Create creates new user with given entity of user.
 	FindBySub(context.Context, string) (entity.User, error)
 	Create(context.Context, entity.User) error
+	// FindByID finds user by given id. Error will be returned if user is not found.
+	FindByID(context.Context, uuid.UUID) (entity.User, error)
+	// ListByEmails finds users with given emails. Unknown emails are ignored.
+	ListByEmails(context.Context, []string) ([]entity.User, error)
+	// FindBySubForUpdate finds and locks user by given sub. This must be called in transaction.
+	FindBySubForUpdate(context.Context, string) (entity.User, error)
+	// Update updates given user.
+	Update(context.Context, entity.User) error
+	// Search finds paginated users whose email, given name or family name starts with given query.
+	// Empty query matches all users. next is id of first user in page and [uuid.Nil] means first page.
+	Search(ctx context.Context, query string, next uuid.UUID, limit int32) (entity.Page[entity.User], error)
+	// PurgeDeleted permanently deletes at most limit users deleted before given time with rows owned by them.
+	// This must be called in transaction. Users being purged by other transaction are skipped.
+	PurgeDeleted(ctx context.Context, before time.Time, limit int32) (int64, error)
 }
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+)
+
+// UserExportRepository manipulates user export datastore.
+type UserExportRepository interface {
+	Create(context.Context, entity.UserExport) error
+	// FindByID finds user export by given id. Error will be returned if export is not found.
+	FindByID(context.Context, entity.UserExportID) (entity.UserExport, error)
+	// FindPendingForUpdate finds and locks oldest pending export. This must be called in transaction.
+	// Exports locked by other transaction are skipped, and error will be returned if no export is pending.
+	FindPendingForUpdate(context.Context) (entity.UserExport, error)
+	// Update updates status and content of given export.
+	Update(context.Context, entity.UserExport) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// UserPreferencesRepository manipulates user preferences datastore.
+type UserPreferencesRepository interface {
+	// Find finds preferences of given user. Error will be returned if user has never saved preferences.
+	Find(context.Context, uuid.UUID) (entity.UserPreferences, error)
+	// Save inserts or updates given preferences.
+	Save(context.Context, entity.UserPreferences) error
+}
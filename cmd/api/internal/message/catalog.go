@@ -0,0 +1,13 @@
+package message
+
+import (
+	"go-playground/pkg/apperr"
+
+	"golang.org/x/text/language"
+)
+
+// Catalog is catalog of client messages in supported languages. English is used by default.
+var Catalog = apperr.NewCatalog(
+	apperr.Translation{Language: language.English, Messages: english},
+	apperr.Translation{Language: language.Japanese, Messages: japanese},
+)
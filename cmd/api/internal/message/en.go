@@ -0,0 +1,62 @@
+package message
+
+import "go-playground/pkg/apperr"
+
+// english is client messages in English. It is used when no preferred language of request is supported.
+var english = map[apperr.MessageID]string{
+	InternalServerError:      "Internal server error",
+	InternalPanic:            "Unexpected error was happened. Please report this error you have checked.",
+	AuthorizationFailure:     "Authorization failure",
+	InsufficientScope:        "Insufficient scope",
+	AccountDisabled:          "Account is disabled",
+	AccountDeleted:           "Account is deleted. Only restoring or exporting it is allowed until it is purged",
+	PermissionDenied:         "Permission denied",
+	InvalidToken:             "Invalid token",
+	InvalidRequest:           "Invalid request",
+	InvalidGroupBy:           "Invalid group_by parameter",
+	InvalidRender:            "Invalid render parameter",
+	InvalidWeekStart:         "Invalid weekStart",
+	InvalidCursor:            "Invalid cursor",
+	BatchSizeOutOfRange:      "Batch size must be between 1 and %d",
+	FieldNotUpdatable:        "%s can not be updated",
+	FieldMustBeString:        "%s must be a string",
+	FieldRequired:            "%s is required",
+	FieldInvalidFormat:       "%s has invalid format",
+	FieldTooManyValues:       "%s must be a single value",
+	InvalidFields:            "Some fields are invalid",
+	OrganizationNotSelected:  "Organization is not selected",
+	NotMemberOfOrganization:  "You are not a member of this organization",
+	MemberNotFound:           "Member not found",
+	AlreadyMember:            "User is already a member",
+	AmbiguousInvitee:         "More than one user has this email",
+	InvalidOrganizationRole:  "Role must be admin or member",
+	InviteRoleNotAllowed:     "You are not allowed to invite member with this role",
+	RemoveMemberNotAllowed:   "You are not allowed to remove this member",
+	UserNotFound:             "User not found",
+	UserAlreadyExists:        "User already exists",
+	UserAlreadyDeleted:       "User is already deleted",
+	UserNotDeleted:           "User is not deleted",
+	UserAlreadyDisabled:      "User is already disabled",
+	UserNotDisabled:          "User is not disabled",
+	RestorePeriodEnded:       "Grace period to restore user has ended",
+	CannotChangeOwnAccount:   "You can not change your own account",
+	EmailAlreadyVerified:     "Email is already verified",
+	InvalidVerificationToken: "Invalid or expired verification token",
+	TaskNotFound:             "Task not found",
+	ArchivedTaskNotFound:     "Archived task not found",
+	TaskContentEmpty:         "Task content must not be empty",
+	TaskContentTooLong:       "Task content must be at most %d characters",
+	TimerAlreadyRunning:      "Timer is already running. Stop it before starting a new one",
+	TimerNotRunning:          "No timer is running on the task",
+	TimerAlreadyStopped:      "Timer is already stopped",
+	TimerStoppedBeforeStart:  "Timer can not be stopped before it starts",
+	TimeEntryEndsBeforeStart: "Time entry must end after it starts",
+	TimeEntryEndsInFuture:    "Time entry must not end in the future",
+	TimeReportInvalidRange:   "from must not be after to",
+	TimeReportTooLong:        "Time report must be at most %d days",
+	TokenNotFound:            "Token not found",
+	TokenAlreadyRevoked:      "Token is already revoked",
+	TokenScopeNotGranted:     "Token can not be granted scopes you do not have",
+	ExportNotFound:           "Export not found",
+	ExportNotReady:           "Export is not ready",
+}
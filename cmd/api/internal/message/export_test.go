@@ -0,0 +1,6 @@
+package message
+
+var (
+	English  = english
+	Japanese = japanese
+)
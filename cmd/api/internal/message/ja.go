@@ -0,0 +1,62 @@
+package message
+
+import "go-playground/pkg/apperr"
+
+// japanese is client messages in Japanese.
+var japanese = map[apperr.MessageID]string{
+	InternalServerError:      "サーバー内部でエラーが発生しました",
+	InternalPanic:            "予期しないエラーが発生しました。このエラーを報告してください。",
+	AuthorizationFailure:     "認可に失敗しました",
+	InsufficientScope:        "アクセストークンのスコープが不足しています",
+	AccountDisabled:          "アカウントは無効化されています",
+	AccountDeleted:           "アカウントは削除されています。完全に削除されるまでは復元とエクスポートのみ可能です",
+	PermissionDenied:         "権限がありません",
+	InvalidToken:             "トークンが無効です",
+	InvalidRequest:           "リクエストが不正です",
+	InvalidGroupBy:           "group_by パラメータが不正です",
+	InvalidRender:            "render パラメータが不正です",
+	InvalidWeekStart:         "weekStart が不正です",
+	InvalidCursor:            "カーソルが不正です",
+	BatchSizeOutOfRange:      "バッチサイズは 1 以上 %d 以下にしてください",
+	FieldNotUpdatable:        "%s は更新できません",
+	FieldMustBeString:        "%s は文字列にしてください",
+	FieldRequired:            "%s を指定してください",
+	FieldInvalidFormat:       "%s の形式が不正です",
+	FieldTooManyValues:       "%s は1つだけ指定してください",
+	InvalidFields:            "不正な項目があります",
+	OrganizationNotSelected:  "組織が選択されていません",
+	NotMemberOfOrganization:  "この組織のメンバーではありません",
+	MemberNotFound:           "メンバーが見つかりません",
+	AlreadyMember:            "ユーザーは既にメンバーです",
+	AmbiguousInvitee:         "このメールアドレスのユーザーが複数います",
+	InvalidOrganizationRole:  "ロールは admin か member にしてください",
+	InviteRoleNotAllowed:     "このロールのメンバーを招待する権限がありません",
+	RemoveMemberNotAllowed:   "このメンバーを削除する権限がありません",
+	UserNotFound:             "ユーザーが見つかりません",
+	UserAlreadyExists:        "ユーザーは既に存在します",
+	UserAlreadyDeleted:       "ユーザーは既に削除されています",
+	UserNotDeleted:           "ユーザーは削除されていません",
+	UserAlreadyDisabled:      "ユーザーは既に無効化されています",
+	UserNotDisabled:          "ユーザーは無効化されていません",
+	RestorePeriodEnded:       "ユーザーを復元できる猶予期間は終了しています",
+	CannotChangeOwnAccount:   "自分自身のアカウントは変更できません",
+	EmailAlreadyVerified:     "メールアドレスは既に確認済みです",
+	InvalidVerificationToken: "確認トークンが無効か有効期限切れです",
+	TaskNotFound:             "タスクが見つかりません",
+	ArchivedTaskNotFound:     "アーカイブされたタスクが見つかりません",
+	TaskContentEmpty:         "タスクの内容を入力してください",
+	TaskContentTooLong:       "タスクの内容は %d 文字以内にしてください",
+	TimerAlreadyRunning:      "タイマーは既に動いています。新しいタイマーを開始する前に停止してください",
+	TimerNotRunning:          "このタスクで動いているタイマーはありません",
+	TimerAlreadyStopped:      "タイマーは既に停止しています",
+	TimerStoppedBeforeStart:  "タイマーは開始より前の時刻に停止できません",
+	TimeEntryEndsBeforeStart: "作業時間の終了は開始より後にしてください",
+	TimeEntryEndsInFuture:    "作業時間の終了を未来にすることはできません",
+	TimeReportInvalidRange:   "from は to 以前の日付にしてください",
+	TimeReportTooLong:        "タイムレポートの期間は %d 日以内にしてください",
+	TokenNotFound:            "トークンが見つかりません",
+	TokenAlreadyRevoked:      "トークンは既に失効しています",
+	TokenScopeNotGranted:     "自分に付与されていないスコープはトークンに付与できません",
+	ExportNotFound:           "エクスポートが見つかりません",
+	ExportNotReady:           "エクスポートはまだ準備できていません",
+}
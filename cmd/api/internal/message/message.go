@@ -0,0 +1,88 @@
+// Package message is catalog of client messages of errors.
+//
+// Errors identify client messages by [apperr.WithMessageID] with ids in this package,
+// and messages are translated into language of request when error response is written.
+package message
+
+import "go-playground/pkg/apperr"
+
+const (
+	// Messages of unexpected errors.
+	InternalServerError apperr.MessageID = "internal.server_error"
+	InternalPanic       apperr.MessageID = "internal.panic"
+
+	// Messages of authentication and authorization.
+	AuthorizationFailure apperr.MessageID = "auth.authorization_failure"
+	InsufficientScope    apperr.MessageID = "auth.insufficient_scope"
+	AccountDisabled      apperr.MessageID = "auth.account_disabled"
+	AccountDeleted       apperr.MessageID = "auth.account_deleted"
+	PermissionDenied     apperr.MessageID = "auth.permission_denied"
+	InvalidToken         apperr.MessageID = "auth.invalid_token"
+
+	// Messages of malformed requests.
+	InvalidRequest      apperr.MessageID = "request.invalid"
+	InvalidGroupBy      apperr.MessageID = "request.invalid_group_by"
+	InvalidRender       apperr.MessageID = "request.invalid_render"
+	InvalidWeekStart    apperr.MessageID = "request.invalid_week_start"
+	InvalidCursor       apperr.MessageID = "request.invalid_cursor"
+	BatchSizeOutOfRange apperr.MessageID = "request.batch_size_out_of_range"
+	FieldNotUpdatable   apperr.MessageID = "request.field_not_updatable"
+	FieldMustBeString   apperr.MessageID = "request.field_must_be_string"
+	FieldRequired       apperr.MessageID = "request.field_required"
+	FieldInvalidFormat  apperr.MessageID = "request.field_invalid_format"
+	FieldTooManyValues  apperr.MessageID = "request.field_too_many_values"
+	InvalidFields       apperr.MessageID = "request.invalid_fields"
+
+	// Messages of organizations.
+	OrganizationNotSelected apperr.MessageID = "organization.not_selected"
+	NotMemberOfOrganization apperr.MessageID = "organization.not_member"
+	MemberNotFound          apperr.MessageID = "organization.member_not_found"
+	AlreadyMember           apperr.MessageID = "organization.already_member"
+	AmbiguousInvitee        apperr.MessageID = "organization.ambiguous_invitee"
+	InvalidOrganizationRole apperr.MessageID = "organization.invalid_role"
+	InviteRoleNotAllowed    apperr.MessageID = "organization.invite_role_not_allowed"
+	RemoveMemberNotAllowed  apperr.MessageID = "organization.remove_member_not_allowed"
+
+	// Messages of users.
+	UserNotFound        apperr.MessageID = "user.not_found"
+	UserAlreadyExists   apperr.MessageID = "user.already_exists"
+	UserAlreadyDeleted  apperr.MessageID = "user.already_deleted"
+	UserNotDeleted      apperr.MessageID = "user.not_deleted"
+	UserAlreadyDisabled apperr.MessageID = "user.already_disabled"
+	UserNotDisabled     apperr.MessageID = "user.not_disabled"
+	RestorePeriodEnded  apperr.MessageID = "user.restore_period_ended"
+
+	// Messages of administration.
+	CannotChangeOwnAccount apperr.MessageID = "admin.cannot_change_own_account"
+
+	// Messages of email verification.
+	EmailAlreadyVerified     apperr.MessageID = "email.already_verified"
+	InvalidVerificationToken apperr.MessageID = "email.invalid_verification_token"
+
+	// Messages of tasks.
+	TaskNotFound         apperr.MessageID = "task.not_found"
+	ArchivedTaskNotFound apperr.MessageID = "task.archived_not_found"
+	TaskContentEmpty     apperr.MessageID = "task.content_empty"
+	TaskContentTooLong   apperr.MessageID = "task.content_too_long"
+
+	// Messages of time tracking.
+	TimerAlreadyRunning      apperr.MessageID = "time_entry.timer_already_running"
+	TimerNotRunning          apperr.MessageID = "time_entry.timer_not_running"
+	TimerAlreadyStopped      apperr.MessageID = "time_entry.timer_already_stopped"
+	TimerStoppedBeforeStart  apperr.MessageID = "time_entry.timer_stopped_before_start"
+	TimeEntryEndsBeforeStart apperr.MessageID = "time_entry.ends_before_start"
+	TimeEntryEndsInFuture    apperr.MessageID = "time_entry.ends_in_future"
+
+	// Messages of time reports.
+	TimeReportInvalidRange apperr.MessageID = "time_report.invalid_range"
+	TimeReportTooLong      apperr.MessageID = "time_report.too_long"
+
+	// Messages of personal access tokens.
+	TokenNotFound        apperr.MessageID = "token.not_found"
+	TokenAlreadyRevoked  apperr.MessageID = "token.already_revoked"
+	TokenScopeNotGranted apperr.MessageID = "token.scope_not_granted"
+
+	// Messages of user exports.
+	ExportNotFound apperr.MessageID = "export.not_found"
+	ExportNotReady apperr.MessageID = "export.not_ready"
+)
@@ -0,0 +1,33 @@
+package message_test
+
+import (
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestCatalog(t *testing.T) {
+	err := apperr.New("validate task", "too long", apperr.WithMessageID(message.TaskContentTooLong, 1000))
+	var appErr *apperr.Error
+	assert.ErrorAs(t, err, &appErr)
+
+	assert.Equal(t, "Task content must be at most 1000 characters", message.Catalog.ClientMessage(language.English, appErr))
+	assert.Equal(t, "タスクの内容は 1000 文字以内にしてください", message.Catalog.ClientMessage(language.Japanese, appErr))
+}
+
+func TestTranslations(t *testing.T) {
+	for id, en := range message.English {
+		ja, ok := message.Japanese[id]
+		if assert.True(t, ok, "%s is not translated into Japanese", id) {
+			assert.Equal(t, strings.Count(en, "%"), strings.Count(ja, "%"), "%s has different args", id)
+		}
+	}
+	for id := range message.Japanese {
+		_, ok := message.English[id]
+		assert.True(t, ok, "%s is not translated into English", id)
+	}
+}
@@ -0,0 +1,41 @@
+package realtime
+
+// EventType is type of [Event].
+type EventType string
+
+const (
+	// EventTaskCreated notifies task was created.
+	EventTaskCreated EventType = "task.created"
+	// EventTaskUpdated notifies task was updated.
+	EventTaskUpdated EventType = "task.updated"
+	// EventPresenceSync is sent to joined client with current presences in the room.
+	EventPresenceSync EventType = "presence.sync"
+	// EventPresenceJoin notifies member joined the room.
+	EventPresenceJoin EventType = "presence.join"
+	// EventPresenceView notifies member started viewing task. Empty task id means member is viewing no task.
+	// Clients also send this event to tell which task they are viewing.
+	EventPresenceView EventType = "presence.view"
+	// EventPresenceLeave notifies member left the room.
+	EventPresenceLeave EventType = "presence.leave"
+)
+
+// Event is message exchanged over WebSocket.
+type Event struct {
+	Type      EventType  `json:"type"`
+	Subject   string     `json:"subject,omitempty"`
+	TaskID    string     `json:"taskId,omitempty"`
+	Task      *Task      `json:"task,omitempty"`
+	Presences []Presence `json:"presences,omitempty"`
+}
+
+// Task is task payload of task events.
+type Task struct {
+	ID      string `json:"id"`
+	Content string `json:"content"`
+}
+
+// Presence is who is viewing which task.
+type Presence struct {
+	Subject string `json:"subject"`
+	TaskID  string `json:"taskId,omitempty"`
+}
@@ -0,0 +1,98 @@
+package realtime
+
+import (
+	"context"
+	"errors"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/cmd/api/internal/transportlayer/rest"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+)
+
+const (
+	writeTimeout = 5 * time.Second
+	readLimit    = 4 << 10
+)
+
+// Handler serves WebSocket connection and joins it to room of active organization in [Hub].
+//
+// Handler must be wrapped by middleware checking access token and resolving active organization,
+// since members are identified by subject of access token. Connection without active organization is rejected.
+type Handler struct {
+	Hub  *Hub
+	Room string
+}
+
+// ServeHTTP implements [http.Handler].
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	sub, ok := ctxhelper.Subject(r.Context())
+	if !ok {
+		rest.Err(w, r, apperr.CodeUnAuthz, rest.Message(r, message.AuthorizationFailure))
+		return
+	}
+	room, ok := roomOf(r.Context(), h.Room)
+	if !ok {
+		rest.Err(w, r, apperr.CodeInvalidArgument, rest.Message(r, message.OrganizationNotSelected))
+		return
+	}
+	// Hijacked connection inherits deadlines of http.Server. Long-lived connection must not have them.
+	rc := http.NewResponseController(w)
+	_ = rc.SetReadDeadline(time.Time{})
+	_ = rc.SetWriteDeadline(time.Time{})
+	// Origin is not verified because access token is never sent by browsers implicitly.
+	conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{InsecureSkipVerify: true})
+	if err != nil {
+		slog.WarnContext(r.Context(), "failed to accept websocket", slog.String("error", err.Error()))
+		return
+	}
+	defer func() { _ = conn.CloseNow() }()
+	conn.SetReadLimit(readLimit)
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	m := h.Hub.join(room, sub)
+	defer h.Hub.leave(room, m)
+
+	go func() {
+		defer cancel()
+		for {
+			var e Event
+			if err := wsjson.Read(ctx, conn, &e); err != nil {
+				return
+			}
+			if e.Type == EventPresenceView {
+				h.Hub.view(room, m, e.TaskID)
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			_ = conn.Close(websocket.StatusNormalClosure, "")
+			return
+		case <-m.slow:
+			_ = conn.Close(websocket.StatusTryAgainLater, "too slow to receive events")
+			return
+		case msg := <-m.send:
+			if err := write(ctx, conn, msg); err != nil {
+				if !errors.Is(err, context.Canceled) {
+					slog.WarnContext(ctx, "failed to write websocket message", slog.String("error", err.Error()))
+				}
+				return
+			}
+		}
+	}
+}
+
+func write(ctx context.Context, conn *websocket.Conn, msg []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, writeTimeout)
+	defer cancel()
+	return conn.Write(ctx, websocket.MessageText, msg)
+}
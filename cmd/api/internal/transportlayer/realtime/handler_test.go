@@ -0,0 +1,151 @@
+package realtime_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/transportlayer/realtime"
+	"go-playground/pkg/ctxhelper"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withSubject stands in for access token and organization middlewares.
+// Subject and active organization are taken from sub and org query parameters.
+func withSubject(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if sub := r.URL.Query().Get("sub"); sub != "" {
+			r = r.WithContext(ctxhelper.WithSubject(r.Context(), sub))
+		}
+		if org := r.URL.Query().Get("org"); org != "" {
+			r = r.WithContext(ctxhelper.WithOrganizationID(r.Context(), org))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// testOrg is organization of members dialed by [dial].
+const testOrg = "0194c3a1-0000-7000-8000-000000000000"
+
+func dial(t *testing.T, svr *httptest.Server, sub string) *websocket.Conn {
+	t.Helper()
+	return dialOrganization(t, svr, sub, testOrg)
+}
+
+func dialOrganization(t *testing.T, svr *httptest.Server, sub, org string) *websocket.Conn {
+	t.Helper()
+	conn, _, err := websocket.Dial(t.Context(), "ws"+strings.TrimPrefix(svr.URL, "http")+"?sub="+sub+"&org="+org, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.CloseNow() })
+	return conn
+}
+
+func read(t *testing.T, conn *websocket.Conn) realtime.Event {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(t.Context(), 3*time.Second)
+	defer cancel()
+	var e realtime.Event
+	require.NoError(t, wsjson.Read(ctx, conn, &e))
+	return e
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	hub := realtime.NewHub(8)
+	svr := httptest.NewServer(withSubject(&realtime.Handler{Hub: hub, Room: realtime.RoomTasks}))
+	defer svr.Close()
+
+	alice := dial(t, svr, "alice")
+	assert.Equal(t, realtime.Event{Type: realtime.EventPresenceSync, Presences: []realtime.Presence{{Subject: "alice"}}}, read(t, alice))
+
+	bob := dial(t, svr, "bob")
+	assert.Equal(t, realtime.EventPresenceSync, read(t, bob).Type)
+	assert.Equal(t, realtime.Event{Type: realtime.EventPresenceJoin, Subject: "bob"}, read(t, alice))
+
+	require.NoError(t, wsjson.Write(t.Context(), alice, realtime.Event{Type: realtime.EventPresenceView, TaskID: "0197a6f0-4b6e-7c1c-9d1c-6c0e5c0c1a11"}))
+	assert.Equal(t, realtime.Event{Type: realtime.EventPresenceView, Subject: "alice", TaskID: "0197a6f0-4b6e-7c1c-9d1c-6c0e5c0c1a11"}, read(t, bob))
+
+	notifier := realtime.TaskNotifier{Hub: hub, Room: realtime.RoomTasks}
+	notifier.TaskUpdated(ctxhelper.WithOrganizationID(ctxhelper.WithSubject(t.Context(), "alice"), testOrg), "0197a6f0-4b6e-7c1c-9d1c-6c0e5c0c1a11", "updated")
+	want := realtime.Event{
+		Type:    realtime.EventTaskUpdated,
+		Subject: "alice",
+		TaskID:  "0197a6f0-4b6e-7c1c-9d1c-6c0e5c0c1a11",
+		Task:    &realtime.Task{ID: "0197a6f0-4b6e-7c1c-9d1c-6c0e5c0c1a11", Content: "updated"},
+	}
+	assert.Equal(t, want, read(t, alice))
+	assert.Equal(t, want, read(t, bob))
+
+	require.NoError(t, bob.Close(websocket.StatusNormalClosure, ""))
+	assert.Equal(t, realtime.Event{Type: realtime.EventPresenceLeave, Subject: "bob"}, read(t, alice))
+}
+
+func TestHandler_ServeHTTP_Organization(t *testing.T) {
+	const org1, org2 = "0194c3a1-0000-7000-8000-000000000001", "0194c3a1-0000-7000-8000-000000000002"
+	hub := realtime.NewHub(8)
+	svr := httptest.NewServer(withSubject(&realtime.Handler{Hub: hub, Room: realtime.RoomTasks}))
+	defer svr.Close()
+
+	alice := dialOrganization(t, svr, "alice", org1)
+	assert.Equal(t, realtime.Event{Type: realtime.EventPresenceSync, Presences: []realtime.Presence{{Subject: "alice"}}}, read(t, alice))
+	bob := dialOrganization(t, svr, "bob", org2)
+	assert.Equal(t, realtime.Event{Type: realtime.EventPresenceSync, Presences: []realtime.Presence{{Subject: "bob"}}}, read(t, bob))
+
+	notifier := realtime.TaskNotifier{Hub: hub, Room: realtime.RoomTasks}
+	notifier.TaskCreated(ctxhelper.WithOrganizationID(ctxhelper.WithSubject(t.Context(), "bob"), org2), "0197a6f0-4b6e-7c1c-9d1c-6c0e5c0c1a11", "created")
+	assert.Equal(t, "0197a6f0-4b6e-7c1c-9d1c-6c0e5c0c1a11", read(t, bob).TaskID)
+
+	// Edit without organization is never published.
+	notifier.TaskCreated(ctxhelper.WithSubject(t.Context(), "bob"), "0197a6f0-4b6e-7c1c-9d1c-6c0e5c0c1a13", "created")
+
+	// alice receives nothing from other organization, so the next event is the one of her own.
+	notifier.TaskCreated(ctxhelper.WithOrganizationID(ctxhelper.WithSubject(t.Context(), "alice"), org1), "0197a6f0-4b6e-7c1c-9d1c-6c0e5c0c1a12", "created")
+	assert.Equal(t, "0197a6f0-4b6e-7c1c-9d1c-6c0e5c0c1a12", read(t, alice).TaskID)
+}
+
+func TestHandler_ServeHTTP_SlowMember(t *testing.T) {
+	hub := realtime.NewHub(1)
+	svr := httptest.NewServer(withSubject(&realtime.Handler{Hub: hub, Room: realtime.RoomTasks}))
+	defer svr.Close()
+	conn := dial(t, svr, "alice")
+
+	notifier := realtime.TaskNotifier{Hub: hub, Room: realtime.RoomTasks}
+	for range 100 {
+		notifier.TaskUpdated(ctxhelper.WithOrganizationID(t.Context(), testOrg), "0197a6f0-4b6e-7c1c-9d1c-6c0e5c0c1a11", "updated")
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 3*time.Second)
+	defer cancel()
+	var err error
+	for err == nil {
+		_, _, err = conn.Read(ctx)
+	}
+	assert.Equal(t, websocket.StatusTryAgainLater, websocket.CloseStatus(err))
+}
+
+func TestHandler_ServeHTTP_MissingSubject(t *testing.T) {
+	h := &realtime.Handler{Hub: realtime.NewHub(1), Room: realtime.RoomTasks}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/ws", nil)
+
+	h.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.JSONEq(t, `{"code":"unauthorized","detail":"Authorization failure","message":"Authorization failure","status":403,"title":"Forbidden","type":"about:blank"}`, w.Body.String())
+}
+
+func TestHandler_ServeHTTP_MissingOrganization(t *testing.T) {
+	h := &realtime.Handler{Hub: realtime.NewHub(1), Room: realtime.RoomTasks}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequestWithContext(ctxhelper.WithSubject(t.Context(), "alice"), http.MethodGet, "/ws", nil)
+
+	h.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.JSONEq(t, `{"code":"invalidArgument","detail":"Organization is not selected","message":"Organization is not selected","status":400,"title":"Bad Request","type":"about:blank"}`, w.Body.String())
+}
@@ -0,0 +1,161 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"go-playground/pkg/ctxhelper"
+	"log/slog"
+	"sync"
+)
+
+// RoomTasks is room shared by all members editing tasks.
+// Tasks belong to organization, so this is split into room of each active organization by [roomOf].
+const RoomTasks = "tasks"
+
+// roomOf returns room of active organization in ctx, so that members of other organizations never receive events.
+// ok is false if no organization is active, since room shared by all organizations must never be used.
+func roomOf(ctx context.Context, room string) (string, bool) {
+	orgID, ok := ctxhelper.OrganizationID(ctx)
+	if !ok {
+		return "", false
+	}
+	return room + "/" + orgID, true
+}
+
+// Hub fans out events to members connected to each room.
+//
+// Hub never blocks on slow members.
+// Member whose send buffer is full is disconnected, and it can re-join with the latest state.
+type Hub struct {
+	mu         sync.RWMutex
+	rooms      map[string]map[*member]struct{}
+	bufferSize int
+}
+
+// member is a connection joined to room.
+type member struct {
+	subject string
+	// taskID is task which member is viewing. This is guarded by [Hub.mu].
+	taskID string
+	send   chan []byte
+	// slow is closed when member can not keep up with events.
+	slow     chan struct{}
+	slowOnce sync.Once
+}
+
+func (m *member) markSlow() {
+	m.slowOnce.Do(func() { close(m.slow) })
+}
+
+// NewHub creates Hub. bufferSize is number of events buffered for each member.
+func NewHub(bufferSize int) *Hub {
+	return &Hub{
+		rooms:      make(map[string]map[*member]struct{}),
+		bufferSize: bufferSize,
+	}
+}
+
+// Broadcast sends given event to all members in room.
+func (h *Hub) Broadcast(room string, e Event) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	h.broadcast(room, e, nil)
+}
+
+// join adds new member to room, sends current presences to it and notifies others.
+func (h *Hub) join(room, subject string) *member {
+	m := &member{
+		subject: subject,
+		send:    make(chan []byte, h.bufferSize),
+		slow:    make(chan struct{}),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	members, ok := h.rooms[room]
+	if !ok {
+		members = make(map[*member]struct{})
+		h.rooms[room] = members
+	}
+	members[m] = struct{}{}
+	presences := make([]Presence, 0, len(members))
+	for other := range members {
+		presences = append(presences, Presence{Subject: other.subject, TaskID: other.taskID})
+	}
+	h.deliver(m, h.encode(Event{Type: EventPresenceSync, Presences: presences}))
+	h.broadcast(room, Event{Type: EventPresenceJoin, Subject: subject}, m)
+	return m
+}
+
+// view records task which member is viewing and notifies others.
+func (h *Hub) view(room string, m *member, taskID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.rooms[room][m]; !ok {
+		return
+	}
+	m.taskID = taskID
+	h.broadcast(room, Event{Type: EventPresenceView, Subject: m.subject, TaskID: taskID}, m)
+}
+
+// leave removes member from room and notifies others.
+func (h *Hub) leave(room string, m *member) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	members, ok := h.rooms[room]
+	if !ok {
+		return
+	}
+	if _, ok := members[m]; !ok {
+		return
+	}
+	delete(members, m)
+	if len(members) == 0 {
+		delete(h.rooms, room)
+		return
+	}
+	h.broadcast(room, Event{Type: EventPresenceLeave, Subject: m.subject}, nil)
+}
+
+// broadcast sends event to members in room except given member. Caller must hold h.mu.
+func (h *Hub) broadcast(room string, e Event, except *member) {
+	members := h.rooms[room]
+	if len(members) == 0 {
+		return
+	}
+	msg := h.encode(e)
+	if msg == nil {
+		return
+	}
+	for m := range members {
+		if m == except {
+			continue
+		}
+		h.deliver(m, msg)
+	}
+}
+
+// deliver enqueues message without blocking. Member is marked as slow when its buffer is full.
+func (h *Hub) deliver(m *member, msg []byte) {
+	if msg == nil {
+		return
+	}
+	select {
+	case m.send <- msg:
+	default:
+		m.markSlow()
+	}
+}
+
+func (h *Hub) encode(e Event) []byte {
+	msg, err := json.Marshal(e)
+	if err != nil {
+		slog.Error("failed to marshal realtime event", slog.String("type", string(e.Type)), slog.String("error", err.Error()))
+		return nil
+	}
+	return msg
+}
@@ -0,0 +1,44 @@
+package realtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHub_JoinAndLeave(t *testing.T) {
+	hub := NewHub(4)
+
+	alice := hub.join(RoomTasks, "alice")
+	bob := hub.join(RoomTasks, "bob")
+	hub.view(RoomTasks, bob, "0197a6f0-4b6e-7c1c-9d1c-6c0e5c0c1a11")
+	hub.leave(RoomTasks, bob)
+	hub.leave(RoomTasks, alice)
+
+	assert.Empty(t, hub.rooms)
+	assert.JSONEq(t, `{"type":"presence.sync","presences":[{"subject":"alice"}]}`, string(<-alice.send))
+	assert.JSONEq(t, `{"type":"presence.join","subject":"bob"}`, string(<-alice.send))
+	assert.JSONEq(t, `{"type":"presence.view","subject":"bob","taskId":"0197a6f0-4b6e-7c1c-9d1c-6c0e5c0c1a11"}`, string(<-alice.send))
+	assert.JSONEq(t, `{"type":"presence.leave","subject":"bob"}`, string(<-alice.send))
+}
+
+func TestHub_Broadcast_SlowMember(t *testing.T) {
+	hub := NewHub(1)
+	m := hub.join(RoomTasks, "alice") // presence.sync fills the buffer
+
+	hub.Broadcast(RoomTasks, Event{Type: EventTaskCreated})
+
+	select {
+	case <-m.slow:
+	default:
+		t.Fatal("member should be marked as slow")
+	}
+}
+
+func TestHub_Broadcast_UnknownRoom(t *testing.T) {
+	hub := NewHub(1)
+
+	hub.Broadcast("unknown", Event{Type: EventTaskCreated})
+
+	assert.Empty(t, hub.rooms)
+}
@@ -0,0 +1,37 @@
+package realtime
+
+import (
+	"context"
+	"go-playground/pkg/ctxhelper"
+)
+
+// TaskNotifier publishes task edits to members in room of active organization.
+// Edits without active organization are never published.
+type TaskNotifier struct {
+	Hub  *Hub
+	Room string
+}
+
+// TaskCreated publishes [EventTaskCreated].
+func (n *TaskNotifier) TaskCreated(ctx context.Context, id, content string) {
+	n.publish(ctx, EventTaskCreated, id, content)
+}
+
+// TaskUpdated publishes [EventTaskUpdated].
+func (n *TaskNotifier) TaskUpdated(ctx context.Context, id, content string) {
+	n.publish(ctx, EventTaskUpdated, id, content)
+}
+
+func (n *TaskNotifier) publish(ctx context.Context, typ EventType, id, content string) {
+	room, ok := roomOf(ctx, n.Room)
+	if !ok {
+		return
+	}
+	sub, _ := ctxhelper.Subject(ctx)
+	n.Hub.Broadcast(room, Event{
+		Type:    typ,
+		Subject: sub,
+		TaskID:  id,
+		Task:    &Task{ID: id, Content: content},
+	})
+}
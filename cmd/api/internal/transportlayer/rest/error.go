@@ -3,21 +3,70 @@ package rest
 import (
 	"encoding/json"
 	"go-playground/cmd/api/internal/transportlayer/rest/oapi"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/collection"
+	"go-playground/pkg/ctxhelper"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 )
 
-// ErrBody is common error response body.
-type ErrBody struct {
-	Msg string `json:"message"`
-}
+// problemType is type of problems which have no more specific semantics than HTTP status. See RFC 9457 section 4.2.1.
+const problemType = "about:blank"
 
-// Err writes error response.
+// Err writes error response as problem details of RFC 9457 with status of given code.
+// msg is written to both detail and message, and instance is id of request if request is identified.
+// fields are written as errors so that client can tell which fields are invalid.
 func Err(
 	w http.ResponseWriter,
+	r *http.Request,
+	code apperr.Code,
 	msg string,
-	sts int,
+	fields ...apperr.FieldError,
 ) {
+	sts := code.HTTPStatus()
+	title := http.StatusText(sts)
+	if title == "" {
+		// Non-standard status such as 499 has no phrase.
+		title = code.String()
+	}
+	body := oapi.Error{
+		Type:    problemType,
+		Title:   title,
+		Status:  sts,
+		Detail:  &msg,
+		Code:    oapi.ErrorCode(code.String()),
+		Message: msg,
+	}
+	if id, ok := ctxhelper.RequestID(r.Context()); ok {
+		body.Instance = &id
+	}
+	if len(fields) > 0 {
+		errs := collection.SMap(fields, toFieldError)
+		body.Errors = &errs
+	}
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(sts)
-	w.Header().Set("Content-Type", "application/json")
-	_ = json.NewEncoder(w).Encode(oapi.Error{Message: msg})
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// defaultRetryAfter is delay told to client when retryable error does not know when operation may succeed.
+const defaultRetryAfter = time.Second
+
+// RetryAfter sets Retry-After header in seconds if err is retryable, so that client can tell when to retry the request.
+// This must be called before writing status.
+func RetryAfter(w http.ResponseWriter, err *apperr.Error) {
+	if !err.Retryable() {
+		return
+	}
+	d := err.RetryAfter()
+	if d <= 0 {
+		d = defaultRetryAfter
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(d.Seconds()))))
+}
+
+func toFieldError(f apperr.FieldError) oapi.FieldError {
+	return oapi.FieldError{Field: f.Field, Rule: f.Rule, Message: f.Message}
 }
@@ -2,19 +2,83 @@ package rest_test
 
 import (
 	"go-playground/cmd/api/internal/transportlayer/rest"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
 func TestErr(t *testing.T) {
-	w := httptest.NewRecorder()
+	tests := map[string]struct {
+		r        *http.Request
+		code     apperr.Code
+		fields   []apperr.FieldError
+		wantCode int
+		wantBody string
+	}{
+		"internal": {
+			r:        httptest.NewRequest(http.MethodGet, "/", nil),
+			code:     apperr.CodeInternal,
+			wantCode: http.StatusInternalServerError,
+			wantBody: `{"code":"internalServerError","detail":"test","message":"test","status":500,"title":"Internal Server Error","type":"about:blank"}`,
+		},
+		"identified request": {
+			r: httptest.NewRequestWithContext(
+				ctxhelper.WithRequestID(t.Context(), "0d0b5b2e-6b0c-4b8e-9a55-3f0a2f6c7f1d"),
+				http.MethodGet, "/", nil,
+			),
+			code:     apperr.CodeNotFound,
+			wantCode: http.StatusNotFound,
+			wantBody: `{"code":"notfound","detail":"test","instance":"0d0b5b2e-6b0c-4b8e-9a55-3f0a2f6c7f1d","message":"test","status":404,"title":"Not Found","type":"about:blank"}`,
+		},
+		"non-standard status": {
+			r:        httptest.NewRequest(http.MethodGet, "/", nil),
+			code:     apperr.CodeCanceled,
+			wantCode: apperr.StatusClientClosedRequest,
+			wantBody: `{"code":"canceled","detail":"test","message":"test","status":499,"title":"canceled","type":"about:blank"}`,
+		},
+		"invalid fields": {
+			r:        httptest.NewRequest(http.MethodGet, "/", nil),
+			code:     apperr.CodeInvalidArgument,
+			fields:   []apperr.FieldError{{Field: "email", Rule: "is_email", Message: "must be a valid email address"}},
+			wantCode: http.StatusBadRequest,
+			wantBody: `{"code":"invalidArgument","detail":"test","errors":[{"field":"email","rule":"is_email","message":"must be a valid email address"}],"message":"test","status":400,"title":"Bad Request","type":"about:blank"}`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
 
-	rest.Err(w, "test", http.StatusInternalServerError)
+			rest.Err(w, tc.r, tc.code, "test", tc.fields...)
 
-	assert.Equal(t, 500, w.Code)
-	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
-	assert.JSONEq(t, `{"message":"test"}`, w.Body.String())
+			assert.Equal(t, tc.wantCode, w.Code)
+			assert.Equal(t, "application/problem+json", w.Result().Header.Get("Content-Type"))
+			assert.JSONEq(t, tc.wantBody, w.Body.String())
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want string
+	}{
+		"not retryable":        {err: apperr.New("find task", "Task not found", apperr.CodeNotFound)},
+		"retryable code":       {err: apperr.New("update task", "Task is being updated", apperr.CodeConflict), want: "1"},
+		"retry after":          {err: apperr.New("call api", "Too many requests", apperr.WithRetryAfter(time.Minute)), want: "60"},
+		"rounded up to second": {err: apperr.New("call api", "Too many requests", apperr.WithRetryAfter(1500*time.Millisecond)), want: "2"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			rest.RetryAfter(w, tc.err.(*apperr.Error))
+
+			assert.Equal(t, tc.want, w.Result().Header.Get("Retry-After"))
+		})
+	}
 }
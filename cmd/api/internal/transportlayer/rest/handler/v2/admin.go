@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/cmd/api/internal/transportlayer/rest/oapi"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+type AdminHandler struct {
+	AdminInteractor AdminInteractor
+}
+
+// SearchUsers searches users for [GET /admin/users]
+func (a *AdminHandler) SearchUsers(w http.ResponseWriter, r *http.Request, params oapi.SearchUsersParams) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/AdminHandler/SearchUsers").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		var (
+			query string
+			next  string
+			limit int32
+		)
+		if params.Query != nil {
+			query = *params.Query
+		}
+		if params.Next != nil {
+			next = *params.Next
+		}
+		if params.Limit != nil {
+			limit = *params.Limit
+		}
+		page, err := a.AdminInteractor.SearchUsers(r.Context(), sub, query, next, limit)
+		if err != nil {
+			return err
+		}
+		items := make([]oapi.User, len(page.Items))
+		for i, user := range page.Items {
+			items[i] = toUser(user)
+		}
+		return json.NewEncoder(w).Encode(oapi.ResponseUsers{
+			Next:    page.NextToken,
+			HasNext: page.HasNext,
+			Items:   items,
+		})
+	})
+}
+
+// DisableUser disables user for [POST /admin/users/{userId}/disable]
+func (a *AdminHandler) DisableUser(w http.ResponseWriter, r *http.Request, userID oapi.UserID) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/AdminHandler/DisableUser").End()
+
+	a.changeDisabled(w, r, uuid.UUID(userID), a.AdminInteractor.DisableUser)
+}
+
+// EnableUser enables disabled user for [POST /admin/users/{userId}/enable]
+func (a *AdminHandler) EnableUser(w http.ResponseWriter, r *http.Request, userID oapi.UserID) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/AdminHandler/EnableUser").End()
+
+	a.changeDisabled(w, r, uuid.UUID(userID), a.AdminInteractor.EnableUser)
+}
+
+func (a *AdminHandler) changeDisabled(w http.ResponseWriter, r *http.Request, id uuid.UUID, change func(ctx context.Context, sub string, id uuid.UUID) (entity.User, error)) {
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		user, err := change(r.Context(), sub, id)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(w).Encode(toUser(user))
+	})
+}
+
+// AdminGetTask gets any task including archived one for [GET /admin/tasks/{taskId}]
+func (a *AdminHandler) AdminGetTask(w http.ResponseWriter, r *http.Request, taskID oapi.TaskID) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/AdminHandler/AdminGetTask").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		task, err := a.AdminInteractor.FindTask(r.Context(), sub, taskID)
+		if err != nil {
+			return err
+		}
+		res, err := toTask(task, false)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(w).Encode(res)
+	})
+}
@@ -0,0 +1,217 @@
+package handler_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/transportlayer/rest/handler/v2"
+	"go-playground/cmd/api/internal/transportlayer/rest/oapi"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"go-playground/pkg/testhelper"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminHandler_SearchUsers(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "admin-sub")
+	query := "jonathan"
+	var limit int32 = 1
+	type want struct {
+		status int
+		body   string
+	}
+	tests := map[string]struct {
+		ctx    context.Context
+		params oapi.SearchUsersParams
+		setup  func(*testing.T) *handler.AdminHandler
+		want   want
+	}{
+		"success": {
+			ctx:    ctx,
+			params: oapi.SearchUsersParams{Query: &query, Limit: &limit},
+			setup: func(t *testing.T) *handler.AdminHandler {
+				mck := new(MockAdminInteractor)
+				mck.
+					On("SearchUsers", ctx, "admin-sub", "jonathan", "", int32(1)).
+					Return(entity.Page[entity.User]{
+						Items: []entity.User{{
+							ID:            testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812"),
+							Sub:           "80dbb87a-5ce8-4b45-85a0-3b8aec488b7a",
+							GivenName:     "Dibbert",
+							FamilyName:    "Kozey",
+							Email:         "Jonathan74@example.com",
+							EmailVerified: true,
+							CreatedAt:     time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC),
+							UpdatedAt:     time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC),
+							Role:          entity.RoleSupport,
+							DisabledAt:    time.Date(2024, 11, 9, 14, 40, 33, 0, time.UTC),
+						}},
+						NextToken: "next-token",
+						HasNext:   true,
+					}, nil)
+				return &handler.AdminHandler{AdminInteractor: mck}
+			},
+			want: want{
+				status: http.StatusOK,
+				body: `
+{
+  "hasNext": true,
+  "next": "next-token",
+  "items": [
+    {
+      "id": "01930c3a-e82b-700a-b41a-6f58b5c2b812",
+      "sub": "80dbb87a-5ce8-4b45-85a0-3b8aec488b7a",
+      "givenName": "Dibbert",
+      "familyName": "Kozey",
+      "email": "Jonathan74@example.com",
+      "emailVerified": true,
+      "role": "support",
+      "disabledAt": "2024-11-09T14:40:33Z",
+      "createdAt": "2024-11-08T14:40:33Z",
+      "updatedAt": "2024-11-08T14:40:33Z"
+    }
+  ]
+}`,
+			},
+		},
+		"failure permission denied": {
+			ctx: ctx,
+			setup: func(t *testing.T) *handler.AdminHandler {
+				mck := new(MockAdminInteractor)
+				mck.
+					On("SearchUsers", ctx, "admin-sub", "", "", int32(0)).
+					Return(entity.Page[entity.User]{}, apperr.New("user is not allowed", "permission denied", apperr.CodeUnAuthz))
+				return &handler.AdminHandler{AdminInteractor: mck}
+			},
+			want: want{
+				status: http.StatusForbidden,
+				body:   `{"code":"unauthorized","detail":"permission denied","message":"permission denied","status":403,"title":"Forbidden","type":"about:blank"}`,
+			},
+		},
+		"failure missing subject from context": {
+			ctx:   context.Background(),
+			setup: func(t *testing.T) *handler.AdminHandler { return new(handler.AdminHandler) },
+			want: want{
+				status: http.StatusForbidden,
+				body:   `{"code":"unauthorized","detail":"Authorization failure","message":"Authorization failure","status":403,"title":"Forbidden","type":"about:blank"}`,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := tc.setup(t)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(tc.ctx, http.MethodGet, "/admin/users", nil)
+
+			h.SearchUsers(w, r, tc.params)
+
+			assert.Equal(t, tc.want.status, w.Code)
+			assert.JSONEq(t, tc.want.body, w.Body.String())
+		})
+	}
+}
+
+func TestAdminHandler_DisableUser(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "admin-sub")
+	id := testhelper.UUIDFromString(t, "01930c3a-e82b-700a-b41a-6f58b5c2b812")
+	type want struct {
+		status int
+		body   string
+	}
+	tests := map[string]struct {
+		setup func(*testing.T) *handler.AdminHandler
+		want  want
+	}{
+		"success": {
+			setup: func(t *testing.T) *handler.AdminHandler {
+				mck := new(MockAdminInteractor)
+				mck.
+					On("DisableUser", ctx, "admin-sub", id).
+					Return(entity.User{
+						ID:         id,
+						Sub:        "sub1",
+						GivenName:  "Dibbert",
+						FamilyName: "Kozey",
+						Email:      "Jonathan74@example.com",
+						CreatedAt:  time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC),
+						UpdatedAt:  time.Date(2024, 11, 9, 14, 40, 33, 0, time.UTC),
+						Role:       entity.RoleUser,
+						DisabledAt: time.Date(2024, 11, 9, 14, 40, 33, 0, time.UTC),
+					}, nil)
+				return &handler.AdminHandler{AdminInteractor: mck}
+			},
+			want: want{
+				status: http.StatusOK,
+				body: `
+{
+  "id": "01930c3a-e82b-700a-b41a-6f58b5c2b812",
+  "sub": "sub1",
+  "givenName": "Dibbert",
+  "familyName": "Kozey",
+  "email": "Jonathan74@example.com",
+  "emailVerified": false,
+  "role": "user",
+  "disabledAt": "2024-11-09T14:40:33Z",
+  "createdAt": "2024-11-08T14:40:33Z",
+  "updatedAt": "2024-11-09T14:40:33Z"
+}`,
+			},
+		},
+		"failure disable own account": {
+			setup: func(t *testing.T) *handler.AdminHandler {
+				mck := new(MockAdminInteractor)
+				mck.
+					On("DisableUser", ctx, "admin-sub", id).
+					Return(entity.User{}, apperr.New("disable own account", "You can not change your own account", apperr.CodeInvalidArgument))
+				return &handler.AdminHandler{AdminInteractor: mck}
+			},
+			want: want{
+				status: http.StatusBadRequest,
+				body:   `{"code":"invalidArgument","detail":"You can not change your own account","message":"You can not change your own account","status":400,"title":"Bad Request","type":"about:blank"}`,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := tc.setup(t)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/admin/users/"+id.String()+"/disable", nil)
+
+			h.DisableUser(w, r, id)
+
+			assert.Equal(t, tc.want.status, w.Code)
+			assert.JSONEq(t, tc.want.body, w.Body.String())
+		})
+	}
+}
+
+func TestAdminHandler_AdminGetTask(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "admin-sub")
+	mck := new(MockAdminInteractor)
+	mck.
+		On("FindTask", ctx, "admin-sub", "0192b83f-e199-79d1-a872-b3dcf1f4119a").
+		Return(entity.Task{
+			ID:        "0192b83f-e199-79d1-a872-b3dcf1f4119a",
+			Content:   "this is test",
+			CreatedAt: time.Date(2024, 10, 23, 16, 20, 47, 0, time.UTC),
+			UpdatedAt: time.Date(2024, 10, 23, 16, 20, 47, 0, time.UTC),
+		}, nil)
+	h := &handler.AdminHandler{AdminInteractor: mck}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/admin/tasks/0192b83f-e199-79d1-a872-b3dcf1f4119a", nil)
+
+	h.AdminGetTask(w, r, "0192b83f-e199-79d1-a872-b3dcf1f4119a")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `
+{
+  "content": "this is test",
+  "createdAt": "2024-10-23T16:20:47Z",
+  "id": "0192b83f-e199-79d1-a872-b3dcf1f4119a",
+  "updatedAt": "2024-10-23T16:20:47Z"
+}`, w.Body.String())
+}
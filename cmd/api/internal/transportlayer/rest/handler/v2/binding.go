@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"errors"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/cmd/api/internal/transportlayer/rest"
+	"go-playground/cmd/api/internal/transportlayer/rest/oapi"
+	"go-playground/pkg/apperr"
+	"net/http"
+)
+
+// bindingError converts error of binding request parameters by oapi-codegen into [apperr.Error] which tells client the invalid parameter.
+// Messages of fields are translated into language of r, and raw cause is only logged.
+func bindingError(r *http.Request, err error) error {
+	var (
+		formatErr    *oapi.InvalidParamFormatError
+		requiredErr  *oapi.RequiredParamError
+		headerErr    *oapi.RequiredHeaderError
+		tooManyErr   *oapi.TooManyValuesForParamError
+		unmarshalErr *oapi.UnmarshalingParamError
+		cookieErr    *oapi.UnescapedCookieParamError
+		fields       []apperr.FieldError
+	)
+	switch {
+	case errors.As(err, &formatErr):
+		fields = append(fields, apperr.FieldError{Field: formatErr.ParamName, Rule: "format", Message: rest.Message(r, message.FieldInvalidFormat, formatErr.ParamName)})
+	case errors.As(err, &unmarshalErr):
+		fields = append(fields, apperr.FieldError{Field: unmarshalErr.ParamName, Rule: "format", Message: rest.Message(r, message.FieldInvalidFormat, unmarshalErr.ParamName)})
+	case errors.As(err, &cookieErr):
+		fields = append(fields, apperr.FieldError{Field: cookieErr.ParamName, Rule: "format", Message: rest.Message(r, message.FieldInvalidFormat, cookieErr.ParamName)})
+	case errors.As(err, &requiredErr):
+		fields = append(fields, apperr.FieldError{Field: requiredErr.ParamName, Rule: "required", Message: rest.Message(r, message.FieldRequired, requiredErr.ParamName)})
+	case errors.As(err, &headerErr):
+		fields = append(fields, apperr.FieldError{Field: headerErr.ParamName, Rule: "required", Message: rest.Message(r, message.FieldRequired, headerErr.ParamName)})
+	case errors.As(err, &tooManyErr):
+		fields = append(fields, apperr.FieldError{Field: tooManyErr.ParamName, Rule: "too_many_values", Message: rest.Message(r, message.FieldTooManyValues, tooManyErr.ParamName)})
+	}
+	return apperr.New(
+		"bind request parameters",
+		"Invalid request",
+		apperr.WithMessageID(message.InvalidRequest),
+		apperr.WithCause(err),
+		apperr.WithFieldErrors(fields...),
+		apperr.CodeInvalidArgument,
+	)
+}
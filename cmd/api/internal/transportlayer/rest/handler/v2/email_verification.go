@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"encoding/json"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/cmd/api/internal/transportlayer/rest/oapi"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"log/slog"
+	"net/http"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+type EmailVerificationHandler struct {
+	EmailVerificationInteractor EmailVerificationInteractor
+}
+
+// RequestMyEmailVerification sends verification mail to own email for [POST /users/me/email/verification]
+func (h *EmailVerificationHandler) RequestMyEmailVerification(w http.ResponseWriter, r *http.Request) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/EmailVerificationHandler/RequestMyEmailVerification").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		return h.EmailVerificationInteractor.RequestVerification(r.Context(), sub)
+	})
+}
+
+// VerifyMyEmail verifies own email by token in verification mail for [POST /users/me/email/verify]
+func (h *EmailVerificationHandler) VerifyMyEmail(w http.ResponseWriter, r *http.Request) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/EmailVerificationHandler/VerifyMyEmail").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		var body oapi.RequestEmailVerify
+		err := json.NewDecoder(r.Body).Decode(&body)
+		if err != nil {
+			return apperr.New("unmarshal VerifyMyEmail body", "Invalid request", apperr.WithMessageID(message.InvalidRequest), apperr.WithCause(err), apperr.CodeInvalidArgument)
+		}
+		user, err := h.EmailVerificationInteractor.VerifyEmail(r.Context(), sub, body.Token)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(w).Encode(toUser(user))
+	})
+}
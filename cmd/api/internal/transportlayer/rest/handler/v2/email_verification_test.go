@@ -0,0 +1,143 @@
+package handler_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/transportlayer/rest/handler/v2"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"go-playground/pkg/testhelper"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmailVerificationHandler_RequestMyEmailVerification(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "sub1")
+	type want struct {
+		status int
+		body   string
+	}
+	tests := map[string]struct {
+		ctx   context.Context
+		setup func() *MockEmailVerificationInteractor
+		want  want
+	}{
+		"success": {
+			ctx: ctx,
+			setup: func() *MockEmailVerificationInteractor {
+				mck := new(MockEmailVerificationInteractor)
+				mck.On("RequestVerification", ctx, "sub1").Return(nil)
+				return mck
+			},
+			want: want{status: http.StatusOK},
+		},
+		"failure already verified": {
+			ctx: ctx,
+			setup: func() *MockEmailVerificationInteractor {
+				mck := new(MockEmailVerificationInteractor)
+				mck.On("RequestVerification", ctx, "sub1").Return(apperr.New("already verified", "Email is already verified", apperr.CodeFailedPrecondition))
+				return mck
+			},
+			want: want{status: http.StatusBadRequest, body: `{"code":"failedPrecondition","detail":"Email is already verified","message":"Email is already verified","status":400,"title":"Bad Request","type":"about:blank"}`},
+		},
+		"failure missing subject": {
+			ctx:   context.Background(),
+			setup: func() *MockEmailVerificationInteractor { return new(MockEmailVerificationInteractor) },
+			want:  want{status: http.StatusForbidden, body: `{"code":"unauthorized","detail":"Authorization failure","message":"Authorization failure","status":403,"title":"Forbidden","type":"about:blank"}`},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mck := tc.setup()
+			h := &handler.EmailVerificationHandler{EmailVerificationInteractor: mck}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(tc.ctx, http.MethodPost, "/users/me/email/verification", nil)
+
+			h.RequestMyEmailVerification(w, r)
+
+			assert.Equal(t, tc.want.status, w.Code)
+			assert.Equal(t, tc.want.body, strings.TrimSpace(w.Body.String()))
+			mck.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEmailVerificationHandler_VerifyMyEmail(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "sub1")
+	type want struct {
+		status int
+		body   string
+	}
+	tests := map[string]struct {
+		body  string
+		setup func(t *testing.T) *MockEmailVerificationInteractor
+		want  want
+	}{
+		"success": {
+			body: `{"token":"signed-token"}`,
+			setup: func(t *testing.T) *MockEmailVerificationInteractor {
+				mck := new(MockEmailVerificationInteractor)
+				mck.On("VerifyEmail", ctx, "sub1", "signed-token").Return(entity.User{
+					ID:            testhelper.UUIDFromString(t, "01951975-21cb-7991-b0a4-a854c842e258"),
+					Sub:           "sub1",
+					FamilyName:    "family",
+					GivenName:     "given",
+					Email:         "Alvis.Cummerata@example.com",
+					EmailVerified: true,
+					CreatedAt:     time.Date(2025, 02, 18, 14, 0, 0, 0, time.UTC),
+					UpdatedAt:     time.Date(2025, 02, 19, 14, 0, 0, 0, time.UTC),
+					Role:          entity.RoleUser,
+				}, nil)
+				return mck
+			},
+			want: want{
+				status: http.StatusOK,
+				body: `
+				{
+					"id":"01951975-21cb-7991-b0a4-a854c842e258",
+					"sub":"sub1",
+					"role":"user",
+					"familyName":"family",
+					"givenName":"given",
+					"email":"Alvis.Cummerata@example.com",
+					"emailVerified":true,
+					"createdAt":"2025-02-18T14:00:00Z",
+					"updatedAt":"2025-02-19T14:00:00Z"
+				}`,
+			},
+		},
+		"failure invalid token": {
+			body: `{"token":"expired-token"}`,
+			setup: func(t *testing.T) *MockEmailVerificationInteractor {
+				mck := new(MockEmailVerificationInteractor)
+				mck.On("VerifyEmail", ctx, "sub1", "expired-token").Return(entity.User{}, apperr.New("expired", "Invalid or expired verification token", apperr.CodeInvalidArgument))
+				return mck
+			},
+			want: want{status: http.StatusBadRequest, body: `{"code":"invalidArgument","detail":"Invalid or expired verification token","message":"Invalid or expired verification token","status":400,"title":"Bad Request","type":"about:blank"}`},
+		},
+		"failure unmarshal body": {
+			body:  `{`,
+			setup: func(t *testing.T) *MockEmailVerificationInteractor { return new(MockEmailVerificationInteractor) },
+			want:  want{status: http.StatusBadRequest, body: `{"code":"invalidArgument","detail":"Invalid request","message":"Invalid request","status":400,"title":"Bad Request","type":"about:blank"}`},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mck := tc.setup(t)
+			h := &handler.EmailVerificationHandler{EmailVerificationInteractor: mck}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/users/me/email/verify", strings.NewReader(tc.body))
+
+			h.VerifyMyEmail(w, r)
+
+			assert.Equal(t, tc.want.status, w.Code)
+			assert.JSONEq(t, tc.want.body, w.Body.String())
+			mck.AssertExpectations(t)
+		})
+	}
+}
@@ -22,14 +22,15 @@ func ErrorHandlerFunc(w http.ResponseWriter, r *http.Request, fn func(http.Respo
 	if !errors.As(err, &appErr) {
 		slog.ErrorContext(r.Context(), "caught unhandled error", slog.String("error", err.Error()))
 		txn.NoticeError(err)
-		rest.Err(w, "internal server error", http.StatusInternalServerError)
+		rest.Err(w, r, apperr.CodeInternal, rest.ErrMessage(r, err))
 		return
 	}
-	slog.Log(r.Context(), appErr.Level(), appErr.Error(), slog.Any("error", appErr.StackTrace()))
+	slog.LogAttrs(r.Context(), appErr.Level(), appErr.Error(), append(appErr.Attrs(), slog.Any("error", appErr.StackTrace()))...)
 	if appErr.Level() == slog.LevelError {
 		txn.NoticeError(appErr)
 	} else {
 		txn.NoticeExpectedError(appErr)
 	}
-	rest.Err(w, appErr.ClientMessage(), appErr.HTTPStatus())
+	rest.RetryAfter(w, appErr)
+	rest.Err(w, r, appErr.Code(), rest.ErrMessage(r, appErr), appErr.FieldErrors()...)
 }
@@ -1,12 +1,15 @@
 package handler_test
 
 import (
+	"go-playground/cmd/api/internal/message"
 	"go-playground/cmd/api/internal/transportlayer/rest/handler/v2"
 	"go-playground/pkg/apperr"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -18,8 +21,9 @@ func TestErrorHandlerFunc(t *testing.T) {
 		handler func(http.ResponseWriter, *http.Request) error
 	}
 	type want struct {
-		status int
-		body   string
+		status     int
+		retryAfter string
+		body       string
 	}
 	tests := map[string]struct {
 		input input
@@ -49,7 +53,7 @@ func TestErrorHandlerFunc(t *testing.T) {
 			},
 			want: want{
 				status: http.StatusInternalServerError,
-				body:   `{"message":"app unexpected error"}`,
+				body:   `{"code":"internalServerError","detail":"app unexpected error","message":"app unexpected error","status":500,"title":"Internal Server Error","type":"about:blank"}`,
 			},
 		},
 		"handler returns app error(expected)": {
@@ -62,7 +66,38 @@ func TestErrorHandlerFunc(t *testing.T) {
 			},
 			want: want{
 				status: http.StatusBadRequest,
-				body:   `{"message":"app expected error"}`,
+				body:   `{"code":"invalidArgument","detail":"app expected error","message":"app expected error","status":400,"title":"Bad Request","type":"about:blank"}`,
+			},
+		},
+		"handler returns app error in language of request": {
+			input: input{
+				w: httptest.NewRecorder(),
+				r: func() *http.Request {
+					r := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+					r.Header.Set("Accept-Language", "ja-JP,ja;q=0.9,en;q=0.8")
+					return r
+				}(),
+				handler: func(w http.ResponseWriter, r *http.Request) error {
+					return apperr.New("do something", "Invalid request", apperr.WithMessageID(message.InvalidRequest), apperr.CodeInvalidArgument)
+				},
+			},
+			want: want{
+				status: http.StatusBadRequest,
+				body:   `{"code":"invalidArgument","detail":"リクエストが不正です","message":"リクエストが不正です","status":400,"title":"Bad Request","type":"about:blank"}`,
+			},
+		},
+		"handler returns retryable app error": {
+			input: input{
+				w: httptest.NewRecorder(),
+				r: httptest.NewRequest(http.MethodGet, "http://example.com", nil),
+				handler: func(w http.ResponseWriter, r *http.Request) error {
+					return apperr.New("do something", "app retryable error", apperr.WithAttrs(slog.String("taskID", "0192ae1b")), apperr.WithRetryAfter(30*time.Second), apperr.CodeUnavailable)
+				},
+			},
+			want: want{
+				status:     http.StatusServiceUnavailable,
+				retryAfter: "30",
+				body:       `{"code":"unavailable","detail":"app retryable error","message":"app retryable error","status":503,"title":"Service Unavailable","type":"about:blank"}`,
 			},
 		},
 		"handler returns unhandled error": {
@@ -75,7 +110,7 @@ func TestErrorHandlerFunc(t *testing.T) {
 			},
 			want: want{
 				status: http.StatusInternalServerError,
-				body:   `{"message":"internal server error"}`,
+				body:   `{"code":"internalServerError","detail":"Internal server error","message":"Internal server error","status":500,"title":"Internal Server Error","type":"about:blank"}`,
 			},
 		},
 	}
@@ -85,6 +120,7 @@ func TestErrorHandlerFunc(t *testing.T) {
 			handler.ErrorHandlerFunc(v.input.w, v.input.r, v.input.handler)
 
 			assert.Equal(t, v.want.status, v.input.w.Code)
+			assert.Equal(t, v.want.retryAfter, v.input.w.Result().Header.Get("Retry-After"))
 			assert.JSONEq(t, v.want.body, v.input.w.Body.String())
 		})
 	}
@@ -1,18 +1,24 @@
 package handler
 
 import (
+	"context"
 	"fmt"
 	"go-playground/cmd/api/internal/datasource"
 	"go-playground/cmd/api/internal/datasource/database"
-	"go-playground/cmd/api/internal/transportlayer/rest"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/transportlayer/realtime"
 	"go-playground/cmd/api/internal/transportlayer/rest/middleware"
 	"go-playground/cmd/api/internal/transportlayer/rest/oapi"
 	"go-playground/cmd/api/internal/usecase"
+	"go-playground/pkg/collection"
 	"go-playground/pkg/env/v2"
+	"go-playground/pkg/mail"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"time"
 
+	"github.com/auth0/go-jwt-middleware/v3/validator"
 	"github.com/newrelic/go-agent/v3/newrelic"
 	"github.com/rs/cors"
 	"github.com/tecchu11/nrgo-std/nrhttp"
@@ -22,10 +28,19 @@ type handlers struct {
 	*HealthHandler
 	*TaskHandler
 	*UserHandler
+	*TimeEntryHandler
+	*UserExportHandler
+	*AdminHandler
+	*PersonalAccessTokenHandler
+	*EmailVerificationHandler
+	*OrganizationHandler
+	*UserPreferencesHandler
 }
 
 // New creates handler to handle requests.
-func New(app *newrelic.Application, lookup func(string) (string, bool)) (http.Handler, error) {
+//
+// Background workers behind handler run until ctx is done, so ctx should be canceled when server is shut down.
+func New(ctx context.Context, app *newrelic.Application, lookup func(string) (string, bool)) (http.Handler, error) {
 	db, err := database.NewDB(lookup)
 	if err != nil {
 		return nil, fmt.Errorf("new query db: %w", err)
@@ -33,18 +48,89 @@ func New(app *newrelic.Application, lookup func(string) (string, bool)) (http.Ha
 	transactionAdaptor := datasource.NewDBTransactionAdaptor(db)
 	taskAdaptor := datasource.NewTaskAdaptor(db)
 	userAdaptor := datasource.NewUserAdaptor(db)
+	mentionAdaptor := datasource.NewMentionAdaptor(db)
+	timeEntryAdaptor := datasource.NewTimeEntryAdaptor(db)
+	taskArchiveAdaptor := datasource.NewTaskArchiveAdaptor(db)
+	auditLogAdaptor := datasource.NewAuditLogAdaptor(db)
+	userExportAdaptor := datasource.NewUserExportAdaptor(db)
+	personalAccessTokenAdaptor := datasource.NewPersonalAccessTokenAdaptor(db)
+	organizationAdaptor := datasource.NewOrganizationAdaptor(db)
+	userPreferencesAdaptor := datasource.NewUserPreferencesAdaptor(db)
 
-	taskUseCase := usecase.NewTaskUseCase(taskAdaptor, transactionAdaptor)
-	userUseCase := usecase.NewUserUseCase(userAdaptor)
+	applier := env.New(lookup)
+	mailSender := &mail.SMTPSender{
+		Addr: applier.String("MAIL_SMTP_ADDRESS"),
+		From: applier.String("MAIL_FROM"),
+	}
+	// Secret signs email verification tokens. Rotating it invalidates tokens sent before.
+	emailVerificationSecret := applier.String("EMAIL_VERIFICATION_SECRET")
+	// Page of frontend which receives token in query and calls POST /users/me/email/verify.
+	emailVerificationURL := applier.URL("EMAIL_VERIFICATION_URL")
+	if err := applier.Err(); err != nil {
+		return nil, fmt.Errorf("find mail config from env: %w", err)
+	}
+	if len(emailVerificationSecret) < entity.EmailVerificationMinSecretLength {
+		return nil, fmt.Errorf("EMAIL_VERIFICATION_SECRET must be at least %d bytes", entity.EmailVerificationMinSecretLength)
+	}
+	mailAdaptor := datasource.NewMailAdaptor(mailSender)
+
+	taskUseCase := usecase.NewTaskUseCase(taskAdaptor, userAdaptor, organizationAdaptor, mentionAdaptor, transactionAdaptor)
+	userUseCase := usecase.NewUserUseCase(userAdaptor, organizationAdaptor, taskArchiveAdaptor, auditLogAdaptor, transactionAdaptor)
+	timeEntryUseCase := usecase.NewTimeEntryUseCase(taskAdaptor, userAdaptor, timeEntryAdaptor, transactionAdaptor)
+	taskArchiveUseCase := usecase.NewTaskArchiveUseCase(taskArchiveAdaptor, transactionAdaptor)
+	userExportUseCase := usecase.NewUserExportUseCase(userAdaptor, organizationAdaptor, taskArchiveAdaptor, mentionAdaptor, timeEntryAdaptor, userExportAdaptor, transactionAdaptor)
+	adminUseCase := usecase.NewAdminUseCase(userAdaptor, taskArchiveAdaptor, auditLogAdaptor, transactionAdaptor)
+	personalAccessTokenUseCase := usecase.NewPersonalAccessTokenUseCase(userAdaptor, personalAccessTokenAdaptor, auditLogAdaptor, transactionAdaptor)
+	organizationUseCase := usecase.NewOrganizationUseCase(userAdaptor, organizationAdaptor, auditLogAdaptor, transactionAdaptor)
+	userPreferencesUseCase := usecase.NewUserPreferencesUseCase(userAdaptor, userPreferencesAdaptor)
+	emailVerificationUseCase := usecase.NewEmailVerificationUseCase(
+		userAdaptor,
+		auditLogAdaptor,
+		mailAdaptor,
+		transactionAdaptor,
+		[]byte(emailVerificationSecret),
+		emailVerificationURL,
+	)
+
+	// Worker lives until ctx is done. Exports left pending by stopped process are picked up by others periodically.
+	userExportWorker := usecase.NewUserExportWorker(userExportUseCase, time.Minute)
+	go userExportWorker.Run(ctx)
+
+	hub := realtime.NewHub(64)
 
 	health := &HealthHandler{Pinger: db}
-	task := &TaskHandler{TaskInteractor: taskUseCase}
+	task := &TaskHandler{
+		TaskInteractor:        taskUseCase,
+		TaskArchiveInteractor: taskArchiveUseCase,
+		TaskNotifier:          &realtime.TaskNotifier{Hub: hub, Room: realtime.RoomTasks},
+	}
 	user := &UserHandler{UserInteractor: userUseCase}
+	timeEntry := &TimeEntryHandler{TimeEntryInteractor: timeEntryUseCase}
+	userExport := &UserExportHandler{UserExportInteractor: userExportUseCase, UserExportNotifier: userExportWorker}
+	admin := &AdminHandler{AdminInteractor: adminUseCase}
+	personalAccessToken := &PersonalAccessTokenHandler{PersonalAccessTokenInteractor: personalAccessTokenUseCase}
+	emailVerification := &EmailVerificationHandler{EmailVerificationInteractor: emailVerificationUseCase}
+	organization := &OrganizationHandler{OrganizationInteractor: organizationUseCase}
+	userPreferences := &UserPreferencesHandler{UserPreferencesInteractor: userPreferencesUseCase}
 
-	applier := env.New(lookup)
-	issuer := applier.URL("AUTH_ISSUER_URL")
+	var issuerEnvs []issuerEnv
+	applier.JSON("AUTH_ISSUERS", &issuerEnvs)
+	// Users are created from custom claims of access token instead of POST /users if enabled.
+	jitProvisioning := applier.Bool("AUTH_JIT_PROVISIONING", false)
 	if err := applier.Err(); err != nil {
-		return nil, fmt.Errorf("find auth issuer url from env: %w", err)
+		return nil, fmt.Errorf("find auth config from env: %w", err)
+	}
+	// Ratio of logged successful requests. Failed requests are always logged.
+	accessLogSampleRate := applier.Float("ACCESS_LOG_SUCCESS_SAMPLE_RATE", 1)
+	if err := applier.Err(); err != nil {
+		return nil, fmt.Errorf("find access log config from env: %w", err)
+	}
+	if accessLogSampleRate < 0 || accessLogSampleRate > 1 {
+		return nil, fmt.Errorf("ACCESS_LOG_SUCCESS_SAMPLE_RATE must be between 0 and 1 but got %v", accessLogSampleRate)
+	}
+	issuers, err := toIssuerConfigs(issuerEnvs)
+	if err != nil {
+		return nil, fmt.Errorf("parse AUTH_ISSUERS: %w", err)
 	}
 	roundTripper := http.DefaultTransport
 	if t, ok := http.DefaultTransport.(*http.Transport); ok {
@@ -58,11 +144,10 @@ func New(app *newrelic.Application, lookup func(string) (string, bool)) (http.Ha
 	}
 	checkAccessToken, err := middleware.NewCheckAccessToken(
 		middleware.CheckAccessTokenConfig{
-			IssuerURL:     issuer,
-			Audiences:     []string{"backend"},
-			ExclusionURLs: []string{"/health"},
+			Issuers: issuers,
 			HTTPClient: &http.Client{
-				Transport: newrelic.NewRoundTripper(roundTripper),
+				// New Relic overwrites traceparent propagated by this service if distributed tracing is enabled.
+				Transport: middleware.PropagateRequestID(newrelic.NewRoundTripper(roundTripper)),
 				Timeout:   5 * time.Second,
 			},
 			CacheTTL: 5 * time.Minute,
@@ -72,27 +157,127 @@ func New(app *newrelic.Application, lookup func(string) (string, bool)) (http.Ha
 	if err != nil {
 		return nil, fmt.Errorf("new auth middleware: %w", err)
 	}
-	corsMiddleware := cors.AllowAll().Handler
+	// Personal access token is checked in front of JWT, and other tokens are validated as JWT.
+	checkCredential := middleware.NewCheckPersonalAccessToken(personalAccessTokenUseCase, checkAccessToken)
+	rejectDisabledUser := middleware.NewRejectDisabledUser(userUseCase, deletedUserOperations...)
+	resolveOrganization := middleware.NewResolveOrganization(organizationUseCase)
+	loadPreferences := middleware.NewLoadPreferences(userPreferencesUseCase)
+	accessLog := middleware.NewAccessLog(middleware.AccessLogConfig{SuccessSampleRate: accessLogSampleRate})
+	provisionUser := func(next http.Handler) http.Handler { return next }
+	if jitProvisioning {
+		provisionUser = middleware.NewProvisionUser(userUseCase)
+	}
+	// Middlewares are applied from last, so scopes are checked, user is provisioned, disabled user is rejected,
+	// active organization is resolved and preferences are loaded after access token is validated.
+	// User is provisioned first so that new user is found with personal organization by the others.
+	// User is found once by rejectDisabledUser and put on context for the others.
+	// Access log is outermost to log responses by all others.
+	middlewares := []oapi.MiddlewareFunc{
+		loadPreferences,
+		resolveOrganization,
+		rejectDisabledUser,
+		provisionUser,
+		middleware.RequireScopes,
+		middleware.RecordSubject,
+		middleware.NewRequireCredential(checkCredential, oapi.AuthOptionalOperationIDs...),
+		middleware.Recover,
+		nrhttp.Middleware(app),
+		accessLog,
+	}
+	// Same as cors.AllowAll except exposed headers.
+	corsMiddleware := cors.New(cors.Options{
+		AllowedOrigins: []string{"*"},
+		AllowedMethods: []string{
+			http.MethodHead,
+			http.MethodGet,
+			http.MethodPost,
+			http.MethodPut,
+			http.MethodPatch,
+			http.MethodDelete,
+		},
+		AllowedHeaders: []string{"*"},
+		// Browser client can read id of request to tell it in support case.
+		ExposedHeaders: []string{middleware.RequestIDHeader},
+	}).Handler
+	mux := http.NewServeMux()
+	// WebSocket is out of OpenAPI. New Relic middleware is not applied since hijacked connection never ends transaction.
+	// Scopes are checked explicitly since they are not declared by OpenAPI. Task edits are broadcast, so read:tasks is required.
+	requireReadTasks := middleware.NewRequireScopes("read:tasks")
+	mux.Handle(
+		"GET /ws",
+		middleware.Recover(checkCredential(requireReadTasks(provisionUser(rejectDisabledUser(resolveOrganization(&realtime.Handler{Hub: hub, Room: realtime.RoomTasks})))))),
+	)
 	svr := oapi.HandlerWithOptions(
 		&handlers{
-			TaskHandler:   task,
-			HealthHandler: health,
-			UserHandler:   user,
+			TaskHandler:                task,
+			HealthHandler:              health,
+			UserHandler:                user,
+			TimeEntryHandler:           timeEntry,
+			UserExportHandler:          userExport,
+			AdminHandler:               admin,
+			PersonalAccessTokenHandler: personalAccessToken,
+			EmailVerificationHandler:   emailVerification,
+			OrganizationHandler:        organization,
+			UserPreferencesHandler:     userPreferences,
 		},
 		oapi.StdHTTPServerOptions{
-			Middlewares: []oapi.MiddlewareFunc{
-				checkAccessToken,
-				middleware.Recover,
-				nrhttp.Middleware(app),
-			},
+			BaseRouter:  middleware.OperationRouter{ServeMux: mux, OperationIDs: oapi.OperationIDs},
+			Middlewares: middlewares,
+			// Parameters are bound before middlewares, so access log is applied here too.
 			ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-				txn := newrelic.FromContext(r.Context())
-				txn.NoticeExpectedError(err)
-				rest.Err(w, err.Error(), http.StatusBadRequest)
+				accessLog(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					ErrorHandlerFunc(w, r, func(http.ResponseWriter, *http.Request) error {
+						return bindingError(r, err)
+					})
+				})).ServeHTTP(w, r)
 			},
 		},
 	)
-	return corsMiddleware(svr), nil
+	// Request is identified in front of all, so that errors of binding parameters and WebSocket are also identified.
+	return corsMiddleware(middleware.RequestID(svr)), nil
+}
+
+// deletedUserOperations are operationIds allowed to deleted user, so that user can restore or export account during grace period.
+var deletedUserOperations = []string{"RestoreMe", "RequestMyExport", "GetMyExport", "DownloadMyExport"}
+
+// issuerEnv is element of AUTH_ISSUERS, which is JSON array of trusted issuers such as
+//
+//	[{"issuer":"https://idp.example.com/","jwksUrl":"https://idp.example.com/.well-known/jwks.json","audiences":["backend"],"algorithms":["RS256"]}]
+//
+// jwksUrl is discovered from issuer if omitted. audiences defaults to backend and algorithms defaults to RS256.
+type issuerEnv struct {
+	Issuer     string   `json:"issuer"`
+	JWKSURL    string   `json:"jwksUrl"`
+	Audiences  []string `json:"audiences"`
+	Algorithms []string `json:"algorithms"`
+}
+
+func toIssuerConfigs(envs []issuerEnv) ([]middleware.IssuerConfig, error) {
+	configs := make([]middleware.IssuerConfig, len(envs))
+	for i, e := range envs {
+		issuerURL, err := url.Parse(e.Issuer)
+		if err != nil || e.Issuer == "" {
+			return nil, fmt.Errorf("invalid issuer %q at %d", e.Issuer, i)
+		}
+		var jwksURL *url.URL
+		if e.JWKSURL != "" {
+			jwksURL, err = url.Parse(e.JWKSURL)
+			if err != nil {
+				return nil, fmt.Errorf("invalid jwksUrl %q at %d: %w", e.JWKSURL, i, err)
+			}
+		}
+		audiences := e.Audiences
+		if len(audiences) == 0 {
+			audiences = []string{"backend"}
+		}
+		configs[i] = middleware.IssuerConfig{
+			IssuerURL:  issuerURL,
+			JWKSURL:    jwksURL,
+			Audiences:  audiences,
+			Algorithms: collection.SMap(e.Algorithms, func(alg string) validator.SignatureAlgorithm { return validator.SignatureAlgorithm(alg) }),
+		}
+	}
+	return configs, nil
 }
 
 var _ oapi.ServerInterface = (*handlers)(nil)
@@ -2,7 +2,9 @@ package handler_test
 
 import (
 	"context"
+	"fmt"
 	"go-playground/cmd/api/internal/transportlayer/rest/handler/v2"
+	"go-playground/pkg/testhelper"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -23,19 +25,168 @@ func TestNew(t *testing.T) {
 				t.Setenv("DB_PASSWORD", "dummy_password")
 				t.Setenv("DB_ADDRESS", "localhost:3306")
 				t.Setenv("DB_NAME", "dummy")
-				t.Setenv("AUTH_ISSUER_URL", "http://example.com")
+				t.Setenv("MAIL_SMTP_ADDRESS", "localhost:1025")
+				t.Setenv("MAIL_FROM", "noreply@example.com")
+				t.Setenv("EMAIL_VERIFICATION_SECRET", "dummy_secret_which_is_long_enough")
+				t.Setenv("EMAIL_VERIFICATION_URL", "http://localhost:3000/verify-email")
+				t.Setenv("AUTH_ISSUERS", `[{"issuer":"http://example.com"}]`)
+			},
+		},
+		"success with jit provisioning": {
+			setup: func(t *testing.T) {
+				t.Setenv("DB_USER", "dummy_user")
+				t.Setenv("DB_PASSWORD", "dummy_password")
+				t.Setenv("DB_ADDRESS", "localhost:3306")
+				t.Setenv("DB_NAME", "dummy")
+				t.Setenv("MAIL_SMTP_ADDRESS", "localhost:1025")
+				t.Setenv("MAIL_FROM", "noreply@example.com")
+				t.Setenv("EMAIL_VERIFICATION_SECRET", "dummy_secret_which_is_long_enough")
+				t.Setenv("EMAIL_VERIFICATION_URL", "http://localhost:3000/verify-email")
+				t.Setenv("AUTH_ISSUERS", `[{"issuer":"http://example.com"}]`)
+				t.Setenv("AUTH_JIT_PROVISIONING", "true")
 			},
 		},
 		"failure: failed to create query db": {
 			setup:   func(t *testing.T) { /* noop */ },
 			wantErr: true,
 		},
-		"failure: failed to find issuer url": {
+		"success with multiple issuers": {
+			setup: func(t *testing.T) {
+				t.Setenv("DB_USER", "dummy_user")
+				t.Setenv("DB_PASSWORD", "dummy_password")
+				t.Setenv("DB_ADDRESS", "localhost:3306")
+				t.Setenv("DB_NAME", "dummy")
+				t.Setenv("MAIL_SMTP_ADDRESS", "localhost:1025")
+				t.Setenv("MAIL_FROM", "noreply@example.com")
+				t.Setenv("EMAIL_VERIFICATION_SECRET", "dummy_secret_which_is_long_enough")
+				t.Setenv("EMAIL_VERIFICATION_URL", "http://localhost:3000/verify-email")
+				t.Setenv("AUTH_ISSUERS", `[
+				  {"issuer":"http://old.example.com/"},
+				  {"issuer":"http://new.example.com/","jwksUrl":"http://new.example.com/keys","audiences":["api"],"algorithms":["ES256","EdDSA"]}
+				]`)
+			},
+		},
+		"failure: unsupported algorithm": {
+			setup: func(t *testing.T) {
+				t.Setenv("DB_USER", "dummy_user")
+				t.Setenv("DB_PASSWORD", "dummy_password")
+				t.Setenv("DB_ADDRESS", "localhost:3306")
+				t.Setenv("DB_NAME", "dummy")
+				t.Setenv("MAIL_SMTP_ADDRESS", "localhost:1025")
+				t.Setenv("MAIL_FROM", "noreply@example.com")
+				t.Setenv("EMAIL_VERIFICATION_SECRET", "dummy_secret_which_is_long_enough")
+				t.Setenv("EMAIL_VERIFICATION_URL", "http://localhost:3000/verify-email")
+				t.Setenv("AUTH_ISSUERS", `[{"issuer":"http://example.com","algorithms":["HS256"]}]`)
+			},
+			wantErr: true,
+		},
+		"failure: issuer is missing": {
+			setup: func(t *testing.T) {
+				t.Setenv("DB_USER", "dummy_user")
+				t.Setenv("DB_PASSWORD", "dummy_password")
+				t.Setenv("DB_ADDRESS", "localhost:3306")
+				t.Setenv("DB_NAME", "dummy")
+				t.Setenv("MAIL_SMTP_ADDRESS", "localhost:1025")
+				t.Setenv("MAIL_FROM", "noreply@example.com")
+				t.Setenv("EMAIL_VERIFICATION_SECRET", "dummy_secret_which_is_long_enough")
+				t.Setenv("EMAIL_VERIFICATION_URL", "http://localhost:3000/verify-email")
+				t.Setenv("AUTH_ISSUERS", `[{"jwksUrl":"http://example.com/keys"}]`)
+			},
+			wantErr: true,
+		},
+		"failure: failed to find mail config": {
+			setup: func(t *testing.T) {
+				t.Setenv("DB_USER", "dummy_user")
+				t.Setenv("DB_PASSWORD", "dummy_password")
+				t.Setenv("DB_ADDRESS", "localhost:3306")
+				t.Setenv("DB_NAME", "dummy")
+				t.Setenv("AUTH_ISSUERS", `[{"issuer":"http://example.com"}]`)
+			},
+			wantErr: true,
+		},
+		"failure: too short email verification secret": {
+			setup: func(t *testing.T) {
+				t.Setenv("DB_USER", "dummy_user")
+				t.Setenv("DB_PASSWORD", "dummy_password")
+				t.Setenv("DB_ADDRESS", "localhost:3306")
+				t.Setenv("DB_NAME", "dummy")
+				t.Setenv("MAIL_SMTP_ADDRESS", "localhost:1025")
+				t.Setenv("MAIL_FROM", "noreply@example.com")
+				t.Setenv("EMAIL_VERIFICATION_SECRET", "short")
+				t.Setenv("EMAIL_VERIFICATION_URL", "http://localhost:3000/verify-email")
+				t.Setenv("AUTH_ISSUERS", `[{"issuer":"http://example.com"}]`)
+			},
+			wantErr: true,
+		},
+		"failure: failed to find issuers": {
+			setup: func(t *testing.T) {
+				t.Setenv("DB_USER", "dummy_user")
+				t.Setenv("DB_PASSWORD", "dummy_password")
+				t.Setenv("DB_ADDRESS", "localhost:3306")
+				t.Setenv("DB_NAME", "dummy")
+				t.Setenv("MAIL_SMTP_ADDRESS", "localhost:1025")
+				t.Setenv("MAIL_FROM", "noreply@example.com")
+				t.Setenv("EMAIL_VERIFICATION_SECRET", "dummy_secret_which_is_long_enough")
+				t.Setenv("EMAIL_VERIFICATION_URL", "http://localhost:3000/verify-email")
+			},
+			wantErr: true,
+		},
+		"failure: invalid issuers json": {
 			setup: func(t *testing.T) {
 				t.Setenv("DB_USER", "dummy_user")
 				t.Setenv("DB_PASSWORD", "dummy_password")
 				t.Setenv("DB_ADDRESS", "localhost:3306")
 				t.Setenv("DB_NAME", "dummy")
+				t.Setenv("MAIL_SMTP_ADDRESS", "localhost:1025")
+				t.Setenv("MAIL_FROM", "noreply@example.com")
+				t.Setenv("EMAIL_VERIFICATION_SECRET", "dummy_secret_which_is_long_enough")
+				t.Setenv("EMAIL_VERIFICATION_URL", "http://localhost:3000/verify-email")
+				t.Setenv("AUTH_ISSUERS", "http://example.com")
+			},
+			wantErr: true,
+		},
+		"failure: invalid jit provisioning flag": {
+			setup: func(t *testing.T) {
+				t.Setenv("DB_USER", "dummy_user")
+				t.Setenv("DB_PASSWORD", "dummy_password")
+				t.Setenv("DB_ADDRESS", "localhost:3306")
+				t.Setenv("DB_NAME", "dummy")
+				t.Setenv("MAIL_SMTP_ADDRESS", "localhost:1025")
+				t.Setenv("MAIL_FROM", "noreply@example.com")
+				t.Setenv("EMAIL_VERIFICATION_SECRET", "dummy_secret_which_is_long_enough")
+				t.Setenv("EMAIL_VERIFICATION_URL", "http://localhost:3000/verify-email")
+				t.Setenv("AUTH_ISSUERS", `[{"issuer":"http://example.com"}]`)
+				t.Setenv("AUTH_JIT_PROVISIONING", "yes")
+			},
+			wantErr: true,
+		},
+		"failure: invalid access log sample rate": {
+			setup: func(t *testing.T) {
+				t.Setenv("DB_USER", "dummy_user")
+				t.Setenv("DB_PASSWORD", "dummy_password")
+				t.Setenv("DB_ADDRESS", "localhost:3306")
+				t.Setenv("DB_NAME", "dummy")
+				t.Setenv("MAIL_SMTP_ADDRESS", "localhost:1025")
+				t.Setenv("MAIL_FROM", "noreply@example.com")
+				t.Setenv("EMAIL_VERIFICATION_SECRET", "dummy_secret_which_is_long_enough")
+				t.Setenv("EMAIL_VERIFICATION_URL", "http://localhost:3000/verify-email")
+				t.Setenv("AUTH_ISSUERS", `[{"issuer":"http://example.com"}]`)
+				t.Setenv("ACCESS_LOG_SUCCESS_SAMPLE_RATE", "half")
+			},
+			wantErr: true,
+		},
+		"failure: access log sample rate out of range": {
+			setup: func(t *testing.T) {
+				t.Setenv("DB_USER", "dummy_user")
+				t.Setenv("DB_PASSWORD", "dummy_password")
+				t.Setenv("DB_ADDRESS", "localhost:3306")
+				t.Setenv("DB_NAME", "dummy")
+				t.Setenv("MAIL_SMTP_ADDRESS", "localhost:1025")
+				t.Setenv("MAIL_FROM", "noreply@example.com")
+				t.Setenv("EMAIL_VERIFICATION_SECRET", "dummy_secret_which_is_long_enough")
+				t.Setenv("EMAIL_VERIFICATION_URL", "http://localhost:3000/verify-email")
+				t.Setenv("AUTH_ISSUERS", `[{"issuer":"http://example.com"}]`)
+				t.Setenv("ACCESS_LOG_SUCCESS_SAMPLE_RATE", "2")
 			},
 			wantErr: true,
 		},
@@ -45,7 +196,11 @@ func TestNew(t *testing.T) {
 				t.Setenv("DB_PASSWORD", "dummy_password")
 				t.Setenv("DB_ADDRESS", "localhost:3306")
 				t.Setenv("DB_NAME", "dummy")
-				t.Setenv("AUTH_ISSUER_URL", "")
+				t.Setenv("MAIL_SMTP_ADDRESS", "localhost:1025")
+				t.Setenv("MAIL_FROM", "noreply@example.com")
+				t.Setenv("EMAIL_VERIFICATION_SECRET", "dummy_secret_which_is_long_enough")
+				t.Setenv("EMAIL_VERIFICATION_URL", "http://localhost:3000/verify-email")
+				t.Setenv("AUTH_ISSUERS", `[]`)
 			},
 			wantErr: true,
 		},
@@ -55,7 +210,7 @@ func TestNew(t *testing.T) {
 		t.Run(k, func(t *testing.T) {
 			v.setup(t)
 
-			gotHandler, gotErr := handler.New(nil, os.LookupEnv)
+			gotHandler, gotErr := handler.New(t.Context(), nil, os.LookupEnv)
 			if v.wantErr {
 				assert.Empty(t, gotHandler)
 				assert.Error(t, gotErr)
@@ -72,14 +227,107 @@ func TestNew_ErrorHandlerFunc(t *testing.T) {
 	t.Setenv("DB_PASSWORD", "dummy_password")
 	t.Setenv("DB_ADDRESS", "localhost:3306")
 	t.Setenv("DB_NAME", "dummy")
-	t.Setenv("AUTH_ISSUER_URL", "http://example.com")
-	hn, err := handler.New(nil, os.LookupEnv)
+	t.Setenv("MAIL_SMTP_ADDRESS", "localhost:1025")
+	t.Setenv("MAIL_FROM", "noreply@example.com")
+	t.Setenv("EMAIL_VERIFICATION_SECRET", "dummy_secret_which_is_long_enough")
+	t.Setenv("EMAIL_VERIFICATION_URL", "http://localhost:3000/verify-email")
+	t.Setenv("AUTH_ISSUERS", `[{"issuer":"http://example.com"}]`)
+	hn, err := handler.New(t.Context(), nil, os.LookupEnv)
+	require.NoError(t, err)
+	tests := map[string]struct {
+		target   string
+		language string
+		body     string
+	}{
+		"invalid format": {
+			target: "/tasks?limit=not_number",
+			body:   `{"code":"invalidArgument","detail":"Invalid request","errors":[{"field":"limit","rule":"format","message":"limit has invalid format"}],"instance":"req-1","message":"Invalid request","status":400,"title":"Bad Request","type":"about:blank"}`,
+		},
+		"missing required parameter": {
+			target: "/reports/time?to=2026-10-31",
+			body:   `{"code":"invalidArgument","detail":"Invalid request","errors":[{"field":"from","rule":"required","message":"from is required"}],"instance":"req-1","message":"Invalid request","status":400,"title":"Bad Request","type":"about:blank"}`,
+		},
+		"translated": {
+			target:   "/tasks?limit=not_number",
+			language: "ja",
+			body:     `{"code":"invalidArgument","detail":"リクエストが不正です","errors":[{"field":"limit","rule":"format","message":"limit の形式が不正です"}],"instance":"req-1","message":"リクエストが不正です","status":400,"title":"Bad Request","type":"about:blank"}`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(context.Background(), http.MethodGet, tc.target, nil)
+			r.Header.Set("X-Request-ID", "req-1")
+			if tc.language != "" {
+				r.Header.Set("Accept-Language", tc.language)
+			}
+
+			hn.ServeHTTP(w, r)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Equal(t, "req-1", w.Header().Get("X-Request-ID"))
+			assert.JSONEq(t, tc.body, w.Body.String())
+		})
+	}
+}
+
+func TestNew_CheckAccessToken(t *testing.T) {
+	issuer := testhelper.StartIssuer(t)
+	untrusted := testhelper.StartIssuer(t)
+	t.Setenv("DB_USER", "dummy_user")
+	t.Setenv("DB_PASSWORD", "dummy_password")
+	t.Setenv("DB_ADDRESS", "localhost:3306")
+	t.Setenv("DB_NAME", "dummy")
+	t.Setenv("MAIL_SMTP_ADDRESS", "localhost:1025")
+	t.Setenv("MAIL_FROM", "noreply@example.com")
+	t.Setenv("EMAIL_VERIFICATION_SECRET", "dummy_secret_which_is_long_enough")
+	t.Setenv("EMAIL_VERIFICATION_URL", "http://localhost:3000/verify-email")
+	t.Setenv("AUTH_ISSUERS", fmt.Sprintf(`[{"issuer":%q}]`, issuer.URL()))
+	hn, err := handler.New(t.Context(), nil, os.LookupEnv)
 	require.NoError(t, err)
-	w := httptest.NewRecorder()
-	r := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/tasks?limit=not_number", nil)
 
-	hn.ServeHTTP(w, r)
+	tests := map[string]struct {
+		path  string
+		token string
+		want  int
+	}{
+		"no access token": {
+			path: "/tasks",
+			want: http.StatusUnauthorized,
+		},
+		"access token of untrusted issuer": {
+			path:  "/tasks",
+			token: untrusted.Token(t, "test-sub", map[string]any{"scope": "read:tasks"}),
+			want:  http.StatusUnauthorized,
+		},
+		"valid access token without required scope": {
+			path:  "/tasks",
+			token: issuer.Token(t, "test-sub", map[string]any{"scope": "read:users"}),
+			want:  http.StatusForbidden,
+		},
+		"realtime without required scope": {
+			path:  "/ws",
+			token: issuer.Token(t, "test-sub", map[string]any{"scope": "read:users"}),
+			want:  http.StatusForbidden,
+		},
+		"public operation ignores access token": {
+			path:  "/health",
+			token: untrusted.Token(t, "test-sub", nil),
+			// Health check itself fails since DB is unreachable.
+			want: http.StatusInternalServerError,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(context.Background(), http.MethodGet, tc.path, nil)
+			if tc.token != "" {
+				r.Header.Set("Authorization", "Bearer "+tc.token)
+			}
 
-	assert.Equal(t, http.StatusBadRequest, w.Code)
-	assert.JSONEq(t, `{"message":"Invalid format for parameter limit: error binding string parameter: strconv.ParseInt: parsing \"not_number\": invalid syntax"}`, w.Body.String())
+			hn.ServeHTTP(w, r)
+
+			assert.Equal(t, tc.want, w.Code)
+		})
+	}
 }
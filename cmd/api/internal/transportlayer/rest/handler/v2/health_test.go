@@ -52,7 +52,7 @@ func TestHealthCheck(t *testing.T) {
 			},
 			want: want{
 				status: http.StatusInternalServerError,
-				body:   `{"message":"internal server error"}`,
+				body:   `{"code":"internalServerError","detail":"Internal server error","message":"Internal server error","status":500,"title":"Internal Server Error","type":"about:blank"}`,
 			},
 		},
 	}
@@ -3,7 +3,9 @@ package handler
 import (
 	"context"
 	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/transportlayer/realtime"
 	"go-playground/cmd/api/internal/usecase"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -12,18 +14,105 @@ import (
 type TaskInteractor interface {
 	ListTasks(context.Context, string, int32) (entity.Page[entity.Task], error)
 	FindTaskByID(context.Context, string) (entity.Task, error)
-	CreateTask(context.Context, string) (entity.TaskID, error)
+	CreateTask(ctx context.Context, sub string, content string) (entity.TaskID, error)
 	UpdateTask(context.Context, string, string) error
+	// ListMentionedTasks lists tasks mentioning user of given sub.
+	ListMentionedTasks(ctx context.Context, sub string, next string, limit int32) (entity.Page[entity.Task], error)
+}
+
+// TaskArchiveInteractor is interface for [usecase.TaskArchiveUseCase].
+type TaskArchiveInteractor interface {
+	// ListTasks lists tasks including archived tasks.
+	ListTasks(ctx context.Context, next string, limit int32) (entity.Page[entity.Task], error)
+	UnarchiveTask(ctx context.Context, id entity.TaskID) error
 }
 
 // UserInteractor is interface for [usecase.UserUseCase]
 type UserInteractor interface {
 	FindBySub(ctx context.Context, sub string) (entity.User, error)
-	// CreateUser creates user with given information.
-	CreateUser(ctx context.Context, sub string, givenName, familyName string, email string, emailVerified bool) (uuid.UUID, error)
+	// CreateUser creates user with given information. Email is not verified.
+	CreateUser(ctx context.Context, sub string, givenName, familyName string, email string) (uuid.UUID, error)
+	// UpdateMe updates profile of user with given sub by given patch.
+	UpdateMe(ctx context.Context, sub string, patch entity.UserProfilePatch) (entity.User, error)
+	DeleteMe(ctx context.Context, sub string) (entity.User, error)
+	RestoreMe(ctx context.Context, sub string) (entity.User, error)
+}
+
+// PersonalAccessTokenInteractor is interface for [usecase.PersonalAccessTokenUseCase].
+type PersonalAccessTokenInteractor interface {
+	CreateToken(ctx context.Context, sub string, granted []string, name string, scopes []string, expiresAt time.Time) (entity.PersonalAccessToken, string, error)
+	ListTokens(ctx context.Context, sub string) ([]entity.PersonalAccessToken, error)
+	RevokeToken(ctx context.Context, sub string, id entity.PersonalAccessTokenID) error
+}
+
+// EmailVerificationInteractor is interface for [usecase.EmailVerificationUseCase].
+type EmailVerificationInteractor interface {
+	RequestVerification(ctx context.Context, sub string) error
+	VerifyEmail(ctx context.Context, sub string, token string) (entity.User, error)
+}
+
+// AdminInteractor is interface for [usecase.AdminUseCase].
+type AdminInteractor interface {
+	SearchUsers(ctx context.Context, sub, query, next string, limit int32) (entity.Page[entity.User], error)
+	DisableUser(ctx context.Context, sub string, id uuid.UUID) (entity.User, error)
+	EnableUser(ctx context.Context, sub string, id uuid.UUID) (entity.User, error)
+	FindTask(ctx context.Context, sub string, id entity.TaskID) (entity.Task, error)
+}
+
+// UserExportInteractor is interface for [usecase.UserExportUseCase].
+type UserExportInteractor interface {
+	RequestExport(ctx context.Context, sub string) (entity.UserExport, error)
+	FindExport(ctx context.Context, sub string, id entity.UserExportID) (entity.UserExport, error)
+}
+
+// UserExportNotifier is interface for [usecase.UserExportWorker].
+type UserExportNotifier interface {
+	// Notify tells new export is requested.
+	Notify()
+}
+
+// TimeEntryInteractor is interface for [usecase.TimeEntryUseCase].
+type TimeEntryInteractor interface {
+	StartTimer(ctx context.Context, sub string, taskID entity.TaskID) (entity.TimeEntry, error)
+	StopTimer(ctx context.Context, sub string, taskID entity.TaskID) (entity.TimeEntry, error)
+	AddTimeEntry(ctx context.Context, sub string, taskID entity.TaskID, startedAt, endedAt time.Time) (entity.TimeEntry, error)
+	// ReportTime sums tracked time from date of from to date of to inclusive.
+	ReportTime(ctx context.Context, sub string, from, to time.Time, groupBy entity.TimeReportGroupBy) (entity.TimeReport, error)
+}
+
+// UserPreferencesInteractor is interface for [usecase.UserPreferencesUseCase].
+type UserPreferencesInteractor interface {
+	// FindPreferences finds preferences of user with given sub. Defaults are returned if user has never saved them.
+	FindPreferences(ctx context.Context, sub string) (entity.UserPreferences, error)
+	UpdatePreferences(ctx context.Context, sub string, timezone, locale string, pageSize int32, weekStart time.Weekday) (entity.UserPreferences, error)
+}
+
+// OrganizationInteractor is interface for [usecase.OrganizationUseCase].
+type OrganizationInteractor interface {
+	CreateOrganization(ctx context.Context, sub string, name string) (entity.Organization, error)
+	ListOrganizations(ctx context.Context, sub string) ([]entity.Organization, error)
+	ListMembers(ctx context.Context, sub string, orgID entity.OrganizationID) ([]entity.OrganizationMember, error)
+	InviteMember(ctx context.Context, sub string, orgID entity.OrganizationID, email string, role entity.OrganizationRole) (entity.OrganizationMember, error)
+	RemoveMember(ctx context.Context, sub string, orgID entity.OrganizationID, userID uuid.UUID) error
+}
+
+// TaskNotifier is interface for [realtime.TaskNotifier].
+type TaskNotifier interface {
+	TaskCreated(ctx context.Context, id entity.TaskID, content string)
+	TaskUpdated(ctx context.Context, id entity.TaskID, content string)
 }
 
 var (
-	_ TaskInteractor = (*usecase.TaskUseCase)(nil)
-	_ UserInteractor = (*usecase.UserUseCase)(nil)
+	_ TaskInteractor                = (*usecase.TaskUseCase)(nil)
+	_ TaskArchiveInteractor         = (*usecase.TaskArchiveUseCase)(nil)
+	_ UserInteractor                = (*usecase.UserUseCase)(nil)
+	_ UserExportInteractor          = (*usecase.UserExportUseCase)(nil)
+	_ AdminInteractor               = (*usecase.AdminUseCase)(nil)
+	_ PersonalAccessTokenInteractor = (*usecase.PersonalAccessTokenUseCase)(nil)
+	_ EmailVerificationInteractor   = (*usecase.EmailVerificationUseCase)(nil)
+	_ UserExportNotifier            = (*usecase.UserExportWorker)(nil)
+	_ TimeEntryInteractor           = (*usecase.TimeEntryUseCase)(nil)
+	_ OrganizationInteractor        = (*usecase.OrganizationUseCase)(nil)
+	_ UserPreferencesInteractor     = (*usecase.UserPreferencesUseCase)(nil)
+	_ TaskNotifier                  = (*realtime.TaskNotifier)(nil)
 )
@@ -3,6 +3,7 @@ package handler_test
 import (
 	"context"
 	"go-playground/cmd/api/internal/domain/entity"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -31,8 +32,8 @@ func (mck *MockTaskInteractor) FindTaskByID(ctx context.Context, id string) (ent
 	return args.Get(0).(entity.Task), args.Error(1)
 }
 
-func (mck *MockTaskInteractor) CreateTask(ctx context.Context, content string) (entity.TaskID, error) {
-	args := mck.Called(ctx, content)
+func (mck *MockTaskInteractor) CreateTask(ctx context.Context, sub string, content string) (entity.TaskID, error) {
+	args := mck.Called(ctx, sub, content)
 	return args.Get(0).(string), args.Error(1)
 }
 
@@ -41,12 +42,43 @@ func (mck *MockTaskInteractor) UpdateTask(ctx context.Context, id, content strin
 	return args.Error(0)
 }
 
+func (mck *MockTaskInteractor) ListMentionedTasks(ctx context.Context, sub, next string, limit int32) (entity.Page[entity.Task], error) {
+	args := mck.Called(ctx, sub, next, limit)
+	return args.Get(0).(entity.Page[entity.Task]), args.Error(1)
+}
+
+type MockTaskArchiveInteractor struct {
+	mock.Mock
+}
+
+func (mck *MockTaskArchiveInteractor) ListTasks(ctx context.Context, next string, limit int32) (entity.Page[entity.Task], error) {
+	args := mck.Called(ctx, next, limit)
+	return args.Get(0).(entity.Page[entity.Task]), args.Error(1)
+}
+
+func (mck *MockTaskArchiveInteractor) UnarchiveTask(ctx context.Context, id entity.TaskID) error {
+	args := mck.Called(ctx, id)
+	return args.Error(0)
+}
+
+type MockTaskNotifier struct {
+	mock.Mock
+}
+
+func (mck *MockTaskNotifier) TaskCreated(ctx context.Context, id entity.TaskID, content string) {
+	mck.Called(ctx, id, content)
+}
+
+func (mck *MockTaskNotifier) TaskUpdated(ctx context.Context, id entity.TaskID, content string) {
+	mck.Called(ctx, id, content)
+}
+
 type MockUserInteractor struct {
 	mock.Mock
 }
 
-func (mck *MockUserInteractor) CreateUser(ctx context.Context, sub string, givenName, familyName string, email string, emailVerified bool) (uuid.UUID, error) {
-	args := mck.Called(ctx, sub, givenName, familyName, email, emailVerified)
+func (mck *MockUserInteractor) CreateUser(ctx context.Context, sub string, givenName, familyName string, email string) (uuid.UUID, error) {
+	args := mck.Called(ctx, sub, givenName, familyName, email)
 	return args.Get(0).(uuid.UUID), args.Error(1)
 }
 
@@ -54,3 +86,164 @@ func (mck *MockUserInteractor) FindBySub(ctx context.Context, sub string) (entit
 	args := mck.Called(ctx, sub)
 	return args.Get(0).(entity.User), args.Error(1)
 }
+
+func (mck *MockUserInteractor) UpdateMe(ctx context.Context, sub string, patch entity.UserProfilePatch) (entity.User, error) {
+	args := mck.Called(ctx, sub, patch)
+	return args.Get(0).(entity.User), args.Error(1)
+}
+
+func (mck *MockUserInteractor) DeleteMe(ctx context.Context, sub string) (entity.User, error) {
+	args := mck.Called(ctx, sub)
+	return args.Get(0).(entity.User), args.Error(1)
+}
+
+func (mck *MockUserInteractor) RestoreMe(ctx context.Context, sub string) (entity.User, error) {
+	args := mck.Called(ctx, sub)
+	return args.Get(0).(entity.User), args.Error(1)
+}
+
+type MockUserExportInteractor struct {
+	mock.Mock
+}
+
+func (mck *MockUserExportInteractor) RequestExport(ctx context.Context, sub string) (entity.UserExport, error) {
+	args := mck.Called(ctx, sub)
+	return args.Get(0).(entity.UserExport), args.Error(1)
+}
+
+func (mck *MockUserExportInteractor) FindExport(ctx context.Context, sub string, id entity.UserExportID) (entity.UserExport, error) {
+	args := mck.Called(ctx, sub, id)
+	return args.Get(0).(entity.UserExport), args.Error(1)
+}
+
+type MockUserExportNotifier struct {
+	mock.Mock
+}
+
+func (mck *MockUserExportNotifier) Notify() {
+	mck.Called()
+}
+
+type MockAdminInteractor struct {
+	mock.Mock
+}
+
+func (mck *MockAdminInteractor) SearchUsers(ctx context.Context, sub, query, next string, limit int32) (entity.Page[entity.User], error) {
+	args := mck.Called(ctx, sub, query, next, limit)
+	return args.Get(0).(entity.Page[entity.User]), args.Error(1)
+}
+
+func (mck *MockAdminInteractor) DisableUser(ctx context.Context, sub string, id uuid.UUID) (entity.User, error) {
+	args := mck.Called(ctx, sub, id)
+	return args.Get(0).(entity.User), args.Error(1)
+}
+
+func (mck *MockAdminInteractor) EnableUser(ctx context.Context, sub string, id uuid.UUID) (entity.User, error) {
+	args := mck.Called(ctx, sub, id)
+	return args.Get(0).(entity.User), args.Error(1)
+}
+
+func (mck *MockAdminInteractor) FindTask(ctx context.Context, sub string, id entity.TaskID) (entity.Task, error) {
+	args := mck.Called(ctx, sub, id)
+	return args.Get(0).(entity.Task), args.Error(1)
+}
+
+type MockPersonalAccessTokenInteractor struct {
+	mock.Mock
+}
+
+func (mck *MockPersonalAccessTokenInteractor) CreateToken(ctx context.Context, sub string, granted []string, name string, scopes []string, expiresAt time.Time) (entity.PersonalAccessToken, string, error) {
+	args := mck.Called(ctx, sub, granted, name, scopes, expiresAt)
+	return args.Get(0).(entity.PersonalAccessToken), args.String(1), args.Error(2)
+}
+
+func (mck *MockPersonalAccessTokenInteractor) ListTokens(ctx context.Context, sub string) ([]entity.PersonalAccessToken, error) {
+	args := mck.Called(ctx, sub)
+	return args.Get(0).([]entity.PersonalAccessToken), args.Error(1)
+}
+
+func (mck *MockPersonalAccessTokenInteractor) RevokeToken(ctx context.Context, sub string, id entity.PersonalAccessTokenID) error {
+	args := mck.Called(ctx, sub, id)
+	return args.Error(0)
+}
+
+type MockEmailVerificationInteractor struct {
+	mock.Mock
+}
+
+func (mck *MockEmailVerificationInteractor) RequestVerification(ctx context.Context, sub string) error {
+	args := mck.Called(ctx, sub)
+	return args.Error(0)
+}
+
+func (mck *MockEmailVerificationInteractor) VerifyEmail(ctx context.Context, sub string, token string) (entity.User, error) {
+	args := mck.Called(ctx, sub, token)
+	return args.Get(0).(entity.User), args.Error(1)
+}
+
+type MockTimeEntryInteractor struct {
+	mock.Mock
+}
+
+func (mck *MockTimeEntryInteractor) StartTimer(ctx context.Context, sub string, taskID entity.TaskID) (entity.TimeEntry, error) {
+	args := mck.Called(ctx, sub, taskID)
+	return args.Get(0).(entity.TimeEntry), args.Error(1)
+}
+
+func (mck *MockTimeEntryInteractor) StopTimer(ctx context.Context, sub string, taskID entity.TaskID) (entity.TimeEntry, error) {
+	args := mck.Called(ctx, sub, taskID)
+	return args.Get(0).(entity.TimeEntry), args.Error(1)
+}
+
+func (mck *MockTimeEntryInteractor) AddTimeEntry(ctx context.Context, sub string, taskID entity.TaskID, startedAt, endedAt time.Time) (entity.TimeEntry, error) {
+	args := mck.Called(ctx, sub, taskID, startedAt, endedAt)
+	return args.Get(0).(entity.TimeEntry), args.Error(1)
+}
+
+func (mck *MockTimeEntryInteractor) ReportTime(ctx context.Context, sub string, from, to time.Time, groupBy entity.TimeReportGroupBy) (entity.TimeReport, error) {
+	args := mck.Called(ctx, sub, from, to, groupBy)
+	return args.Get(0).(entity.TimeReport), args.Error(1)
+}
+
+type MockOrganizationInteractor struct {
+	mock.Mock
+}
+
+func (mck *MockOrganizationInteractor) CreateOrganization(ctx context.Context, sub string, name string) (entity.Organization, error) {
+	args := mck.Called(ctx, sub, name)
+	return args.Get(0).(entity.Organization), args.Error(1)
+}
+
+func (mck *MockOrganizationInteractor) ListOrganizations(ctx context.Context, sub string) ([]entity.Organization, error) {
+	args := mck.Called(ctx, sub)
+	return args.Get(0).([]entity.Organization), args.Error(1)
+}
+
+func (mck *MockOrganizationInteractor) ListMembers(ctx context.Context, sub string, orgID entity.OrganizationID) ([]entity.OrganizationMember, error) {
+	args := mck.Called(ctx, sub, orgID)
+	return args.Get(0).([]entity.OrganizationMember), args.Error(1)
+}
+
+func (mck *MockOrganizationInteractor) InviteMember(ctx context.Context, sub string, orgID entity.OrganizationID, email string, role entity.OrganizationRole) (entity.OrganizationMember, error) {
+	args := mck.Called(ctx, sub, orgID, email, role)
+	return args.Get(0).(entity.OrganizationMember), args.Error(1)
+}
+
+func (mck *MockOrganizationInteractor) RemoveMember(ctx context.Context, sub string, orgID entity.OrganizationID, userID uuid.UUID) error {
+	args := mck.Called(ctx, sub, orgID, userID)
+	return args.Error(0)
+}
+
+type MockUserPreferencesInteractor struct {
+	mock.Mock
+}
+
+func (mck *MockUserPreferencesInteractor) FindPreferences(ctx context.Context, sub string) (entity.UserPreferences, error) {
+	args := mck.Called(ctx, sub)
+	return args.Get(0).(entity.UserPreferences), args.Error(1)
+}
+
+func (mck *MockUserPreferencesInteractor) UpdatePreferences(ctx context.Context, sub string, timezone, locale string, pageSize int32, weekStart time.Weekday) (entity.UserPreferences, error) {
+	args := mck.Called(ctx, sub, timezone, locale, pageSize, weekStart)
+	return args.Get(0).(entity.UserPreferences), args.Error(1)
+}
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"encoding/json"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/cmd/api/internal/transportlayer/rest/oapi"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/collection"
+	"go-playground/pkg/ctxhelper"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+type OrganizationHandler struct {
+	OrganizationInteractor OrganizationInteractor
+}
+
+// ListMyOrganizations lists organizations of user for [GET /organizations]
+func (h *OrganizationHandler) ListMyOrganizations(w http.ResponseWriter, r *http.Request) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/OrganizationHandler/ListMyOrganizations").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		orgs, err := h.OrganizationInteractor.ListOrganizations(r.Context(), sub)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(w).Encode(oapi.ResponseOrganizations{Items: collection.SMap(orgs, toOrganization)})
+	})
+}
+
+// CreateOrganization creates organization owned by user for [POST /organizations]
+func (h *OrganizationHandler) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/OrganizationHandler/CreateOrganization").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		var body oapi.RequestOrganization
+		err := json.NewDecoder(r.Body).Decode(&body)
+		if err != nil {
+			return apperr.New("unmarshal CreateOrganization body", "Invalid request", apperr.WithMessageID(message.InvalidRequest), apperr.WithCause(err), apperr.CodeInvalidArgument)
+		}
+		org, err := h.OrganizationInteractor.CreateOrganization(r.Context(), sub, body.Name)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(w).Encode(toOrganization(org))
+	})
+}
+
+// ListOrganizationMembers lists members of organization for [GET /organizations/{organizationId}/members]
+func (h *OrganizationHandler) ListOrganizationMembers(w http.ResponseWriter, r *http.Request, organizationID oapi.OrganizationID) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/OrganizationHandler/ListOrganizationMembers").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		members, err := h.OrganizationInteractor.ListMembers(r.Context(), sub, organizationID)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(w).Encode(oapi.ResponseOrganizationMembers{Items: collection.SMap(members, toOrganizationMember)})
+	})
+}
+
+// InviteOrganizationMember adds user to organization for [POST /organizations/{organizationId}/members]
+func (h *OrganizationHandler) InviteOrganizationMember(w http.ResponseWriter, r *http.Request, organizationID oapi.OrganizationID) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/OrganizationHandler/InviteOrganizationMember").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		var body oapi.RequestOrganizationMember
+		err := json.NewDecoder(r.Body).Decode(&body)
+		if err != nil {
+			return apperr.New("unmarshal InviteOrganizationMember body", "Invalid request", apperr.WithMessageID(message.InvalidRequest), apperr.WithCause(err), apperr.CodeInvalidArgument)
+		}
+		member, err := h.OrganizationInteractor.InviteMember(r.Context(), sub, organizationID, string(body.Email), entity.OrganizationRole(body.Role))
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(w).Encode(toOrganizationMember(member))
+	})
+}
+
+// RemoveOrganizationMember removes user from organization for [DELETE /organizations/{organizationId}/members/{userId}]
+func (h *OrganizationHandler) RemoveOrganizationMember(w http.ResponseWriter, r *http.Request, organizationID oapi.OrganizationID, userID oapi.UserID) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/OrganizationHandler/RemoveOrganizationMember").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		return h.OrganizationInteractor.RemoveMember(r.Context(), sub, organizationID, uuid.UUID(userID))
+	})
+}
+
+func toOrganization(org entity.Organization) oapi.Organization {
+	return oapi.Organization{
+		ID:        org.ID,
+		Name:      org.Name,
+		CreatedAt: org.CreatedAt,
+		UpdatedAt: org.UpdatedAt,
+	}
+}
+
+func toOrganizationMember(member entity.OrganizationMember) oapi.OrganizationMember {
+	return oapi.OrganizationMember{
+		UserID:    member.UserID,
+		Role:      oapi.OrganizationMemberRole(member.Role),
+		CreatedAt: member.CreatedAt,
+	}
+}
@@ -0,0 +1,244 @@
+package handler_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/transportlayer/rest/handler/v2"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrganizationHandler_ListMyOrganizations(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "sub1")
+	mck := new(MockOrganizationInteractor)
+	mck.
+		On("ListOrganizations", ctx, "sub1").
+		Return([]entity.Organization{
+			{
+				ID:        "0194c3a1-0000-7000-8000-000000000001",
+				Name:      "Platform team",
+				CreatedAt: time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC),
+				UpdatedAt: time.Date(2024, 11, 9, 14, 40, 33, 0, time.UTC),
+			},
+		}, nil)
+	h := &handler.OrganizationHandler{OrganizationInteractor: mck}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/organizations", nil)
+
+	h.ListMyOrganizations(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `
+{
+  "items": [
+    {
+      "id": "0194c3a1-0000-7000-8000-000000000001",
+      "name": "Platform team",
+      "createdAt": "2024-11-08T14:40:33Z",
+      "updatedAt": "2024-11-09T14:40:33Z"
+    }
+  ]
+}`, w.Body.String())
+}
+
+func TestOrganizationHandler_CreateOrganization(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "sub1")
+	type want struct {
+		status int
+		body   string
+	}
+	tests := map[string]struct {
+		body  string
+		setup func() *handler.OrganizationHandler
+		want  want
+	}{
+		"success": {
+			body: `{"name":"Platform team"}`,
+			setup: func() *handler.OrganizationHandler {
+				mck := new(MockOrganizationInteractor)
+				mck.
+					On("CreateOrganization", ctx, "sub1", "Platform team").
+					Return(entity.Organization{
+						ID:        "0194c3a1-0000-7000-8000-000000000001",
+						Name:      "Platform team",
+						CreatedAt: time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC),
+						UpdatedAt: time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC),
+					}, nil)
+				return &handler.OrganizationHandler{OrganizationInteractor: mck}
+			},
+			want: want{
+				status: http.StatusOK,
+				body: `
+{
+  "id": "0194c3a1-0000-7000-8000-000000000001",
+  "name": "Platform team",
+  "createdAt": "2024-11-08T14:40:33Z",
+  "updatedAt": "2024-11-08T14:40:33Z"
+}`,
+			},
+		},
+		"failure unmarshal request body": {
+			body:  "",
+			setup: func() *handler.OrganizationHandler { return new(handler.OrganizationHandler) },
+			want: want{
+				status: http.StatusBadRequest,
+				body:   `{"code":"invalidArgument","detail":"Invalid request","message":"Invalid request","status":400,"title":"Bad Request","type":"about:blank"}`,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := tc.setup()
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/organizations", strings.NewReader(tc.body))
+
+			h.CreateOrganization(w, r)
+
+			assert.Equal(t, tc.want.status, w.Code)
+			assert.JSONEq(t, tc.want.body, w.Body.String())
+		})
+	}
+}
+
+func TestOrganizationHandler_ListOrganizationMembers(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "sub1")
+	mck := new(MockOrganizationInteractor)
+	mck.
+		On("ListMembers", ctx, "sub1", "0194c3a1-0000-7000-8000-000000000001").
+		Return([]entity.OrganizationMember{
+			{
+				OrganizationID: "0194c3a1-0000-7000-8000-000000000001",
+				UserID:         uuid.MustParse("01928120-055d-7edb-a12a-2d290512266e"),
+				Role:           entity.OrganizationRoleOwner,
+				CreatedAt:      time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC),
+			},
+		}, nil)
+	h := &handler.OrganizationHandler{OrganizationInteractor: mck}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/organizations/0194c3a1-0000-7000-8000-000000000001/members", nil)
+
+	h.ListOrganizationMembers(w, r, "0194c3a1-0000-7000-8000-000000000001")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `
+{
+  "items": [
+    {
+      "userId": "01928120-055d-7edb-a12a-2d290512266e",
+      "role": "owner",
+      "createdAt": "2024-11-08T14:40:33Z"
+    }
+  ]
+}`, w.Body.String())
+}
+
+func TestOrganizationHandler_InviteOrganizationMember(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "sub1")
+	type want struct {
+		status int
+		body   string
+	}
+	tests := map[string]struct {
+		body  string
+		setup func() *handler.OrganizationHandler
+		want  want
+	}{
+		"success": {
+			body: `{"email":"invitee@example.com","role":"admin"}`,
+			setup: func() *handler.OrganizationHandler {
+				mck := new(MockOrganizationInteractor)
+				mck.
+					On("InviteMember", ctx, "sub1", "0194c3a1-0000-7000-8000-000000000001", "invitee@example.com", entity.OrganizationRoleAdmin).
+					Return(entity.OrganizationMember{
+						OrganizationID: "0194c3a1-0000-7000-8000-000000000001",
+						UserID:         uuid.MustParse("01928120-055d-7edb-a12a-2d290512266e"),
+						Role:           entity.OrganizationRoleAdmin,
+						CreatedAt:      time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC),
+					}, nil)
+				return &handler.OrganizationHandler{OrganizationInteractor: mck}
+			},
+			want: want{
+				status: http.StatusOK,
+				body: `
+{
+  "userId": "01928120-055d-7edb-a12a-2d290512266e",
+  "role": "admin",
+  "createdAt": "2024-11-08T14:40:33Z"
+}`,
+			},
+		},
+		"failure unmarshal request body": {
+			body:  "",
+			setup: func() *handler.OrganizationHandler { return new(handler.OrganizationHandler) },
+			want: want{
+				status: http.StatusBadRequest,
+				body:   `{"code":"invalidArgument","detail":"Invalid request","message":"Invalid request","status":400,"title":"Bad Request","type":"about:blank"}`,
+			},
+		},
+		"failure inviter is not admin": {
+			body: `{"email":"invitee@example.com","role":"member"}`,
+			setup: func() *handler.OrganizationHandler {
+				mck := new(MockOrganizationInteractor)
+				mck.
+					On("InviteMember", ctx, "sub1", "0194c3a1-0000-7000-8000-000000000001", "invitee@example.com", entity.OrganizationRoleMember).
+					Return(entity.OrganizationMember{}, apperr.New("member invites", "You are not allowed to invite members", apperr.CodeUnAuthz))
+				return &handler.OrganizationHandler{OrganizationInteractor: mck}
+			},
+			want: want{
+				status: http.StatusForbidden,
+				body:   `{"code":"unauthorized","detail":"You are not allowed to invite members","message":"You are not allowed to invite members","status":403,"title":"Forbidden","type":"about:blank"}`,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := tc.setup()
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/organizations/0194c3a1-0000-7000-8000-000000000001/members", strings.NewReader(tc.body))
+
+			h.InviteOrganizationMember(w, r, "0194c3a1-0000-7000-8000-000000000001")
+
+			assert.Equal(t, tc.want.status, w.Code)
+			assert.JSONEq(t, tc.want.body, w.Body.String())
+		})
+	}
+}
+
+func TestOrganizationHandler_RemoveOrganizationMember(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "sub1")
+	userID := uuid.MustParse("01928120-055d-7edb-a12a-2d290512266e")
+	tests := map[string]struct {
+		err        error
+		wantStatus int
+	}{
+		"success": {
+			wantStatus: http.StatusOK,
+		},
+		"failure member is not found": {
+			err:        apperr.New("not found", "not found member", apperr.CodeNotFound),
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mck := new(MockOrganizationInteractor)
+			mck.On("RemoveMember", ctx, "sub1", "0194c3a1-0000-7000-8000-000000000001", userID).Return(tc.err)
+			h := &handler.OrganizationHandler{OrganizationInteractor: mck}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(ctx, http.MethodDelete, "/organizations/0194c3a1-0000-7000-8000-000000000001/members/01928120-055d-7edb-a12a-2d290512266e", nil)
+
+			h.RemoveOrganizationMember(w, r, "0194c3a1-0000-7000-8000-000000000001", userID)
+
+			assert.Equal(t, tc.wantStatus, w.Code)
+			mck.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"encoding/json"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/cmd/api/internal/transportlayer/rest/oapi"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/collection"
+	"go-playground/pkg/ctxhelper"
+	"log/slog"
+	"net/http"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+type PersonalAccessTokenHandler struct {
+	PersonalAccessTokenInteractor PersonalAccessTokenInteractor
+}
+
+// ListMyTokens lists own personal access tokens for [GET /users/me/tokens]
+func (h *PersonalAccessTokenHandler) ListMyTokens(w http.ResponseWriter, r *http.Request) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/PersonalAccessTokenHandler/ListMyTokens").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		tokens, err := h.PersonalAccessTokenInteractor.ListTokens(r.Context(), sub)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(w).Encode(oapi.ResponsePersonalAccessTokens{Items: collection.SMap(tokens, toPersonalAccessToken)})
+	})
+}
+
+// CreateMyToken creates personal access token for [POST /users/me/tokens]
+func (h *PersonalAccessTokenHandler) CreateMyToken(w http.ResponseWriter, r *http.Request) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/PersonalAccessTokenHandler/CreateMyToken").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		var body oapi.RequestPersonalAccessToken
+		err := json.NewDecoder(r.Body).Decode(&body)
+		if err != nil {
+			return apperr.New("unmarshal CreateMyToken body", "Invalid request", apperr.WithMessageID(message.InvalidRequest), apperr.WithCause(err), apperr.CodeInvalidArgument)
+		}
+		scopes := collection.SMap(body.Scopes, func(s oapi.RequestPersonalAccessTokenScopes) string { return string(s) })
+		token, raw, err := h.PersonalAccessTokenInteractor.CreateToken(r.Context(), sub, ctxhelper.Scopes(r.Context()), body.Name, scopes, body.ExpiresAt)
+		if err != nil {
+			return err
+		}
+		res := toPersonalAccessToken(token)
+		return json.NewEncoder(w).Encode(oapi.ResponseCreatedPersonalAccessToken{
+			ID:        res.ID,
+			Name:      res.Name,
+			Scopes:    res.Scopes,
+			ExpiresAt: res.ExpiresAt,
+			RevokedAt: res.RevokedAt,
+			CreatedAt: res.CreatedAt,
+			Token:     raw,
+		})
+	})
+}
+
+// RevokeMyToken revokes own personal access token for [DELETE /users/me/tokens/{tokenId}]
+func (h *PersonalAccessTokenHandler) RevokeMyToken(w http.ResponseWriter, r *http.Request, tokenID oapi.TokenID) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/PersonalAccessTokenHandler/RevokeMyToken").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		return h.PersonalAccessTokenInteractor.RevokeToken(r.Context(), sub, tokenID)
+	})
+}
+
+func toPersonalAccessToken(token entity.PersonalAccessToken) oapi.PersonalAccessToken {
+	res := oapi.PersonalAccessToken{
+		ID:        token.ID,
+		Name:      token.Name,
+		Scopes:    token.Scopes,
+		ExpiresAt: token.ExpiresAt,
+		CreatedAt: token.CreatedAt,
+	}
+	if token.Revoked() {
+		revokedAt := token.RevokedAt
+		res.RevokedAt = &revokedAt
+	}
+	return res
+}
@@ -0,0 +1,160 @@
+package handler_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/transportlayer/rest/handler/v2"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersonalAccessTokenHandler_ListMyTokens(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "sub1")
+	mck := new(MockPersonalAccessTokenInteractor)
+	mck.
+		On("ListTokens", ctx, "sub1").
+		Return([]entity.PersonalAccessToken{
+			{
+				ID:        "0193dd96-47aa-755b-806e-0b22d6f1849b",
+				Name:      "ci",
+				Scopes:    []string{"read:tasks"},
+				ExpiresAt: time.Date(2025, 11, 8, 14, 40, 33, 0, time.UTC),
+				RevokedAt: time.Date(2024, 11, 9, 14, 40, 33, 0, time.UTC),
+				CreatedAt: time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC),
+			},
+		}, nil)
+	h := &handler.PersonalAccessTokenHandler{PersonalAccessTokenInteractor: mck}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/users/me/tokens", nil)
+
+	h.ListMyTokens(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `
+{
+  "items": [
+    {
+      "id": "0193dd96-47aa-755b-806e-0b22d6f1849b",
+      "name": "ci",
+      "scopes": ["read:tasks"],
+      "expiresAt": "2025-11-08T14:40:33Z",
+      "revokedAt": "2024-11-09T14:40:33Z",
+      "createdAt": "2024-11-08T14:40:33Z"
+    }
+  ]
+}`, w.Body.String())
+}
+
+func TestPersonalAccessTokenHandler_CreateMyToken(t *testing.T) {
+	ctx := ctxhelper.WithScopes(context.Background(), "sub1", []string{"read:tasks", "write:users"})
+	expiresAt := time.Date(2025, 11, 8, 14, 40, 33, 0, time.UTC)
+	type want struct {
+		status int
+		body   string
+	}
+	tests := map[string]struct {
+		body  string
+		setup func() *handler.PersonalAccessTokenHandler
+		want  want
+	}{
+		"success": {
+			body: `{"name":"ci","scopes":["read:tasks"],"expiresAt":"2025-11-08T14:40:33Z"}`,
+			setup: func() *handler.PersonalAccessTokenHandler {
+				mck := new(MockPersonalAccessTokenInteractor)
+				mck.
+					On("CreateToken", ctx, "sub1", []string{"read:tasks", "write:users"}, "ci", []string{"read:tasks"}, expiresAt).
+					Return(entity.PersonalAccessToken{
+						ID:        "0193dd96-47aa-755b-806e-0b22d6f1849b",
+						Name:      "ci",
+						Scopes:    []string{"read:tasks"},
+						ExpiresAt: expiresAt,
+						CreatedAt: time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC),
+					}, "pat_secret", nil)
+				return &handler.PersonalAccessTokenHandler{PersonalAccessTokenInteractor: mck}
+			},
+			want: want{
+				status: http.StatusOK,
+				body: `
+{
+  "id": "0193dd96-47aa-755b-806e-0b22d6f1849b",
+  "name": "ci",
+  "scopes": ["read:tasks"],
+  "expiresAt": "2025-11-08T14:40:33Z",
+  "createdAt": "2024-11-08T14:40:33Z",
+  "token": "pat_secret"
+}`,
+			},
+		},
+		"failure unmarshal request body": {
+			body:  "",
+			setup: func() *handler.PersonalAccessTokenHandler { return new(handler.PersonalAccessTokenHandler) },
+			want: want{
+				status: http.StatusBadRequest,
+				body:   `{"code":"invalidArgument","detail":"Invalid request","message":"Invalid request","status":400,"title":"Bad Request","type":"about:blank"}`,
+			},
+		},
+		"failure scope beyond credential": {
+			body: `{"name":"ci","scopes":["write:tasks"],"expiresAt":"2025-11-08T14:40:33Z"}`,
+			setup: func() *handler.PersonalAccessTokenHandler {
+				mck := new(MockPersonalAccessTokenInteractor)
+				mck.
+					On("CreateToken", ctx, "sub1", []string{"read:tasks", "write:users"}, "ci", []string{"write:tasks"}, expiresAt).
+					Return(entity.PersonalAccessToken{}, "", apperr.New("scope is not granted", "Token can not be granted scopes you do not have", apperr.CodeUnAuthz))
+				return &handler.PersonalAccessTokenHandler{PersonalAccessTokenInteractor: mck}
+			},
+			want: want{
+				status: http.StatusForbidden,
+				body:   `{"code":"unauthorized","detail":"Token can not be granted scopes you do not have","message":"Token can not be granted scopes you do not have","status":403,"title":"Forbidden","type":"about:blank"}`,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := tc.setup()
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/users/me/tokens", strings.NewReader(tc.body))
+
+			h.CreateMyToken(w, r)
+
+			assert.Equal(t, tc.want.status, w.Code)
+			assert.JSONEq(t, tc.want.body, w.Body.String())
+		})
+	}
+}
+
+func TestPersonalAccessTokenHandler_RevokeMyToken(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "sub1")
+	tests := map[string]struct {
+		err        error
+		wantStatus int
+	}{
+		"success": {
+			wantStatus: http.StatusOK,
+		},
+		"failure token is not found": {
+			err:        apperr.New("not found", "not found token", apperr.CodeNotFound),
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mck := new(MockPersonalAccessTokenInteractor)
+			mck.On("RevokeToken", ctx, "sub1", "0193dd96-47aa-755b-806e-0b22d6f1849b").Return(tc.err)
+			h := &handler.PersonalAccessTokenHandler{PersonalAccessTokenInteractor: mck}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(ctx, http.MethodDelete, "/users/me/tokens/0193dd96-47aa-755b-806e-0b22d6f1849b", nil)
+
+			h.RevokeMyToken(w, r, "0193dd96-47aa-755b-806e-0b22d6f1849b")
+
+			assert.Equal(t, tc.wantStatus, w.Code)
+			mck.AssertExpectations(t)
+		})
+	}
+}
@@ -2,17 +2,24 @@ package handler
 
 import (
 	"encoding/json"
+	"fmt"
 	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/message"
 	"go-playground/cmd/api/internal/transportlayer/rest/oapi"
 	"go-playground/pkg/apperr"
-	"go-playground/pkg/collection"
+	"go-playground/pkg/ctxhelper"
+	"go-playground/pkg/markdown"
+	"log/slog"
 	"net/http"
 
 	"github.com/newrelic/go-agent/v3/newrelic"
 )
 
 type TaskHandler struct {
-	TaskInteractor TaskInteractor
+	TaskInteractor        TaskInteractor
+	TaskArchiveInteractor TaskArchiveInteractor
+	// TaskNotifier publishes task edits to connected members. Nothing is published if nil.
+	TaskNotifier TaskNotifier
 }
 
 // ListTask lists tasks for [GET /tasks]
@@ -30,44 +37,80 @@ func (t *TaskHandler) ListTasks(w http.ResponseWriter, r *http.Request, params o
 		if params.Limit != nil {
 			limit = *params.Limit
 		}
-		result, err := t.TaskInteractor.ListTasks(r.Context(), next, limit)
+		render, err := renderHTML((*oapi.Render)(params.Render))
 		if err != nil {
 			return err
 		}
-		return json.NewEncoder(w).Encode(
-			oapi.ResponseTasks{
-				Next:    result.NextToken,
-				HasNext: result.HasNext,
-				Items: collection.SMap(result.Items, func(e entity.Task) oapi.Task {
-					return oapi.Task{
-						ID:        e.ID,
-						Content:   e.Content,
-						CreatedAt: e.CreatedAt,
-						UpdatedAt: e.UpdatedAt,
-					}
-				}),
-			},
+		var result entity.Page[entity.Task]
+		if params.IncludeArchived != nil && *params.IncludeArchived {
+			result, err = t.TaskArchiveInteractor.ListTasks(r.Context(), next, limit)
+		} else {
+			result, err = t.TaskInteractor.ListTasks(r.Context(), next, limit)
+		}
+		if err != nil {
+			return err
+		}
+		res, err := toTasks(result, render)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(w).Encode(res)
+	})
+}
+
+// ListMyMentions lists tasks mentioning own for [GET /users/me/mentions]
+func (t *TaskHandler) ListMyMentions(w http.ResponseWriter, r *http.Request, params oapi.ListMyMentionsParams) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/taskHandler/ListMyMentions").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		var (
+			next  string
+			limit int32
 		)
+		if params.Next != nil {
+			next = *params.Next
+		}
+		if params.Limit != nil {
+			limit = *params.Limit
+		}
+		render, err := renderHTML((*oapi.Render)(params.Render))
+		if err != nil {
+			return err
+		}
+		result, err := t.TaskInteractor.ListMentionedTasks(r.Context(), sub, next, limit)
+		if err != nil {
+			return err
+		}
+		res, err := toTasks(result, render)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(w).Encode(res)
 	})
 }
 
 // GetTask gets task by given id for [GET /tasks/{taskId}]
-func (t *TaskHandler) GetTask(w http.ResponseWriter, r *http.Request, id oapi.TaskID) {
+func (t *TaskHandler) GetTask(w http.ResponseWriter, r *http.Request, id oapi.TaskID, params oapi.GetTaskParams) {
 	defer newrelic.FromContext(r.Context()).StartSegment("/handler/taskHandler/GetTask").End()
 
 	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		render, err := renderHTML((*oapi.Render)(params.Render))
+		if err != nil {
+			return err
+		}
 		result, err := t.TaskInteractor.FindTaskByID(r.Context(), id)
 		if err != nil {
 			return err
 		}
-		return json.NewEncoder(w).Encode(
-			oapi.ResponseTask{
-				ID:        result.ID,
-				Content:   result.Content,
-				CreatedAt: result.CreatedAt,
-				UpdatedAt: result.UpdatedAt,
-			},
-		)
+		res, err := toTask(result, render)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(w).Encode(res)
 	})
 }
 
@@ -76,15 +119,22 @@ func (t *TaskHandler) PostTask(w http.ResponseWriter, r *http.Request) {
 	defer newrelic.FromContext(r.Context()).StartSegment("handler/taskHandler/PostTask")
 
 	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
 		var body oapi.PostTaskJSONRequestBody
 		err := json.NewDecoder(r.Body).Decode(&body)
 		if err != nil {
-			return apperr.New("unmarshal PostTask", "invalid request", apperr.WithCause(err), apperr.CodeInvalidArgument)
+			return apperr.New("unmarshal PostTask", "Invalid request", apperr.WithMessageID(message.InvalidRequest), apperr.WithCause(err), apperr.CodeInvalidArgument)
 		}
-		result, err := t.TaskInteractor.CreateTask(r.Context(), body.Content)
+		result, err := t.TaskInteractor.CreateTask(r.Context(), sub, body.Content)
 		if err != nil {
 			return err
 		}
+		if t.TaskNotifier != nil {
+			t.TaskNotifier.TaskCreated(r.Context(), result, body.Content)
+		}
 		return json.NewEncoder(w).Encode(oapi.ResponseTaskID{
 			ID: result,
 		})
@@ -99,14 +149,82 @@ func (t *TaskHandler) PutTask(w http.ResponseWriter, r *http.Request, id oapi.Ta
 		var body oapi.PutTaskJSONRequestBody
 		err := json.NewDecoder(r.Body).Decode(&body)
 		if err != nil {
-			return apperr.New("unmarshal PutTask body", "invalid request", apperr.WithCause(err), apperr.CodeInvalidArgument)
+			return apperr.New("unmarshal PutTask body", "Invalid request", apperr.WithMessageID(message.InvalidRequest), apperr.WithCause(err), apperr.CodeInvalidArgument)
 		}
 		err = t.TaskInteractor.UpdateTask(r.Context(), id, body.Content)
 		if err != nil {
 			return err
 		}
+		if t.TaskNotifier != nil {
+			t.TaskNotifier.TaskUpdated(r.Context(), id, body.Content)
+		}
+		return json.NewEncoder(w).Encode(oapi.ResponseTaskID{
+			ID: id,
+		})
+	})
+}
+
+// UnarchiveTask moves archived task back to active tasks for [POST /tasks/{taskId}/unarchive]
+func (t *TaskHandler) UnarchiveTask(w http.ResponseWriter, r *http.Request, id oapi.TaskID) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/taskHandler/UnarchiveTask").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		err := t.TaskArchiveInteractor.UnarchiveTask(r.Context(), id)
+		if err != nil {
+			return err
+		}
 		return json.NewEncoder(w).Encode(oapi.ResponseTaskID{
 			ID: id,
 		})
 	})
 }
+
+// renderHTML reports whether task content should be rendered as HTML.
+func renderHTML(render *oapi.Render) (bool, error) {
+	if render == nil {
+		return false, nil
+	}
+	if !render.Valid() {
+		return false, apperr.New(fmt.Sprintf("unsupported render %q", *render), "Invalid render parameter", apperr.WithMessageID(message.InvalidRender), apperr.CodeInvalidArgument)
+	}
+	return *render == oapi.RenderHtml, nil
+}
+
+// toTasks converts page of task entity to response.
+func toTasks(page entity.Page[entity.Task], render bool) (oapi.ResponseTasks, error) {
+	items := make([]oapi.Task, len(page.Items))
+	for i, e := range page.Items {
+		var err error
+		items[i], err = toTask(e, render)
+		if err != nil {
+			return oapi.ResponseTasks{}, err
+		}
+	}
+	return oapi.ResponseTasks{
+		Next:    page.NextToken,
+		HasNext: page.HasNext,
+		Items:   items,
+	}, nil
+}
+
+// toTask converts task entity to response. Markdown content is rendered to sanitized HTML if render is true.
+func toTask(e entity.Task, render bool) (oapi.Task, error) {
+	task := oapi.Task{
+		ID:        e.ID,
+		Content:   e.Content,
+		CreatedAt: e.CreatedAt,
+		UpdatedAt: e.UpdatedAt,
+	}
+	if e.Archived {
+		task.Archived = &e.Archived
+	}
+	if !render {
+		return task, nil
+	}
+	html, err := markdown.ToHTML(e.Content)
+	if err != nil {
+		return oapi.Task{}, apperr.New(fmt.Sprintf("render content of task %q as html", e.ID), "failed to render task content", apperr.WithCause(err))
+	}
+	task.ContentHTML = &html
+	return task, nil
+}
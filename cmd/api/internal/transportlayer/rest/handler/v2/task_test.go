@@ -6,6 +6,7 @@ import (
 	"go-playground/cmd/api/internal/transportlayer/rest/handler/v2"
 	"go-playground/cmd/api/internal/transportlayer/rest/oapi"
 	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
 	"go-playground/pkg/ptr"
 	"net/http"
 	"net/http/httptest"
@@ -17,6 +18,11 @@ import (
 )
 
 func TestTaskHandler_ListTasks(t *testing.T) {
+	var (
+		renderHTML      = oapi.ListTasksParamsRenderHtml
+		renderPDF       = oapi.ListTasksParamsRender("pdf")
+		includeArchived = true
+	)
 	type input struct {
 		w     *httptest.ResponseRecorder
 		r     *http.Request
@@ -165,6 +171,111 @@ func TestTaskHandler_ListTasks(t *testing.T) {
 				body:   `{"hasNext":false,"next":"","items":[]}`,
 			},
 		},
+		"success: with render param": {
+			input: input{
+				w:     httptest.NewRecorder(),
+				r:     httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/tasks?render=html", nil),
+				param: oapi.ListTasksParams{Render: &renderHTML},
+			},
+			setup: func() *handler.TaskHandler {
+				mck := new(MockTaskInteractor)
+				mck.On("ListTasks", context.Background(), "", int32(0)).Return(entity.Page[entity.Task]{
+					Items: []entity.Task{
+						{
+							ID:        "0192b845-7a32-706b-ae58-d46437963c0e",
+							Content:   "**bold** <script>alert(1)</script>",
+							CreatedAt: time.Date(2024, 10, 23, 16, 26, 54, 0, time.UTC),
+							UpdatedAt: time.Date(2024, 10, 23, 16, 26, 54, 0, time.UTC),
+						},
+					},
+				}, nil)
+				return &handler.TaskHandler{TaskInteractor: mck}
+			},
+			want: want{
+				status: http.StatusOK,
+				body: `
+{
+  "hasNext": false,
+  "items": [
+    {
+      "content": "**bold** <script>alert(1)</script>",
+      "contentHtml": "<p><strong>bold</strong> alert(1)</p>\n",
+      "createdAt": "2024-10-23T16:26:54Z",
+      "id": "0192b845-7a32-706b-ae58-d46437963c0e",
+      "updatedAt": "2024-10-23T16:26:54Z"
+    }
+  ],
+  "next": ""
+}
+				`,
+			},
+		},
+		"success: include archived": {
+			input: input{
+				w:     httptest.NewRecorder(),
+				r:     httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/tasks?include_archived=true", nil),
+				param: oapi.ListTasksParams{IncludeArchived: &includeArchived},
+			},
+			setup: func() *handler.TaskHandler {
+				mck := new(MockTaskArchiveInteractor)
+				mck.On("ListTasks", context.Background(), "", int32(0)).Return(entity.Page[entity.Task]{
+					Items: []entity.Task{
+						{
+							ID:        "0192b845-7a32-706b-ae58-d46437963c0e",
+							Content:   "this is test",
+							CreatedAt: time.Date(2024, 10, 23, 16, 26, 54, 0, time.UTC),
+							UpdatedAt: time.Date(2024, 10, 23, 16, 26, 54, 0, time.UTC),
+						},
+						{
+							ID:        "0192b843-151e-74fe-8198-0e69ce37932b",
+							Content:   "this is archived",
+							CreatedAt: time.Date(2024, 10, 23, 16, 24, 17, 0, time.UTC),
+							UpdatedAt: time.Date(2024, 10, 23, 16, 24, 17, 0, time.UTC),
+							Archived:  true,
+						},
+					},
+				}, nil)
+				return &handler.TaskHandler{TaskInteractor: new(MockTaskInteractor), TaskArchiveInteractor: mck}
+			},
+			want: want{
+				status: http.StatusOK,
+				body: `
+{
+  "hasNext": false,
+  "items": [
+    {
+      "content": "this is test",
+      "createdAt": "2024-10-23T16:26:54Z",
+      "id": "0192b845-7a32-706b-ae58-d46437963c0e",
+      "updatedAt": "2024-10-23T16:26:54Z"
+    },
+    {
+      "archived": true,
+      "content": "this is archived",
+      "createdAt": "2024-10-23T16:24:17Z",
+      "id": "0192b843-151e-74fe-8198-0e69ce37932b",
+      "updatedAt": "2024-10-23T16:24:17Z"
+    }
+  ],
+  "next": ""
+}
+				`,
+			},
+		},
+		"invalid render param": {
+			input: input{
+				w:     httptest.NewRecorder(),
+				r:     httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/tasks?render=pdf", nil),
+				param: oapi.ListTasksParams{Render: &renderPDF},
+			},
+			setup: func() *handler.TaskHandler {
+				return &handler.TaskHandler{TaskInteractor: new(MockTaskInteractor)}
+			},
+			want: want{
+				status: http.StatusBadRequest,
+				body:   `{"code":"invalidArgument","detail":"Invalid render parameter","message":"Invalid render parameter","status":400,"title":"Bad Request","type":"about:blank"}`,
+			},
+		},
 		"failed to list tasks": {
 			input: input{
 				w: httptest.NewRecorder(),
@@ -177,7 +288,7 @@ func TestTaskHandler_ListTasks(t *testing.T) {
 			},
 			want: want{
 				status: http.StatusBadRequest,
-				body:   `{"message":"failed to list task"}`,
+				body:   `{"code":"invalidArgument","detail":"failed to list task","message":"failed to list task","status":400,"title":"Bad Request","type":"about:blank"}`,
 			},
 		},
 	}
@@ -194,11 +305,111 @@ func TestTaskHandler_ListTasks(t *testing.T) {
 	}
 }
 
+func TestTaskHandler_ListMyMentions(t *testing.T) {
+	type input struct {
+		w     *httptest.ResponseRecorder
+		r     *http.Request
+		param oapi.ListMyMentionsParams
+	}
+	type want struct {
+		status int
+		body   string
+	}
+	tests := map[string]struct {
+		input input
+		setup func() *handler.TaskHandler
+		want  want
+	}{
+		"success": {
+			input: input{
+				w:     httptest.NewRecorder(),
+				r:     httptest.NewRequestWithContext(ctxhelper.WithSubject(context.Background(), "sub1"), http.MethodGet, "/users/me/mentions?limit=1", nil),
+				param: oapi.ListMyMentionsParams{Limit: ptr.Int32(1)},
+			},
+			setup: func() *handler.TaskHandler {
+				mck := new(MockTaskInteractor)
+				mck.On("ListMentionedTasks", ctxhelper.WithSubject(context.Background(), "sub1"), "sub1", "", int32(1)).Return(entity.Page[entity.Task]{
+					Items: []entity.Task{
+						{
+							ID:        "0192b845-7a32-706b-ae58-d46437963c0e",
+							Content:   "review by @Jonathan74@example.com",
+							CreatedAt: time.Date(2024, 10, 23, 16, 26, 54, 0, time.UTC),
+							UpdatedAt: time.Date(2024, 10, 23, 16, 26, 54, 0, time.UTC),
+						},
+					},
+				}, nil)
+				return &handler.TaskHandler{TaskInteractor: mck}
+			},
+			want: want{
+				status: http.StatusOK,
+				body: `
+{
+  "hasNext": false,
+  "items": [
+    {
+      "content": "review by @Jonathan74@example.com",
+      "createdAt": "2024-10-23T16:26:54Z",
+      "id": "0192b845-7a32-706b-ae58-d46437963c0e",
+      "updatedAt": "2024-10-23T16:26:54Z"
+    }
+  ],
+  "next": ""
+}
+				`,
+			},
+		},
+		"failure user not found": {
+			input: input{
+				w: httptest.NewRecorder(),
+				r: httptest.NewRequestWithContext(ctxhelper.WithSubject(context.Background(), "sub1"), http.MethodGet, "/users/me/mentions", nil),
+			},
+			setup: func() *handler.TaskHandler {
+				mck := new(MockTaskInteractor)
+				mck.On("ListMentionedTasks", ctxhelper.WithSubject(context.Background(), "sub1"), "sub1", "", int32(0)).Return(entity.Page[entity.Task]{}, apperr.New("find user", "user is not found", apperr.CodeNotFound))
+				return &handler.TaskHandler{TaskInteractor: mck}
+			},
+			want: want{
+				status: http.StatusNotFound,
+				body:   `{"code":"notfound","detail":"user is not found","message":"user is not found","status":404,"title":"Not Found","type":"about:blank"}`,
+			},
+		},
+		"failure missing subject": {
+			input: input{
+				w: httptest.NewRecorder(),
+				r: httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/users/me/mentions", nil),
+			},
+			setup: func() *handler.TaskHandler {
+				return &handler.TaskHandler{TaskInteractor: new(MockTaskInteractor)}
+			},
+			want: want{
+				status: http.StatusForbidden,
+				body:   `{"code":"unauthorized","detail":"Authorization failure","message":"Authorization failure","status":403,"title":"Forbidden","type":"about:blank"}`,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			hn := tc.setup()
+
+			hn.ListMyMentions(tc.input.w, tc.input.r, tc.input.param)
+
+			assert.Equal(t, tc.want.status, tc.input.w.Code)
+			assert.JSONEq(t, tc.want.body, tc.input.w.Body.String())
+		})
+	}
+}
+
 func TestTaskHandler_GetTask(t *testing.T) {
+	var (
+		renderHTML = oapi.GetTaskParamsRenderHtml
+		renderPDF  = oapi.GetTaskParamsRender("pdf")
+	)
 	type input struct {
-		w   *httptest.ResponseRecorder
-		r   *http.Request
-		tid oapi.TaskID
+		w      *httptest.ResponseRecorder
+		r      *http.Request
+		tid    oapi.TaskID
+		params oapi.GetTaskParams
 	}
 	type want struct {
 		status int
@@ -237,6 +448,51 @@ func TestTaskHandler_GetTask(t *testing.T) {
 				`,
 			},
 		},
+		"success with render param": {
+			input: input{
+				w:      httptest.NewRecorder(),
+				r:      httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/tasks/0192b83f-e199-79d1-a872-b3dcf1f4119a?render=html", nil),
+				tid:    "0192b83f-e199-79d1-a872-b3dcf1f4119a",
+				params: oapi.GetTaskParams{Render: &renderHTML},
+			},
+			setup: func() *handler.TaskHandler {
+				mck := new(MockTaskInteractor)
+				mck.On("FindTaskByID", context.Background(), "0192b83f-e199-79d1-a872-b3dcf1f4119a").Return(entity.Task{
+					ID:        "0192b83f-e199-79d1-a872-b3dcf1f4119a",
+					Content:   "[link](javascript:alert(1)) [docs](https://example.com)",
+					CreatedAt: time.Date(2024, 10, 23, 16, 20, 47, 0, time.UTC),
+					UpdatedAt: time.Date(2024, 10, 23, 16, 20, 47, 0, time.UTC),
+				}, nil)
+				return &handler.TaskHandler{TaskInteractor: mck}
+			},
+			want: want{
+				status: http.StatusOK,
+				body: `
+{
+  "content": "[link](javascript:alert(1)) [docs](https://example.com)",
+  "contentHtml": "<p>link <a href=\"https://example.com\" rel=\"nofollow noreferrer noopener\" target=\"_blank\">docs</a></p>\n",
+  "createdAt": "2024-10-23T16:20:47Z",
+  "id": "0192b83f-e199-79d1-a872-b3dcf1f4119a",
+  "updatedAt": "2024-10-23T16:20:47Z"
+}
+				`,
+			},
+		},
+		"invalid render param": {
+			input: input{
+				w:      httptest.NewRecorder(),
+				r:      httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/tasks/0192b83f-e199-79d1-a872-b3dcf1f4119a?render=pdf", nil),
+				tid:    "0192b83f-e199-79d1-a872-b3dcf1f4119a",
+				params: oapi.GetTaskParams{Render: &renderPDF},
+			},
+			setup: func() *handler.TaskHandler {
+				return &handler.TaskHandler{TaskInteractor: new(MockTaskInteractor)}
+			},
+			want: want{
+				status: http.StatusBadRequest,
+				body:   `{"code":"invalidArgument","detail":"Invalid render parameter","message":"Invalid render parameter","status":400,"title":"Bad Request","type":"about:blank"}`,
+			},
+		},
 		"failure": {
 			input: input{
 				w:   httptest.NewRecorder(),
@@ -250,7 +506,7 @@ func TestTaskHandler_GetTask(t *testing.T) {
 			},
 			want: want{
 				status: http.StatusNotFound,
-				body:   `{"message":"missing task by abc"}`,
+				body:   `{"code":"notfound","detail":"missing task by abc","message":"missing task by abc","status":404,"title":"Not Found","type":"about:blank"}`,
 			},
 		},
 	}
@@ -259,7 +515,7 @@ func TestTaskHandler_GetTask(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			hn := tc.setup()
 
-			hn.GetTask(tc.input.w, tc.input.r, tc.input.tid)
+			hn.GetTask(tc.input.w, tc.input.r, tc.input.tid, tc.input.params)
 
 			assert.Equal(t, tc.want.status, tc.input.w.Code)
 			assert.JSONEq(t, tc.want.body, tc.input.w.Body.String())
@@ -276,6 +532,7 @@ func TestTaskHandler_PostTask(t *testing.T) {
 		status int
 		body   string
 	}
+	ctx := ctxhelper.WithSubject(context.Background(), "sub1")
 	tests := map[string]struct {
 		input input
 		setup func() *handler.TaskHandler
@@ -284,11 +541,11 @@ func TestTaskHandler_PostTask(t *testing.T) {
 		"success": {
 			input: input{
 				w: httptest.NewRecorder(),
-				r: httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/tasks", strings.NewReader(`{"content":"ok"}`)),
+				r: httptest.NewRequestWithContext(ctx, http.MethodPost, "/tasks", strings.NewReader(`{"content":"ok"}`)),
 			},
 			setup: func() *handler.TaskHandler {
 				mck := new(MockTaskInteractor)
-				mck.On("CreateTask", context.Background(), "ok").Return("0192b845-7a32-706b-ae58-d46437963c0e", nil)
+				mck.On("CreateTask", ctx, "sub1", "ok").Return("0192b845-7a32-706b-ae58-d46437963c0e", nil)
 				return &handler.TaskHandler{TaskInteractor: mck}
 			},
 			want: want{
@@ -296,30 +553,58 @@ func TestTaskHandler_PostTask(t *testing.T) {
 				body:   `{"id":"0192b845-7a32-706b-ae58-d46437963c0e"}`,
 			},
 		},
+		"success with notifier": {
+			input: input{
+				w: httptest.NewRecorder(),
+				r: httptest.NewRequestWithContext(ctx, http.MethodPost, "/tasks", strings.NewReader(`{"content":"ok"}`)),
+			},
+			setup: func() *handler.TaskHandler {
+				mck := new(MockTaskInteractor)
+				mck.On("CreateTask", ctx, "sub1", "ok").Return("0192b845-7a32-706b-ae58-d46437963c0e", nil)
+				notifier := new(MockTaskNotifier)
+				notifier.On("TaskCreated", ctx, "0192b845-7a32-706b-ae58-d46437963c0e", "ok").Once()
+				return &handler.TaskHandler{TaskInteractor: mck, TaskNotifier: notifier}
+			},
+			want: want{
+				status: http.StatusOK,
+				body:   `{"id":"0192b845-7a32-706b-ae58-d46437963c0e"}`,
+			},
+		},
 		"failure: failed to unmarshal body": {
 			input: input{
 				w: httptest.NewRecorder(),
-				r: httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/tasks", strings.NewReader(``)),
+				r: httptest.NewRequestWithContext(ctx, http.MethodPost, "/tasks", strings.NewReader(``)),
 			},
 			setup: func() *handler.TaskHandler { return &handler.TaskHandler{} },
 			want: want{
 				status: http.StatusBadRequest,
-				body:   `{"message":"invalid request"}`,
+				body:   `{"code":"invalidArgument","detail":"Invalid request","message":"Invalid request","status":400,"title":"Bad Request","type":"about:blank"}`,
+			},
+		},
+		"failure: subject is missing": {
+			input: input{
+				w: httptest.NewRecorder(),
+				r: httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/tasks", strings.NewReader(`{"content":"ok"}`)),
+			},
+			setup: func() *handler.TaskHandler { return &handler.TaskHandler{} },
+			want: want{
+				status: http.StatusForbidden,
+				body:   `{"code":"unauthorized","detail":"Authorization failure","message":"Authorization failure","status":403,"title":"Forbidden","type":"about:blank"}`,
 			},
 		},
 		"failure: failed to create task": {
 			input: input{
 				w: httptest.NewRecorder(),
-				r: httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/tasks", strings.NewReader(`{"content":"failed"}`)),
+				r: httptest.NewRequestWithContext(ctx, http.MethodPost, "/tasks", strings.NewReader(`{"content":"failed"}`)),
 			},
 			setup: func() *handler.TaskHandler {
 				mck := new(MockTaskInteractor)
-				mck.On("CreateTask", context.Background(), "failed").Return("", apperr.New("internal server error", "failed to create new task", apperr.CodeInternal))
+				mck.On("CreateTask", ctx, "sub1", "failed").Return("", apperr.New("internal server error", "failed to create new task", apperr.CodeInternal))
 				return &handler.TaskHandler{TaskInteractor: mck}
 			},
 			want: want{
 				status: http.StatusInternalServerError,
-				body:   `{"message":"failed to create new task"}`,
+				body:   `{"code":"internalServerError","detail":"failed to create new task","message":"failed to create new task","status":500,"title":"Internal Server Error","type":"about:blank"}`,
 			},
 		},
 	}
@@ -332,6 +617,9 @@ func TestTaskHandler_PostTask(t *testing.T) {
 
 			assert.Equal(t, tc.want.status, tc.input.w.Code)
 			assert.JSONEq(t, tc.want.body, tc.input.w.Body.String())
+			if notifier, ok := hn.TaskNotifier.(*MockTaskNotifier); ok {
+				notifier.AssertExpectations(t)
+			}
 		})
 	}
 }
@@ -367,6 +655,24 @@ func TestTaskHandler_PutTask(t *testing.T) {
 				body:   `{"id":"0192b845-7a32-706b-ae58-d46437963c0e"}`,
 			},
 		},
+		"success with notifier": {
+			input: input{
+				w:   httptest.NewRecorder(),
+				r:   httptest.NewRequestWithContext(context.Background(), http.MethodPut, "/tasks/0192b845-7a32-706b-ae58-d46437963c0e", strings.NewReader(`{"content":"want modify"}`)),
+				tid: "0192b845-7a32-706b-ae58-d46437963c0e",
+			},
+			setup: func() *handler.TaskHandler {
+				mck := new(MockTaskInteractor)
+				mck.On("UpdateTask", context.Background(), "0192b845-7a32-706b-ae58-d46437963c0e", "want modify").Return(nil)
+				notifier := new(MockTaskNotifier)
+				notifier.On("TaskUpdated", context.Background(), "0192b845-7a32-706b-ae58-d46437963c0e", "want modify").Once()
+				return &handler.TaskHandler{TaskInteractor: mck, TaskNotifier: notifier}
+			},
+			want: want{
+				status: http.StatusOK,
+				body:   `{"id":"0192b845-7a32-706b-ae58-d46437963c0e"}`,
+			},
+		},
 		"failure: failed to unmarshal body": {
 			input: input{
 				w:   httptest.NewRecorder(),
@@ -376,7 +682,7 @@ func TestTaskHandler_PutTask(t *testing.T) {
 			setup: func() *handler.TaskHandler { return &handler.TaskHandler{} },
 			want: want{
 				status: http.StatusBadRequest,
-				body:   `{"message":"invalid request"}`,
+				body:   `{"code":"invalidArgument","detail":"Invalid request","message":"Invalid request","status":400,"title":"Bad Request","type":"about:blank"}`,
 			},
 		},
 		"failure: failed to update task": {
@@ -392,7 +698,7 @@ func TestTaskHandler_PutTask(t *testing.T) {
 			},
 			want: want{
 				status: http.StatusInternalServerError,
-				body:   `{"message":"failed to update task"}`,
+				body:   `{"code":"internalServerError","detail":"failed to update task","message":"failed to update task","status":500,"title":"Internal Server Error","type":"about:blank"}`,
 			},
 		},
 	}
@@ -405,6 +711,56 @@ func TestTaskHandler_PutTask(t *testing.T) {
 
 			assert.Equal(t, tc.want.status, tc.input.w.Code)
 			assert.JSONEq(t, tc.want.body, tc.input.w.Body.String())
+			if notifier, ok := hn.TaskNotifier.(*MockTaskNotifier); ok {
+				notifier.AssertExpectations(t)
+			}
+		})
+	}
+}
+
+func TestTaskHandler_UnarchiveTask(t *testing.T) {
+	type want struct {
+		status int
+		body   string
+	}
+	tests := map[string]struct {
+		setup func() *handler.TaskHandler
+		want  want
+	}{
+		"success": {
+			setup: func() *handler.TaskHandler {
+				mck := new(MockTaskArchiveInteractor)
+				mck.On("UnarchiveTask", context.Background(), "0192b845-7a32-706b-ae58-d46437963c0e").Return(nil)
+				return &handler.TaskHandler{TaskArchiveInteractor: mck}
+			},
+			want: want{
+				status: http.StatusOK,
+				body:   `{"id":"0192b845-7a32-706b-ae58-d46437963c0e"}`,
+			},
+		},
+		"failure: task is not archived": {
+			setup: func() *handler.TaskHandler {
+				mck := new(MockTaskArchiveInteractor)
+				mck.On("UnarchiveTask", context.Background(), "0192b845-7a32-706b-ae58-d46437963c0e").Return(apperr.New("not found", "not found archived task", apperr.CodeNotFound))
+				return &handler.TaskHandler{TaskArchiveInteractor: mck}
+			},
+			want: want{
+				status: http.StatusNotFound,
+				body:   `{"code":"notfound","detail":"not found archived task","message":"not found archived task","status":404,"title":"Not Found","type":"about:blank"}`,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			hn := tc.setup()
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/tasks/0192b845-7a32-706b-ae58-d46437963c0e/unarchive", nil)
+
+			hn.UnarchiveTask(w, r, "0192b845-7a32-706b-ae58-d46437963c0e")
+
+			assert.Equal(t, tc.want.status, w.Code)
+			assert.JSONEq(t, tc.want.body, w.Body.String())
 		})
 	}
 }
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/cmd/api/internal/transportlayer/rest/oapi"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"log/slog"
+	"net/http"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+type TimeEntryHandler struct {
+	TimeEntryInteractor TimeEntryInteractor
+}
+
+// StartTimer starts timer on task for [POST /tasks/{taskId}/timer/start]
+func (h *TimeEntryHandler) StartTimer(w http.ResponseWriter, r *http.Request, taskID oapi.TaskID) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/TimeEntryHandler/StartTimer").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		entry, err := h.TimeEntryInteractor.StartTimer(r.Context(), sub, taskID)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(w).Encode(toTimeEntry(entry))
+	})
+}
+
+// StopTimer stops running timer on task for [POST /tasks/{taskId}/timer/stop]
+func (h *TimeEntryHandler) StopTimer(w http.ResponseWriter, r *http.Request, taskID oapi.TaskID) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/TimeEntryHandler/StopTimer").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		entry, err := h.TimeEntryInteractor.StopTimer(r.Context(), sub, taskID)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(w).Encode(toTimeEntry(entry))
+	})
+}
+
+// PostTimeEntry adds time tracked manually for [POST /tasks/{taskId}/time-entries]
+func (h *TimeEntryHandler) PostTimeEntry(w http.ResponseWriter, r *http.Request, taskID oapi.TaskID) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/TimeEntryHandler/PostTimeEntry").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		var body oapi.RequestTimeEntry
+		err := json.NewDecoder(r.Body).Decode(&body)
+		if err != nil {
+			return apperr.New("unmarshal PostTimeEntry body", "Invalid request", apperr.WithMessageID(message.InvalidRequest), apperr.WithCause(err), apperr.CodeInvalidArgument)
+		}
+		entry, err := h.TimeEntryInteractor.AddTimeEntry(r.Context(), sub, taskID, body.StartedAt, body.EndedAt)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(w).Encode(toTimeEntry(entry))
+	})
+}
+
+// GetTimeReport reports own tracked time for [GET /reports/time]
+func (h *TimeEntryHandler) GetTimeReport(w http.ResponseWriter, r *http.Request, params oapi.GetTimeReportParams) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/TimeEntryHandler/GetTimeReport").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		groupBy := oapi.GetTimeReportParamsGroupByDay
+		if params.GroupBy != nil {
+			groupBy = *params.GroupBy
+		}
+		if !groupBy.Valid() {
+			return apperr.New(fmt.Sprintf("unsupported group_by %q", groupBy), "Invalid group_by parameter", apperr.WithMessageID(message.InvalidGroupBy), apperr.CodeInvalidArgument)
+		}
+		report, err := h.TimeEntryInteractor.ReportTime(r.Context(), sub, params.From.Time, params.To.Time, entity.TimeReportGroupBy(groupBy))
+		if err != nil {
+			return err
+		}
+		res := oapi.ResponseTimeReport{
+			Timezone: report.Location.String(),
+			Items:    make([]oapi.TimeReportItem, len(report.Items)),
+		}
+		for i, item := range report.Items {
+			res.Items[i] = oapi.TimeReportItem{Key: item.Key, Seconds: int64(item.Duration.Seconds())}
+		}
+		return json.NewEncoder(w).Encode(res)
+	})
+}
+
+func toTimeEntry(e entity.TimeEntry) oapi.TimeEntry {
+	res := oapi.TimeEntry{
+		ID:        e.ID,
+		TaskID:    e.TaskID,
+		StartedAt: e.StartedAt,
+		CreatedAt: e.CreatedAt,
+		UpdatedAt: e.UpdatedAt,
+	}
+	if !e.Running() {
+		res.EndedAt = &e.EndedAt
+	}
+	return res
+}
@@ -0,0 +1,247 @@
+package handler_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/transportlayer/rest/handler/v2"
+	"go-playground/cmd/api/internal/transportlayer/rest/oapi"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"go-playground/pkg/timex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeEntryHandler_StartTimer(t *testing.T) {
+	tests := map[string]struct {
+		ctx    context.Context
+		setup  func() *handler.TimeEntryHandler
+		status int
+		body   string
+	}{
+		"success": {
+			ctx: ctxhelper.WithSubject(context.Background(), "sub1"),
+			setup: func() *handler.TimeEntryHandler {
+				mck := new(MockTimeEntryInteractor)
+				mck.On("StartTimer", ctxhelper.WithSubject(context.Background(), "sub1"), "sub1", "0190fe59-6618-7811-8b28-a3e67969a4ef").Return(entity.TimeEntry{
+					ID:        "0193a5b2-7400-7c1d-8e2f-4b9a1d8e3c02",
+					TaskID:    "0190fe59-6618-7811-8b28-a3e67969a4ef",
+					StartedAt: time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC),
+					CreatedAt: time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC),
+				}, nil)
+				return &handler.TimeEntryHandler{TimeEntryInteractor: mck}
+			},
+			status: http.StatusOK,
+			body: `
+{
+  "id": "0193a5b2-7400-7c1d-8e2f-4b9a1d8e3c02",
+  "taskId": "0190fe59-6618-7811-8b28-a3e67969a4ef",
+  "startedAt": "2024-12-02T00:00:00Z",
+  "createdAt": "2024-12-02T00:00:00Z",
+  "updatedAt": "2024-12-02T00:00:00Z"
+}
+			`,
+		},
+		"failure timer is already running": {
+			ctx: ctxhelper.WithSubject(context.Background(), "sub1"),
+			setup: func() *handler.TimeEntryHandler {
+				mck := new(MockTimeEntryInteractor)
+				mck.On("StartTimer", ctxhelper.WithSubject(context.Background(), "sub1"), "sub1", "0190fe59-6618-7811-8b28-a3e67969a4ef").
+					Return(entity.TimeEntry{}, apperr.New("timer is running", "Timer is already running. Stop it before starting new one", apperr.CodeFailedPrecondition))
+				return &handler.TimeEntryHandler{TimeEntryInteractor: mck}
+			},
+			status: http.StatusBadRequest,
+			body:   `{"code":"failedPrecondition","detail":"Timer is already running. Stop it before starting new one","message":"Timer is already running. Stop it before starting new one","status":400,"title":"Bad Request","type":"about:blank"}`,
+		},
+		"failure missing subject": {
+			ctx: context.Background(),
+			setup: func() *handler.TimeEntryHandler {
+				return &handler.TimeEntryHandler{TimeEntryInteractor: new(MockTimeEntryInteractor)}
+			},
+			status: http.StatusForbidden,
+			body:   `{"code":"unauthorized","detail":"Authorization failure","message":"Authorization failure","status":403,"title":"Forbidden","type":"about:blank"}`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(tc.ctx, http.MethodPost, "/tasks/0190fe59-6618-7811-8b28-a3e67969a4ef/timer/start", nil)
+
+			tc.setup().StartTimer(w, r, "0190fe59-6618-7811-8b28-a3e67969a4ef")
+
+			assert.Equal(t, tc.status, w.Code)
+			assert.JSONEq(t, tc.body, w.Body.String())
+		})
+	}
+}
+
+func TestTimeEntryHandler_StopTimer(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "sub1")
+	mck := new(MockTimeEntryInteractor)
+	mck.On("StopTimer", ctx, "sub1", "0190fe59-6618-7811-8b28-a3e67969a4ef").Return(entity.TimeEntry{
+		ID:        "0193a5b2-7400-7c1d-8e2f-4b9a1d8e3c02",
+		TaskID:    "0190fe59-6618-7811-8b28-a3e67969a4ef",
+		StartedAt: time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC),
+		EndedAt:   time.Date(2024, 12, 2, 1, 30, 0, 0, time.UTC),
+		CreatedAt: time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2024, 12, 2, 1, 30, 0, 0, time.UTC),
+	}, nil)
+	h := &handler.TimeEntryHandler{TimeEntryInteractor: mck}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/tasks/0190fe59-6618-7811-8b28-a3e67969a4ef/timer/stop", nil)
+
+	h.StopTimer(w, r, "0190fe59-6618-7811-8b28-a3e67969a4ef")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `
+{
+  "id": "0193a5b2-7400-7c1d-8e2f-4b9a1d8e3c02",
+  "taskId": "0190fe59-6618-7811-8b28-a3e67969a4ef",
+  "startedAt": "2024-12-02T00:00:00Z",
+  "endedAt": "2024-12-02T01:30:00Z",
+  "createdAt": "2024-12-02T00:00:00Z",
+  "updatedAt": "2024-12-02T01:30:00Z"
+}
+	`, w.Body.String())
+}
+
+func TestTimeEntryHandler_PostTimeEntry(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "sub1")
+	tests := map[string]struct {
+		body   string
+		setup  func() *handler.TimeEntryHandler
+		status int
+		want   string
+	}{
+		"success": {
+			body: `{"startedAt":"2024-12-02T00:00:00Z","endedAt":"2024-12-02T01:30:00Z"}`,
+			setup: func() *handler.TimeEntryHandler {
+				mck := new(MockTimeEntryInteractor)
+				mck.On("AddTimeEntry", ctx, "sub1", "0190fe59-6618-7811-8b28-a3e67969a4ef", time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 2, 1, 30, 0, 0, time.UTC)).Return(entity.TimeEntry{
+					ID:        "0193a5b2-7400-7c1d-8e2f-4b9a1d8e3c02",
+					TaskID:    "0190fe59-6618-7811-8b28-a3e67969a4ef",
+					StartedAt: time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC),
+					EndedAt:   time.Date(2024, 12, 2, 1, 30, 0, 0, time.UTC),
+					CreatedAt: time.Date(2024, 12, 3, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2024, 12, 3, 0, 0, 0, 0, time.UTC),
+				}, nil)
+				return &handler.TimeEntryHandler{TimeEntryInteractor: mck}
+			},
+			status: http.StatusOK,
+			want: `
+{
+  "id": "0193a5b2-7400-7c1d-8e2f-4b9a1d8e3c02",
+  "taskId": "0190fe59-6618-7811-8b28-a3e67969a4ef",
+  "startedAt": "2024-12-02T00:00:00Z",
+  "endedAt": "2024-12-02T01:30:00Z",
+  "createdAt": "2024-12-03T00:00:00Z",
+  "updatedAt": "2024-12-03T00:00:00Z"
+}
+			`,
+		},
+		"failure invalid body": {
+			body: `{"startedAt":"yesterday"}`,
+			setup: func() *handler.TimeEntryHandler {
+				return &handler.TimeEntryHandler{TimeEntryInteractor: new(MockTimeEntryInteractor)}
+			},
+			status: http.StatusBadRequest,
+			want:   `{"code":"invalidArgument","detail":"Invalid request","message":"Invalid request","status":400,"title":"Bad Request","type":"about:blank"}`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/tasks/0190fe59-6618-7811-8b28-a3e67969a4ef/time-entries", strings.NewReader(tc.body))
+
+			tc.setup().PostTimeEntry(w, r, "0190fe59-6618-7811-8b28-a3e67969a4ef")
+
+			assert.Equal(t, tc.status, w.Code)
+			assert.JSONEq(t, tc.want, w.Body.String())
+		})
+	}
+}
+
+func TestTimeEntryHandler_GetTimeReport(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "sub1")
+	from := types.Date{Time: time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)}
+	to := types.Date{Time: time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC)}
+	task := oapi.GetTimeReportParamsGroupByTask
+	label := oapi.GetTimeReportParamsGroupBy("label")
+	tests := map[string]struct {
+		params oapi.GetTimeReportParams
+		setup  func() *handler.TimeEntryHandler
+		status int
+		want   string
+	}{
+		"success default group by day": {
+			params: oapi.GetTimeReportParams{From: from, To: to},
+			setup: func() *handler.TimeEntryHandler {
+				mck := new(MockTimeEntryInteractor)
+				mck.On("ReportTime", ctx, "sub1", from.Time, to.Time, entity.TimeReportGroupByDay).Return(entity.TimeReport{Location: timex.JST(), Items: []entity.TimeReportItem{
+					{Key: "2024-12-01", Duration: 90 * time.Minute},
+				}}, nil)
+				return &handler.TimeEntryHandler{TimeEntryInteractor: mck}
+			},
+			status: http.StatusOK,
+			want:   `{"timezone":"Asia/Tokyo","items":[{"key":"2024-12-01","seconds":5400}]}`,
+		},
+		"success group by task": {
+			params: oapi.GetTimeReportParams{From: from, To: to, GroupBy: &task},
+			setup: func() *handler.TimeEntryHandler {
+				mck := new(MockTimeEntryInteractor)
+				mck.On("ReportTime", ctx, "sub1", from.Time, to.Time, entity.TimeReportGroupByTask).Return(entity.TimeReport{Location: timex.JST(), Items: []entity.TimeReportItem{}}, nil)
+				return &handler.TimeEntryHandler{TimeEntryInteractor: mck}
+			},
+			status: http.StatusOK,
+			want:   `{"timezone":"Asia/Tokyo","items":[]}`,
+		},
+		"failure unsupported group": {
+			params: oapi.GetTimeReportParams{From: from, To: to, GroupBy: &label},
+			setup: func() *handler.TimeEntryHandler {
+				return &handler.TimeEntryHandler{TimeEntryInteractor: new(MockTimeEntryInteractor)}
+			},
+			status: http.StatusBadRequest,
+			want:   `{"code":"invalidArgument","detail":"Invalid group_by parameter","message":"Invalid group_by parameter","status":400,"title":"Bad Request","type":"about:blank"}`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/reports/time", nil)
+
+			tc.setup().GetTimeReport(w, r, tc.params)
+
+			assert.Equal(t, tc.status, w.Code)
+			assert.JSONEq(t, tc.want, w.Body.String())
+		})
+	}
+}
+
+func TestTimeEntryHandler_GetTimeReport_Preferences(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	ctx := ctxhelper.WithPreferences(ctxhelper.WithSubject(context.Background(), "sub1"), ctxhelper.UserPreferences{Location: newYork})
+	from := types.Date{Time: time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)}
+	to := types.Date{Time: time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC)}
+	week := oapi.GetTimeReportParamsGroupByWeek
+	mck := new(MockTimeEntryInteractor)
+	mck.On("ReportTime", ctx, "sub1", from.Time, to.Time, entity.TimeReportGroupByWeek).Return(entity.TimeReport{Location: newYork, Items: []entity.TimeReportItem{
+		{Key: "2024-11-25", Duration: time.Hour},
+	}}, nil)
+	h := &handler.TimeEntryHandler{TimeEntryInteractor: mck}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/reports/time", nil)
+
+	h.GetTimeReport(w, r, oapi.GetTimeReportParams{From: from, To: to, GroupBy: &week})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"timezone":"America/New_York","items":[{"key":"2024-11-25","seconds":3600}]}`, w.Body.String())
+}
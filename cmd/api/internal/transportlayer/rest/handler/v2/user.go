@@ -1,7 +1,11 @@
 package handler
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/message"
 	"go-playground/cmd/api/internal/transportlayer/rest/oapi"
 	"go-playground/pkg/apperr"
 	"go-playground/pkg/ctxhelper"
@@ -22,23 +26,13 @@ func (u *UserHandler) GetMe(w http.ResponseWriter, r *http.Request) {
 	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
 		sub, ok := ctxhelper.Subject(r.Context())
 		if !ok {
-			return apperr.New("subject is missing but this is unexpected", "authorization failure", apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
 		}
 		user, err := u.UserInteractor.FindBySub(r.Context(), sub)
 		if err != nil {
 			return err
 		}
-		res := oapi.User{
-			ID:            user.ID.String(),
-			Sub:           user.Sub,
-			GivenName:     user.GivenName,
-			FamilyName:    user.FamilyName,
-			Email:         types.Email(user.Email),
-			EmailVerified: user.EmailVerified,
-			CreatedAt:     user.CreatedAt,
-			UpdatedAt:     user.UpdatedAt,
-		}
-		return json.NewEncoder(w).Encode(res)
+		return json.NewEncoder(w).Encode(toUser(user))
 	})
 }
 
@@ -49,12 +43,12 @@ func (u *UserHandler) PostUser(w http.ResponseWriter, r *http.Request) {
 	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
 		sub, ok := ctxhelper.Subject(r.Context())
 		if !ok {
-			return apperr.New("subject is missing but this is unexpected", "authorization failure", apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
 		}
 		var body oapi.RequestUser
 		err := json.NewDecoder(r.Body).Decode(&body)
 		if err != nil {
-			return apperr.New("unmarshal PostUser request body", "invalid request", apperr.WithCause(err), apperr.CodeInvalidArgument)
+			return apperr.New("unmarshal PostUser request body", "Invalid request", apperr.WithMessageID(message.InvalidRequest), apperr.WithCause(err), apperr.CodeInvalidArgument)
 		}
 		uid, err := u.UserInteractor.CreateUser(
 			r.Context(),
@@ -62,7 +56,6 @@ func (u *UserHandler) PostUser(w http.ResponseWriter, r *http.Request) {
 			body.GivenName,
 			body.FamilyName,
 			string(body.Email),
-			body.EmailVerified,
 		)
 		if err != nil {
 			return err
@@ -72,3 +65,128 @@ func (u *UserHandler) PostUser(w http.ResponseWriter, r *http.Request) {
 		})
 	})
 }
+
+// PatchMe updates own profile with JSON Merge Patch for [PATCH /users/me]
+func (u *UserHandler) PatchMe(w http.ResponseWriter, r *http.Request) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/UserHandler/PatchMe").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		var body map[string]json.RawMessage
+		err := json.NewDecoder(r.Body).Decode(&body)
+		if err != nil || body == nil {
+			return apperr.New("unmarshal PatchMe request body", "Invalid request", apperr.WithMessageID(message.InvalidRequest), apperr.WithCause(err), apperr.CodeInvalidArgument)
+		}
+		patch, err := toUserProfilePatch(body)
+		if err != nil {
+			return err
+		}
+		user, err := u.UserInteractor.UpdateMe(r.Context(), sub, patch)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(w).Encode(toUser(user))
+	})
+}
+
+// toUserProfilePatch converts members of merge patch to [entity.UserProfilePatch].
+// Null member removes field, so it is converted to empty string and rejected by validation of user.
+func toUserProfilePatch(body map[string]json.RawMessage) (entity.UserProfilePatch, error) {
+	var patch entity.UserProfilePatch
+	for name, raw := range body {
+		var field **string
+		switch name {
+		case "givenName":
+			field = &patch.GivenName
+		case "familyName":
+			field = &patch.FamilyName
+		case "email":
+			field = &patch.Email
+		default:
+			return entity.UserProfilePatch{}, apperr.New(
+				fmt.Sprintf("patch unknown user field %q", name),
+				fmt.Sprintf("%s can not be updated", name),
+				apperr.WithMessageID(message.FieldNotUpdatable, name),
+				apperr.WithFieldErrors(apperr.FieldError{Field: name, Rule: "not_updatable", Message: "can not be updated"}),
+				apperr.CodeInvalidArgument,
+			)
+		}
+		var v string
+		if !bytes.Equal(raw, []byte("null")) {
+			if err := json.Unmarshal(raw, &v); err != nil {
+				return entity.UserProfilePatch{}, apperr.New(
+					fmt.Sprintf("unmarshal user field %q", name),
+					fmt.Sprintf("%s must be a string", name),
+					apperr.WithMessageID(message.FieldMustBeString, name),
+					apperr.WithFieldErrors(apperr.FieldError{Field: name, Rule: "string", Message: "must be a string"}),
+					apperr.WithCause(err),
+					apperr.CodeInvalidArgument,
+				)
+			}
+		}
+		*field = &v
+	}
+	return patch, nil
+}
+
+// DeleteMe deletes own account for [DELETE /users/me]
+func (u *UserHandler) DeleteMe(w http.ResponseWriter, r *http.Request) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/UserHandler/DeleteMe").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		user, err := u.UserInteractor.DeleteMe(r.Context(), sub)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(w).Encode(toUser(user))
+	})
+}
+
+// RestoreMe cancels deletion of own account for [POST /users/me/restore]
+func (u *UserHandler) RestoreMe(w http.ResponseWriter, r *http.Request) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/UserHandler/RestoreMe").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		user, err := u.UserInteractor.RestoreMe(r.Context(), sub)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(w).Encode(toUser(user))
+	})
+}
+
+// toUser converts user entity to response.
+func toUser(user entity.User) oapi.User {
+	res := oapi.User{
+		ID:            user.ID.String(),
+		Sub:           user.Sub,
+		GivenName:     user.GivenName,
+		FamilyName:    user.FamilyName,
+		Email:         types.Email(user.Email),
+		EmailVerified: user.EmailVerified,
+		CreatedAt:     user.CreatedAt,
+		UpdatedAt:     user.UpdatedAt,
+		Role:          oapi.UserRole(user.Role),
+	}
+	if user.Disabled() {
+		disabledAt := user.DisabledAt
+		res.DisabledAt = &disabledAt
+	}
+	if user.Deleted() {
+		deletedAt, purgeAt := user.DeletedAt, user.PurgeAt()
+		res.DeletedAt = &deletedAt
+		res.PurgeAt = &purgeAt
+	}
+	return res
+}
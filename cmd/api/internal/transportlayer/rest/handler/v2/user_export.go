@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/cmd/api/internal/transportlayer/rest/oapi"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"log/slog"
+	"net/http"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+type UserExportHandler struct {
+	UserExportInteractor UserExportInteractor
+	// UserExportNotifier is notified when export is requested. Pending exports are still built periodically if nil.
+	UserExportNotifier UserExportNotifier
+}
+
+// RequestMyExport requests export of own personal data for [POST /users/me/export]
+func (u *UserExportHandler) RequestMyExport(w http.ResponseWriter, r *http.Request) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/UserExportHandler/RequestMyExport").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		export, err := u.UserExportInteractor.RequestExport(r.Context(), sub)
+		if err != nil {
+			return err
+		}
+		if u.UserExportNotifier != nil {
+			u.UserExportNotifier.Notify()
+		}
+		w.WriteHeader(http.StatusAccepted)
+		return json.NewEncoder(w).Encode(toUserExport(export))
+	})
+}
+
+// GetMyExport gets status of own export for [GET /users/me/exports/{exportId}]
+func (u *UserExportHandler) GetMyExport(w http.ResponseWriter, r *http.Request, id oapi.ExportID) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/UserExportHandler/GetMyExport").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		export, err := u.UserExportInteractor.FindExport(r.Context(), sub, id)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(w).Encode(toUserExport(export))
+	})
+}
+
+// DownloadMyExport downloads own export as JSON file for [GET /users/me/exports/{exportId}/download]
+func (u *UserExportHandler) DownloadMyExport(w http.ResponseWriter, r *http.Request, id oapi.ExportID) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/UserExportHandler/DownloadMyExport").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		export, err := u.UserExportInteractor.FindExport(r.Context(), sub, id)
+		if err != nil {
+			return err
+		}
+		if !export.Ready() {
+			return apperr.New(fmt.Sprintf("download export %q in status %s", export.ID, export.Status), "Export is not ready", apperr.WithMessageID(message.ExportNotReady), apperr.CodeInvalidArgument)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="export-%s.json"`, export.ID))
+		_, err = w.Write(export.Content)
+		return err
+	})
+}
+
+// toUserExport converts user export entity to response.
+func toUserExport(e entity.UserExport) oapi.UserExport {
+	return oapi.UserExport{
+		ID:        e.ID,
+		Status:    oapi.UserExportStatus(e.Status),
+		CreatedAt: e.CreatedAt,
+		UpdatedAt: e.UpdatedAt,
+	}
+}
@@ -0,0 +1,208 @@
+package handler_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/transportlayer/rest/handler/v2"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"go-playground/pkg/testhelper"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserExportHandler_RequestMyExport(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "sub1")
+	type want struct {
+		status int
+		body   string
+	}
+	tests := map[string]struct {
+		ctx   context.Context
+		setup func(*testing.T) *handler.UserExportHandler
+		want  want
+	}{
+		"success": {
+			ctx: ctx,
+			setup: func(t *testing.T) *handler.UserExportHandler {
+				mck := new(MockUserExportInteractor)
+				mck.
+					On("RequestExport", ctx, "sub1").
+					Return(entity.UserExport{
+						ID:        "0193dd96-47aa-755b-806e-0b22d6f1849b",
+						UserID:    testhelper.UUIDFromString(t, "0193196b-28c4-7337-a891-e728860339cd"),
+						Status:    entity.UserExportStatusPending,
+						CreatedAt: time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC),
+						UpdatedAt: time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC),
+					}, nil)
+				notifier := new(MockUserExportNotifier)
+				notifier.On("Notify").Once()
+				t.Cleanup(func() { notifier.AssertExpectations(t) })
+				return &handler.UserExportHandler{UserExportInteractor: mck, UserExportNotifier: notifier}
+			},
+			want: want{
+				status: http.StatusAccepted,
+				body: `{
+  "id": "0193dd96-47aa-755b-806e-0b22d6f1849b",
+  "status": "pending",
+  "createdAt": "2024-11-08T14:40:33Z",
+  "updatedAt": "2024-11-08T14:40:33Z"
+}`,
+			},
+		},
+		"failure": {
+			ctx: ctx,
+			setup: func(t *testing.T) *handler.UserExportHandler {
+				mck := new(MockUserExportInteractor)
+				mck.
+					On("RequestExport", ctx, "sub1").
+					Return(entity.UserExport{}, apperr.New("find user by sub", "not found user", apperr.CodeNotFound))
+				return &handler.UserExportHandler{UserExportInteractor: mck, UserExportNotifier: new(MockUserExportNotifier)}
+			},
+			want: want{
+				status: http.StatusNotFound,
+				body:   `{"code":"notfound","detail":"not found user","message":"not found user","status":404,"title":"Not Found","type":"about:blank"}`,
+			},
+		},
+		"failure missing subject from context": {
+			ctx:   context.Background(),
+			setup: func(t *testing.T) *handler.UserExportHandler { return new(handler.UserExportHandler) },
+			want: want{
+				status: http.StatusForbidden,
+				body:   `{"code":"unauthorized","detail":"Authorization failure","message":"Authorization failure","status":403,"title":"Forbidden","type":"about:blank"}`,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := tc.setup(t)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(tc.ctx, http.MethodPost, "/users/me/export", nil)
+
+			h.RequestMyExport(w, r)
+
+			assert.Equal(t, tc.want.status, w.Code)
+			assert.JSONEq(t, tc.want.body, w.Body.String())
+		})
+	}
+}
+
+func TestUserExportHandler_GetMyExport(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "sub1")
+	type want struct {
+		status int
+		body   string
+	}
+	tests := map[string]struct {
+		setup func(*testing.T) *handler.UserExportHandler
+		want  want
+	}{
+		"success": {
+			setup: func(t *testing.T) *handler.UserExportHandler {
+				mck := new(MockUserExportInteractor)
+				mck.
+					On("FindExport", ctx, "sub1", "0193dd96-47aa-755b-806e-0b22d6f1849b").
+					Return(entity.UserExport{
+						ID:        "0193dd96-47aa-755b-806e-0b22d6f1849b",
+						Status:    entity.UserExportStatusReady,
+						Content:   []byte(`{}`),
+						CreatedAt: time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC),
+						UpdatedAt: time.Date(2024, 11, 8, 14, 41, 33, 0, time.UTC),
+					}, nil)
+				return &handler.UserExportHandler{UserExportInteractor: mck}
+			},
+			want: want{
+				status: http.StatusOK,
+				body: `{
+  "id": "0193dd96-47aa-755b-806e-0b22d6f1849b",
+  "status": "ready",
+  "createdAt": "2024-11-08T14:40:33Z",
+  "updatedAt": "2024-11-08T14:41:33Z"
+}`,
+			},
+		},
+		"failure not found": {
+			setup: func(t *testing.T) *handler.UserExportHandler {
+				mck := new(MockUserExportInteractor)
+				mck.
+					On("FindExport", ctx, "sub1", "0193dd96-47aa-755b-806e-0b22d6f1849b").
+					Return(entity.UserExport{}, apperr.New("find user export", "not found export", apperr.CodeNotFound))
+				return &handler.UserExportHandler{UserExportInteractor: mck}
+			},
+			want: want{
+				status: http.StatusNotFound,
+				body:   `{"code":"notfound","detail":"not found export","message":"not found export","status":404,"title":"Not Found","type":"about:blank"}`,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := tc.setup(t)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/users/me/exports/0193dd96-47aa-755b-806e-0b22d6f1849b", nil)
+
+			h.GetMyExport(w, r, "0193dd96-47aa-755b-806e-0b22d6f1849b")
+
+			assert.Equal(t, tc.want.status, w.Code)
+			assert.JSONEq(t, tc.want.body, w.Body.String())
+		})
+	}
+}
+
+func TestUserExportHandler_DownloadMyExport(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "sub1")
+	type want struct {
+		status      int
+		disposition string
+		body        string
+	}
+	tests := map[string]struct {
+		export entity.UserExport
+		want   want
+	}{
+		"success": {
+			export: entity.UserExport{
+				ID:      "0193dd96-47aa-755b-806e-0b22d6f1849b",
+				Status:  entity.UserExportStatusReady,
+				Content: []byte(`{"profile":{"givenName":"Alice"}}`),
+			},
+			want: want{
+				status:      http.StatusOK,
+				disposition: `attachment; filename="export-0193dd96-47aa-755b-806e-0b22d6f1849b.json"`,
+				body:        `{"profile":{"givenName":"Alice"}}`,
+			},
+		},
+		"failure not ready": {
+			export: entity.UserExport{
+				ID:     "0193dd96-47aa-755b-806e-0b22d6f1849b",
+				Status: entity.UserExportStatusPending,
+			},
+			want: want{
+				status: http.StatusBadRequest,
+				body:   `{"code":"invalidArgument","detail":"Export is not ready","message":"Export is not ready","status":400,"title":"Bad Request","type":"about:blank"}`,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mck := new(MockUserExportInteractor)
+			mck.On("FindExport", ctx, "sub1", "0193dd96-47aa-755b-806e-0b22d6f1849b").Return(tc.export, nil)
+			h := &handler.UserExportHandler{UserExportInteractor: mck}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/users/me/exports/0193dd96-47aa-755b-806e-0b22d6f1849b/download", nil)
+
+			h.DownloadMyExport(w, r, "0193dd96-47aa-755b-806e-0b22d6f1849b")
+
+			assert.Equal(t, tc.want.status, w.Code)
+			assert.Equal(t, tc.want.disposition, w.Header().Get("Content-Disposition"))
+			assert.JSONEq(t, tc.want.body, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/cmd/api/internal/transportlayer/rest/oapi"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+type UserPreferencesHandler struct {
+	UserPreferencesInteractor UserPreferencesInteractor
+}
+
+// GetMyPreferences gets own preferences for [GET /users/me/preferences]
+func (h *UserPreferencesHandler) GetMyPreferences(w http.ResponseWriter, r *http.Request) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/UserPreferencesHandler/GetMyPreferences").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		p, err := h.UserPreferencesInteractor.FindPreferences(r.Context(), sub)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(w).Encode(toUserPreferences(p))
+	})
+}
+
+// UpdateMyPreferences replaces own preferences for [PUT /users/me/preferences]
+func (h *UserPreferencesHandler) UpdateMyPreferences(w http.ResponseWriter, r *http.Request) {
+	defer newrelic.FromContext(r.Context()).StartSegment("handler/UserPreferencesHandler/UpdateMyPreferences").End()
+
+	ErrorHandlerFunc(w, r, func(w http.ResponseWriter, r *http.Request) error {
+		sub, ok := ctxhelper.Subject(r.Context())
+		if !ok {
+			return apperr.New("subject is missing but this is unexpected", "Authorization failure", apperr.WithMessageID(message.AuthorizationFailure), apperr.CodeUnAuthz, apperr.WithLevel(slog.LevelError))
+		}
+		var body oapi.RequestUserPreferences
+		err := json.NewDecoder(r.Body).Decode(&body)
+		if err != nil {
+			return apperr.New("unmarshal UpdateMyPreferences body", "Invalid request", apperr.WithMessageID(message.InvalidRequest), apperr.WithCause(err), apperr.CodeInvalidArgument)
+		}
+		weekStart, ok := parseWeekday(string(body.WeekStart))
+		if !ok {
+			return apperr.New(fmt.Sprintf("unsupported week start %q", body.WeekStart), "Invalid weekStart", apperr.WithMessageID(message.InvalidWeekStart), apperr.CodeInvalidArgument)
+		}
+		p, err := h.UserPreferencesInteractor.UpdatePreferences(r.Context(), sub, body.Timezone, body.Locale, body.PageSize, weekStart)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(w).Encode(toUserPreferences(p))
+	})
+}
+
+func toUserPreferences(p entity.UserPreferences) oapi.UserPreferences {
+	return oapi.UserPreferences{
+		Timezone:  p.Timezone,
+		Locale:    p.Locale,
+		PageSize:  p.PageSize,
+		WeekStart: oapi.UserPreferencesWeekStart(strings.ToLower(p.WeekStart.String())),
+	}
+}
+
+// parseWeekday parses lower case name of weekday such as monday.
+func parseWeekday(name string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.ToLower(d.String()) == name {
+			return d, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,108 @@
+package handler_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/transportlayer/rest/handler/v2"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserPreferencesHandler_GetMyPreferences(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "sub1")
+	mck := new(MockUserPreferencesInteractor)
+	mck.
+		On("FindPreferences", ctx, "sub1").
+		Return(entity.DefaultUserPreferences(uuid.MustParse("01928120-055d-7edb-a12a-2d290512266e")), nil)
+	h := &handler.UserPreferencesHandler{UserPreferencesInteractor: mck}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/users/me/preferences", nil)
+
+	h.GetMyPreferences(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"timezone":"Asia/Tokyo","locale":"en-US","pageSize":10,"weekStart":"monday"}`, w.Body.String())
+}
+
+func TestUserPreferencesHandler_UpdateMyPreferences(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "sub1")
+	type want struct {
+		status int
+		body   string
+	}
+	tests := map[string]struct {
+		body  string
+		setup func() *handler.UserPreferencesHandler
+		want  want
+	}{
+		"success": {
+			body: `{"timezone":"America/New_York","locale":"en-US","pageSize":50,"weekStart":"sunday"}`,
+			setup: func() *handler.UserPreferencesHandler {
+				mck := new(MockUserPreferencesInteractor)
+				mck.
+					On("UpdatePreferences", ctx, "sub1", "America/New_York", "en-US", int32(50), time.Sunday).
+					Return(entity.UserPreferences{
+						Timezone:  "America/New_York",
+						Locale:    "en-US",
+						PageSize:  50,
+						WeekStart: time.Sunday,
+					}, nil)
+				return &handler.UserPreferencesHandler{UserPreferencesInteractor: mck}
+			},
+			want: want{
+				status: http.StatusOK,
+				body:   `{"timezone":"America/New_York","locale":"en-US","pageSize":50,"weekStart":"sunday"}`,
+			},
+		},
+		"failure unmarshal request body": {
+			body:  "",
+			setup: func() *handler.UserPreferencesHandler { return new(handler.UserPreferencesHandler) },
+			want: want{
+				status: http.StatusBadRequest,
+				body:   `{"code":"invalidArgument","detail":"Invalid request","message":"Invalid request","status":400,"title":"Bad Request","type":"about:blank"}`,
+			},
+		},
+		"failure unknown week start": {
+			body:  `{"timezone":"Asia/Tokyo","locale":"ja-JP","pageSize":10,"weekStart":"someday"}`,
+			setup: func() *handler.UserPreferencesHandler { return new(handler.UserPreferencesHandler) },
+			want: want{
+				status: http.StatusBadRequest,
+				body:   `{"code":"invalidArgument","detail":"Invalid weekStart","message":"Invalid weekStart","status":400,"title":"Bad Request","type":"about:blank"}`,
+			},
+		},
+		"failure invalid preferences": {
+			body: `{"timezone":"Asia/Atlantis","locale":"ja-JP","pageSize":10,"weekStart":"monday"}`,
+			setup: func() *handler.UserPreferencesHandler {
+				mck := new(MockUserPreferencesInteractor)
+				mck.
+					On("UpdatePreferences", ctx, "sub1", "Asia/Atlantis", "ja-JP", int32(10), time.Monday).
+					Return(entity.UserPreferences{}, apperr.New("validate user preferences entity", "Some fields are invalid", apperr.WithFieldErrors(apperr.FieldError{Field: "timezone", Rule: "timezone", Message: "must be IANA time zone name"}), apperr.CodeInvalidArgument))
+				return &handler.UserPreferencesHandler{UserPreferencesInteractor: mck}
+			},
+			want: want{
+				status: http.StatusBadRequest,
+				body:   `{"code":"invalidArgument","detail":"Some fields are invalid","errors":[{"field":"timezone","rule":"timezone","message":"must be IANA time zone name"}],"message":"Some fields are invalid","status":400,"title":"Bad Request","type":"about:blank"}`,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := tc.setup()
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(ctx, http.MethodPut, "/users/me/preferences", strings.NewReader(tc.body))
+
+			h.UpdateMyPreferences(w, r)
+
+			assert.Equal(t, tc.want.status, w.Code)
+			assert.JSONEq(t, tc.want.body, w.Body.String())
+		})
+	}
+}
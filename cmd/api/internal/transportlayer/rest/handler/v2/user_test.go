@@ -48,7 +48,7 @@ func TestUserHandler_PostCreate(t *testing.T) {
 			setup: func(t *testing.T) *handler.UserHandler {
 				mck := new(MockUserInteractor)
 				mck.
-					On("CreateUser", ctxhelper.WithSubject(context.Background(), "sub1"), "sub1", "Dibbert", "Kozey", "Jonathan74@example.com", true).
+					On("CreateUser", ctxhelper.WithSubject(context.Background(), "sub1"), "sub1", "Dibbert", "Kozey", "Jonathan74@example.com").
 					Return(testhelper.UUIDFromString(t, "0193196b-28c4-7337-a891-e728860339cd"), nil)
 				h := handler.UserHandler{UserInteractor: mck}
 				return &h
@@ -66,7 +66,7 @@ func TestUserHandler_PostCreate(t *testing.T) {
 			setup: func(t *testing.T) *handler.UserHandler { return new(handler.UserHandler) },
 			want: want{
 				status: http.StatusForbidden,
-				body:   `{"message":"authorization failure"}`,
+				body:   `{"code":"unauthorized","detail":"Authorization failure","message":"Authorization failure","status":403,"title":"Forbidden","type":"about:blank"}`,
 			},
 		},
 		"failure unmarshal request body": {
@@ -77,7 +77,7 @@ func TestUserHandler_PostCreate(t *testing.T) {
 			setup: func(t *testing.T) *handler.UserHandler { return new(handler.UserHandler) },
 			want: want{
 				status: http.StatusBadRequest,
-				body:   `{"message":"invalid request"}`,
+				body:   `{"code":"invalidArgument","detail":"Invalid request","message":"Invalid request","status":400,"title":"Bad Request","type":"about:blank"}`,
 			},
 		},
 		"failure interactor returns error": {
@@ -94,14 +94,14 @@ func TestUserHandler_PostCreate(t *testing.T) {
 			setup: func(t *testing.T) *handler.UserHandler {
 				mck := new(MockUserInteractor)
 				mck.
-					On("CreateUser", ctxhelper.WithSubject(context.Background(), "sub1"), "sub1", "Dibbert", "Kozey", "", true).
+					On("CreateUser", ctxhelper.WithSubject(context.Background(), "sub1"), "sub1", "Dibbert", "Kozey", "").
 					Return(uuid.Nil, apperr.New("validation error", "email is required", apperr.CodeInvalidArgument))
 				h := handler.UserHandler{UserInteractor: mck}
 				return &h
 			},
 			want: want{
 				status: http.StatusBadRequest,
-				body:   `{"message":"email is required"}`,
+				body:   `{"code":"invalidArgument","detail":"email is required","message":"email is required","status":400,"title":"Bad Request","type":"about:blank"}`,
 			},
 		},
 	}
@@ -147,6 +147,7 @@ func TestUserHandler_GetMe(t *testing.T) {
 					EmailVerified: true,
 					CreatedAt:     time.Date(2025, 02, 18, 14, 0, 0, 0, time.UTC),
 					UpdatedAt:     time.Date(2025, 02, 18, 14, 0, 0, 0, time.UTC),
+					Role:          entity.RoleUser,
 				}, nil)
 				return input{
 					r: httptest.NewRequestWithContext(ctx, http.MethodGet, "/users/me", nil),
@@ -159,6 +160,7 @@ func TestUserHandler_GetMe(t *testing.T) {
 				{
 					"id":"01951975-21cb-7991-b0a4-a854c842e258",
 					"sub":"01951972-20ce-7002-b4d7-48ca29ffef2b",
+					"role":"user",
 					"familyName":"family",
 					"givenName":"given",
 					"email":"Alvis.Cummerata@example.com",
@@ -182,7 +184,7 @@ func TestUserHandler_GetMe(t *testing.T) {
 			},
 			want: want{
 				status: http.StatusNotFound,
-				body:   `{"message":"error message"}`,
+				body:   `{"code":"notfound","detail":"error message","message":"error message","status":404,"title":"Not Found","type":"about:blank"}`,
 			},
 		},
 		"missing subject": {
@@ -194,7 +196,7 @@ func TestUserHandler_GetMe(t *testing.T) {
 			},
 			want: want{
 				status: http.StatusForbidden,
-				body:   `{"message":"authorization failure"}`,
+				body:   `{"code":"unauthorized","detail":"Authorization failure","message":"Authorization failure","status":403,"title":"Forbidden","type":"about:blank"}`,
 			},
 		},
 	}
@@ -209,3 +211,289 @@ func TestUserHandler_GetMe(t *testing.T) {
 		})
 	}
 }
+
+func TestUserHandler_PatchMe(t *testing.T) {
+	var (
+		givenName = "Alice"
+		email     = "alice@example.com"
+		blank     = ""
+	)
+	ctx := ctxhelper.WithSubject(context.Background(), "sub1")
+	type want struct {
+		status int
+		body   string
+	}
+	tests := map[string]struct {
+		body  string
+		ctx   context.Context
+		setup func(*testing.T) *handler.UserHandler
+		want  want
+	}{
+		"success": {
+			body: `{"givenName":"Alice","email":"alice@example.com"}`,
+			ctx:  ctx,
+			setup: func(t *testing.T) *handler.UserHandler {
+				mck := new(MockUserInteractor)
+				mck.
+					On("UpdateMe", ctx, "sub1", entity.UserProfilePatch{GivenName: &givenName, Email: &email}).
+					Return(entity.User{
+						ID:         testhelper.UUIDFromString(t, "0193196b-28c4-7337-a891-e728860339cd"),
+						Sub:        "sub1",
+						GivenName:  "Alice",
+						FamilyName: "Kozey",
+						Email:      "alice@example.com",
+						CreatedAt:  time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC),
+						UpdatedAt:  time.Date(2024, 11, 9, 14, 40, 33, 0, time.UTC),
+						Role:       entity.RoleUser,
+					}, nil)
+				return &handler.UserHandler{UserInteractor: mck}
+			},
+			want: want{
+				status: http.StatusOK,
+				body: `{
+  "id": "0193196b-28c4-7337-a891-e728860339cd",
+  "sub": "sub1",
+  "role": "user",
+  "givenName": "Alice",
+  "familyName": "Kozey",
+  "email": "alice@example.com",
+  "emailVerified": false,
+  "createdAt": "2024-11-08T14:40:33Z",
+  "updatedAt": "2024-11-09T14:40:33Z"
+}`,
+			},
+		},
+		"failure null removes required field": {
+			body: `{"familyName":null}`,
+			ctx:  ctx,
+			setup: func(t *testing.T) *handler.UserHandler {
+				mck := new(MockUserInteractor)
+				mck.
+					On("UpdateMe", ctx, "sub1", entity.UserProfilePatch{FamilyName: &blank}).
+					Return(entity.User{}, apperr.New("validate user entity", "Some fields are invalid", apperr.WithFieldErrors(apperr.FieldError{Field: "familyName", Rule: "required", Message: "cannot be blank"}), apperr.CodeInvalidArgument))
+				return &handler.UserHandler{UserInteractor: mck}
+			},
+			want: want{
+				status: http.StatusBadRequest,
+				body:   `{"code":"invalidArgument","detail":"Some fields are invalid","errors":[{"field":"familyName","rule":"required","message":"cannot be blank"}],"message":"Some fields are invalid","status":400,"title":"Bad Request","type":"about:blank"}`,
+			},
+		},
+		"failure read only field": {
+			body:  `{"emailVerified":true}`,
+			ctx:   ctx,
+			setup: func(t *testing.T) *handler.UserHandler { return new(handler.UserHandler) },
+			want: want{
+				status: http.StatusBadRequest,
+				body:   `{"code":"invalidArgument","detail":"emailVerified can not be updated","errors":[{"field":"emailVerified","rule":"not_updatable","message":"can not be updated"}],"message":"emailVerified can not be updated","status":400,"title":"Bad Request","type":"about:blank"}`,
+			},
+		},
+		"failure field is not string": {
+			body:  `{"givenName":1}`,
+			ctx:   ctx,
+			setup: func(t *testing.T) *handler.UserHandler { return new(handler.UserHandler) },
+			want: want{
+				status: http.StatusBadRequest,
+				body:   `{"code":"invalidArgument","detail":"givenName must be a string","errors":[{"field":"givenName","rule":"string","message":"must be a string"}],"message":"givenName must be a string","status":400,"title":"Bad Request","type":"about:blank"}`,
+			},
+		},
+		"failure body is not object": {
+			body:  `null`,
+			ctx:   ctx,
+			setup: func(t *testing.T) *handler.UserHandler { return new(handler.UserHandler) },
+			want: want{
+				status: http.StatusBadRequest,
+				body:   `{"code":"invalidArgument","detail":"Invalid request","message":"Invalid request","status":400,"title":"Bad Request","type":"about:blank"}`,
+			},
+		},
+		"failure missing subject from context": {
+			body:  `{}`,
+			ctx:   context.Background(),
+			setup: func(t *testing.T) *handler.UserHandler { return new(handler.UserHandler) },
+			want: want{
+				status: http.StatusForbidden,
+				body:   `{"code":"unauthorized","detail":"Authorization failure","message":"Authorization failure","status":403,"title":"Forbidden","type":"about:blank"}`,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := tc.setup(t)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(tc.ctx, http.MethodPatch, "/users/me", strings.NewReader(tc.body))
+			r.Header.Set("Content-Type", "application/merge-patch+json")
+
+			h.PatchMe(w, r)
+
+			assert.Equal(t, tc.want.status, w.Code)
+			assert.JSONEq(t, tc.want.body, w.Body.String())
+		})
+	}
+}
+
+func TestUserHandler_DeleteMe(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "sub1")
+	type want struct {
+		status int
+		body   string
+	}
+	tests := map[string]struct {
+		ctx   context.Context
+		setup func(*testing.T) *handler.UserHandler
+		want  want
+	}{
+		"success": {
+			ctx: ctx,
+			setup: func(t *testing.T) *handler.UserHandler {
+				mck := new(MockUserInteractor)
+				mck.
+					On("DeleteMe", ctx, "sub1").
+					Return(entity.User{
+						ID:         testhelper.UUIDFromString(t, "0193196b-28c4-7337-a891-e728860339cd"),
+						Sub:        "sub1",
+						GivenName:  "Alice",
+						FamilyName: "Kozey",
+						Email:      "alice@example.com",
+						CreatedAt:  time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC),
+						UpdatedAt:  time.Date(2024, 11, 9, 14, 40, 33, 0, time.UTC),
+						Role:       entity.RoleUser,
+						DeletedAt:  time.Date(2024, 11, 9, 14, 40, 33, 0, time.UTC),
+					}, nil)
+				return &handler.UserHandler{UserInteractor: mck}
+			},
+			want: want{
+				status: http.StatusOK,
+				body: `{
+  "id": "0193196b-28c4-7337-a891-e728860339cd",
+  "sub": "sub1",
+  "role": "user",
+  "givenName": "Alice",
+  "familyName": "Kozey",
+  "email": "alice@example.com",
+  "emailVerified": false,
+  "createdAt": "2024-11-08T14:40:33Z",
+  "updatedAt": "2024-11-09T14:40:33Z",
+  "deletedAt": "2024-11-09T14:40:33Z",
+  "purgeAt": "2024-12-09T14:40:33Z"
+}`,
+			},
+		},
+		"failure already deleted": {
+			ctx: ctx,
+			setup: func(t *testing.T) *handler.UserHandler {
+				mck := new(MockUserInteractor)
+				mck.
+					On("DeleteMe", ctx, "sub1").
+					Return(entity.User{}, apperr.New("delete user but user is already deleted", "User is already deleted", apperr.CodeFailedPrecondition))
+				return &handler.UserHandler{UserInteractor: mck}
+			},
+			want: want{
+				status: http.StatusBadRequest,
+				body:   `{"code":"failedPrecondition","detail":"User is already deleted","message":"User is already deleted","status":400,"title":"Bad Request","type":"about:blank"}`,
+			},
+		},
+		"failure missing subject from context": {
+			ctx:   context.Background(),
+			setup: func(t *testing.T) *handler.UserHandler { return new(handler.UserHandler) },
+			want: want{
+				status: http.StatusForbidden,
+				body:   `{"code":"unauthorized","detail":"Authorization failure","message":"Authorization failure","status":403,"title":"Forbidden","type":"about:blank"}`,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := tc.setup(t)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(tc.ctx, http.MethodDelete, "/users/me", nil)
+
+			h.DeleteMe(w, r)
+
+			assert.Equal(t, tc.want.status, w.Code)
+			assert.JSONEq(t, tc.want.body, w.Body.String())
+		})
+	}
+}
+
+func TestUserHandler_RestoreMe(t *testing.T) {
+	ctx := ctxhelper.WithSubject(context.Background(), "sub1")
+	type want struct {
+		status int
+		body   string
+	}
+	tests := map[string]struct {
+		ctx   context.Context
+		setup func(*testing.T) *handler.UserHandler
+		want  want
+	}{
+		"success": {
+			ctx: ctx,
+			setup: func(t *testing.T) *handler.UserHandler {
+				mck := new(MockUserInteractor)
+				mck.
+					On("RestoreMe", ctx, "sub1").
+					Return(entity.User{
+						ID:         testhelper.UUIDFromString(t, "0193196b-28c4-7337-a891-e728860339cd"),
+						Sub:        "sub1",
+						GivenName:  "Alice",
+						FamilyName: "Kozey",
+						Email:      "alice@example.com",
+						CreatedAt:  time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC),
+						UpdatedAt:  time.Date(2024, 11, 10, 14, 40, 33, 0, time.UTC),
+						Role:       entity.RoleUser,
+					}, nil)
+				return &handler.UserHandler{UserInteractor: mck}
+			},
+			want: want{
+				status: http.StatusOK,
+				body: `{
+  "id": "0193196b-28c4-7337-a891-e728860339cd",
+  "sub": "sub1",
+  "role": "user",
+  "givenName": "Alice",
+  "familyName": "Kozey",
+  "email": "alice@example.com",
+  "emailVerified": false,
+  "createdAt": "2024-11-08T14:40:33Z",
+  "updatedAt": "2024-11-10T14:40:33Z"
+}`,
+			},
+		},
+		"failure not deleted": {
+			ctx: ctx,
+			setup: func(t *testing.T) *handler.UserHandler {
+				mck := new(MockUserInteractor)
+				mck.
+					On("RestoreMe", ctx, "sub1").
+					Return(entity.User{}, apperr.New("restore user but user is not deleted", "User is not deleted", apperr.CodeFailedPrecondition))
+				return &handler.UserHandler{UserInteractor: mck}
+			},
+			want: want{
+				status: http.StatusBadRequest,
+				body:   `{"code":"failedPrecondition","detail":"User is not deleted","message":"User is not deleted","status":400,"title":"Bad Request","type":"about:blank"}`,
+			},
+		},
+		"failure missing subject from context": {
+			ctx:   context.Background(),
+			setup: func(t *testing.T) *handler.UserHandler { return new(handler.UserHandler) },
+			want: want{
+				status: http.StatusForbidden,
+				body:   `{"code":"unauthorized","detail":"Authorization failure","message":"Authorization failure","status":403,"title":"Forbidden","type":"about:blank"}`,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := tc.setup(t)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(tc.ctx, http.MethodPost, "/users/me/restore", nil)
+
+			h.RestoreMe(w, r)
+
+			assert.Equal(t, tc.want.status, w.Code)
+			assert.JSONEq(t, tc.want.body, w.Body.String())
+		})
+	}
+}
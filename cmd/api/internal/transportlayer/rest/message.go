@@ -0,0 +1,37 @@
+package rest
+
+import (
+	"errors"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"net/http"
+
+	"golang.org/x/text/language"
+)
+
+// Language returns language of client messages for r.
+// Accept-Language header takes priority over locale preferred by user, and English is used if neither is supported.
+func Language(r *http.Request) language.Tag {
+	preferences := r.Header.Values("Accept-Language")
+	if p, ok := ctxhelper.Preferences(r.Context()); ok {
+		preferences = append(preferences, p.Locale)
+	}
+	return message.Catalog.Language(preferences...)
+}
+
+// Message returns client message of given id in language of r.
+func Message(r *http.Request, id apperr.MessageID, args ...any) string {
+	msg, _ := message.Catalog.Translate(Language(r), id, args...)
+	return msg
+}
+
+// ErrMessage returns client message of err in language of r.
+// Detail of err is never exposed unless err is [apperr.Error].
+func ErrMessage(r *http.Request, err error) string {
+	var appErr *apperr.Error
+	if !errors.As(err, &appErr) {
+		return Message(r, message.InternalServerError)
+	}
+	return message.Catalog.ClientMessage(Language(r), appErr)
+}
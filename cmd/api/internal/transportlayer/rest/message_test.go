@@ -0,0 +1,76 @@
+package rest_test
+
+import (
+	"context"
+	"errors"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/cmd/api/internal/transportlayer/rest"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestLanguage(t *testing.T) {
+	tests := map[string]struct {
+		acceptLanguage string
+		locale         string
+		want           language.Tag
+	}{
+		"default":                                {want: language.English},
+		"accept language":                        {acceptLanguage: "ja,en;q=0.8", want: language.Japanese},
+		"preferred locale":                       {locale: "ja-JP", want: language.Japanese},
+		"accept language over preferred locale":  {acceptLanguage: "en-US", locale: "ja-JP", want: language.English},
+		"preferred locale over unsupported ones": {acceptLanguage: "fr-FR", locale: "ja-JP", want: language.Japanese},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.locale != "" {
+				ctx = ctxhelper.WithPreferences(ctx, ctxhelper.UserPreferences{Locale: tc.locale})
+			}
+			r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/", nil)
+			if tc.acceptLanguage != "" {
+				r.Header.Set("Accept-Language", tc.acceptLanguage)
+			}
+
+			assert.Equal(t, tc.want, rest.Language(r))
+		})
+	}
+}
+
+func TestErrMessage(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want string
+	}{
+		"translated": {
+			err:  apperr.New("find task", "Task not found", apperr.WithMessageID(message.TaskNotFound), apperr.CodeNotFound),
+			want: "タスクが見つかりません",
+		},
+		"translated with args": {
+			err:  apperr.New("patch user", "id can not be updated", apperr.WithMessageID(message.FieldNotUpdatable, "id"), apperr.CodeInvalidArgument),
+			want: "id は更新できません",
+		},
+		"not identified": {
+			err:  apperr.New("validate task", "Content: cannot be blank.", apperr.CodeInvalidArgument),
+			want: "Content: cannot be blank.",
+		},
+		"unhandled error": {
+			err:  errors.New("connection refused"),
+			want: "サーバー内部でエラーが発生しました",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set("Accept-Language", "ja")
+
+			assert.Equal(t, tc.want, rest.ErrMessage(r, tc.err))
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package middleware
+
+import (
+	"context"
+	"go-playground/pkg/ctxhelper"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+const redacted = "REDACTED"
+
+var (
+	// DefaultRedactedHeaders are request headers whose values are redacted in access log by default.
+	DefaultRedactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie", "X-Api-Key"}
+	// DefaultRedactedQueryParams are query parameters whose values are redacted in access log by default.
+	DefaultRedactedQueryParams = []string{"token", "access_token", "id_token", "code", "password", "secret"}
+)
+
+// AccessLogConfig is config of access log.
+type AccessLogConfig struct {
+	// SuccessSampleRate is ratio of logged requests responded with status less than 400, from 0 to 1.
+	// Requests responded with 4xx and 5xx are always logged.
+	SuccessSampleRate float64
+	// RedactedHeaders are request headers whose values are redacted. [DefaultRedactedHeaders] is used if nil.
+	RedactedHeaders []string
+	// RedactedQueryParams are query parameters whose values are redacted. [DefaultRedactedQueryParams] is used if nil.
+	RedactedQueryParams []string
+	// Logger writes access log. [slog.Default] is used if nil.
+	// Id of request is written when handler of logger is wrapped by [ctxhelper.NewLogHandler].
+	Logger *slog.Logger
+}
+
+// NewAccessLog creates middleware which writes an access log per request with method, operationId, status, bytes, latency and subject.
+// Raw path is not written since operationId identifies route with less cardinality and no personal data in path.
+//
+// This middleware must be applied outside of others to log responses by them, and subject is written only if [RecordSubject] is applied inside authentication.
+func NewAccessLog(cfg AccessLogConfig) func(http.Handler) http.Handler {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	redactedHeaders := cfg.RedactedHeaders
+	if redactedHeaders == nil {
+		redactedHeaders = DefaultRedactedHeaders
+	}
+	redactedQueryParams := cfg.RedactedQueryParams
+	if redactedQueryParams == nil {
+		redactedQueryParams = DefaultRedactedQueryParams
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			entry := &accessLogEntry{}
+			aw := &accessLogWriter{ResponseWriter: w}
+			next.ServeHTTP(aw, r.WithContext(context.WithValue(r.Context(), accessLogEntryKey{}, entry)))
+
+			status := aw.status
+			if status == 0 {
+				status = http.StatusOK
+			}
+			if status < http.StatusBadRequest && (cfg.SuccessSampleRate <= 0 || rand.Float64() >= cfg.SuccessSampleRate) {
+				return
+			}
+			level := slog.LevelInfo
+			switch {
+			case status >= http.StatusInternalServerError:
+				level = slog.LevelError
+			case status >= http.StatusBadRequest:
+				level = slog.LevelWarn
+			}
+			attrs := []slog.Attr{
+				slog.String("method", r.Method),
+				slog.String("route", OperationID(r.Context())),
+				slog.Int("status", status),
+				slog.Int64("bytes", aw.bytes),
+				slog.Float64("latencyMs", float64(time.Since(start).Microseconds())/1000),
+				slog.String("subject", entry.subject),
+				slog.String("query", redactQuery(r.URL.Query(), redactedQueryParams)),
+				slog.Any("headers", redactHeader(r.Header, redactedHeaders)),
+			}
+			logger.LogAttrs(r.Context(), level, "access", attrs...)
+		})
+	}
+}
+
+// RecordSubject records subject authenticated by outer middlewares into access log written by [NewAccessLog].
+// This is needed since outer middleware can not read context which inner middlewares put subject on.
+var RecordSubject = func(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		entry, ok := r.Context().Value(accessLogEntryKey{}).(*accessLogEntry)
+		if ok {
+			entry.subject, _ = ctxhelper.Subject(r.Context())
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+type accessLogEntryKey struct{}
+
+type accessLogEntry struct {
+	subject string
+}
+
+// accessLogWriter records status and size of response.
+type accessLogWriter struct {
+	http.ResponseWriter
+	status int
+	bytes  int64
+}
+
+func (w *accessLogWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *accessLogWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += int64(n)
+	return n, err
+}
+
+// Unwrap returns original writer so that [http.ResponseController] can flush response.
+func (w *accessLogWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func redactQuery(query url.Values, names []string) string {
+	for name := range query {
+		if slices.ContainsFunc(names, func(n string) bool { return strings.EqualFold(n, name) }) {
+			query[name] = []string{redacted}
+		}
+	}
+	return query.Encode()
+}
+
+func redactHeader(header http.Header, names []string) map[string]string {
+	m := make(map[string]string, len(header))
+	for name, values := range header {
+		if slices.ContainsFunc(names, func(n string) bool { return strings.EqualFold(n, name) }) {
+			m[name] = redacted
+			continue
+		}
+		m[name] = strings.Join(values, ", ")
+	}
+	return m
+}
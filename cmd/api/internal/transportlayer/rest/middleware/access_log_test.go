@@ -0,0 +1,123 @@
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"go-playground/cmd/api/internal/transportlayer/rest/middleware"
+	"go-playground/cmd/api/internal/transportlayer/rest/oapi"
+	"go-playground/pkg/ctxhelper"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccessLog(t *testing.T) {
+	type record struct {
+		Level     string            `json:"level"`
+		Msg       string            `json:"msg"`
+		Method    string            `json:"method"`
+		Route     string            `json:"route"`
+		Status    int               `json:"status"`
+		Bytes     int64             `json:"bytes"`
+		LatencyMs *float64          `json:"latencyMs"`
+		Subject   string            `json:"subject"`
+		Query     string            `json:"query"`
+		Headers   map[string]string `json:"headers"`
+		RequestID string            `json:"requestID"`
+	}
+	tests := map[string]struct {
+		sampleRate float64
+		target     string
+		header     http.Header
+		handler    http.HandlerFunc
+		want       *record
+	}{
+		"success": {
+			sampleRate: 1,
+			target:     "/tasks?limit=10",
+			header:     http.Header{"Accept-Language": {"ja"}},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"items":[]}`))
+			},
+			want: &record{
+				Level: "INFO", Msg: "access", Method: http.MethodGet, Route: "ListTasks", Status: http.StatusOK, Bytes: 12,
+				Subject: "user1", Query: "limit=10", Headers: map[string]string{"Accept-Language": "ja"}, RequestID: "req-1",
+			},
+		},
+		"success is not sampled": {
+			target: "/tasks",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			},
+		},
+		"client error is always logged": {
+			target: "/tasks",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "not found", http.StatusNotFound)
+			},
+			want: &record{
+				Level: "WARN", Msg: "access", Method: http.MethodGet, Route: "ListTasks", Status: http.StatusNotFound, Bytes: 10,
+				Subject: "user1", Headers: map[string]string{}, RequestID: "req-1",
+			},
+		},
+		"server error is always logged": {
+			target: "/tasks",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			want: &record{
+				Level: "ERROR", Msg: "access", Method: http.MethodGet, Route: "ListTasks", Status: http.StatusInternalServerError,
+				Subject: "user1", Headers: map[string]string{}, RequestID: "req-1",
+			},
+		},
+		"sensitive header and query are redacted": {
+			sampleRate: 1,
+			target:     "/tasks?access_token=secret&limit=10",
+			header:     http.Header{"Authorization": {"Bearer secret"}, "Cookie": {"session=secret"}, "Accept": {"application/json"}},
+			handler:    func(w http.ResponseWriter, r *http.Request) {},
+			want: &record{
+				Level: "INFO", Msg: "access", Method: http.MethodGet, Route: "ListTasks", Status: http.StatusOK,
+				Subject: "user1", Query: "access_token=REDACTED&limit=10",
+				Headers:   map[string]string{"Authorization": "REDACTED", "Cookie": "REDACTED", "Accept": "application/json"},
+				RequestID: "req-1",
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			buf := bytes.NewBuffer(nil)
+			accessLog := middleware.NewAccessLog(middleware.AccessLogConfig{
+				SuccessSampleRate: tc.sampleRate,
+				Logger:            slog.New(ctxhelper.NewLogHandler(slog.NewJSONHandler(buf, nil))),
+			})
+			authenticate := func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					next.ServeHTTP(w, r.WithContext(ctxhelper.WithSubject(r.Context(), "user1")))
+				})
+			}
+			router := middleware.OperationRouter{ServeMux: http.NewServeMux(), OperationIDs: oapi.OperationIDs}
+			router.HandleFunc("GET /tasks", accessLog(authenticate(middleware.RecordSubject(tc.handler))).ServeHTTP)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(ctxhelper.WithRequestID(t.Context(), "req-1"), http.MethodGet, tc.target, nil)
+			for k, v := range tc.header {
+				r.Header[k] = v
+			}
+
+			router.ServeHTTP(w, r)
+
+			if tc.want == nil {
+				assert.Empty(t, buf.String())
+				return
+			}
+			var got record
+			require.NoError(t, json.NewDecoder(buf).Decode(&got))
+			require.NotNil(t, got.LatencyMs)
+			got.LatencyMs = nil
+			assert.Equal(t, *tc.want, got)
+		})
+	}
+}
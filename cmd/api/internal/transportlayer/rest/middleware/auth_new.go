@@ -1,9 +1,17 @@
 package middleware
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
 	"net/http"
 	"net/url"
+	"slices"
+	"strings"
 	"time"
 
 	jwtmiddleware "github.com/auth0/go-jwt-middleware/v3"
@@ -11,49 +19,170 @@ import (
 	"github.com/auth0/go-jwt-middleware/v3/validator"
 )
 
+// SupportedAlgorithms are signature algorithms which can be allowed for issuer.
+var SupportedAlgorithms = []validator.SignatureAlgorithm{validator.RS256, validator.ES256, validator.EdDSA}
+
+// IssuerConfig holds configuration to validate access tokens issued by one issuer.
+type IssuerConfig struct {
+	IssuerURL *url.URL
+	// JWKSURL is URL of JWKS. It is discovered from OpenID configuration of issuer if nil.
+	JWKSURL   *url.URL
+	Audiences []string
+	// Algorithms are allowed signature algorithms, which must be [SupportedAlgorithms]. RS256 is allowed if empty.
+	Algorithms []validator.SignatureAlgorithm
+}
+
 // CheckAccessTokenConfig holds configuration for JWT validation middleware.
 type CheckAccessTokenConfig struct {
-	IssuerURL     *url.URL
-	Audiences     []string
-	ExclusionURLs []string
-	HTTPClient    *http.Client
-	CacheTTL      time.Duration
-	Logger        jwtmiddleware.Logger
+	// Issuers are trusted issuers. Access token is validated by the issuer matching its iss claim.
+	// Requests without access token or with access token of unknown issuer are rejected by the first issuer.
+	Issuers    []IssuerConfig
+	HTTPClient *http.Client
+	CacheTTL   time.Duration
+	Logger     jwtmiddleware.Logger
 }
 
 // NewCheckAccessToken creates JWT validation middleware using the official v3 middleware.
-// It initializes the JWKS provider and validator internally, returning an HTTP middleware
-// that validates JWT tokens and skips the specified URLs.
+// It initializes the JWKS provider and validator of each issuer internally, returning an HTTP middleware
+// that validates JWT tokens with validator selected by iss claim.
+// Public operations are not passed to it by [NewRequireCredential].
 func NewCheckAccessToken(cfg CheckAccessTokenConfig) (func(http.Handler) http.Handler, error) {
-	jwksProvider, err := jwks.NewCachingProvider(
-		jwks.WithIssuerURL(cfg.IssuerURL),
+	if len(cfg.Issuers) == 0 {
+		return nil, errors.New("no issuer is configured")
+	}
+	middlewares := make(map[string]*jwtmiddleware.JWTMiddleware, len(cfg.Issuers))
+	for i, issuer := range cfg.Issuers {
+		m, err := newIssuerMiddleware(cfg, issuer)
+		if err != nil {
+			return nil, fmt.Errorf("issuer[%d]: %w", i, err)
+		}
+		iss := issuer.IssuerURL.String()
+		if _, ok := middlewares[iss]; ok {
+			return nil, fmt.Errorf("issuer[%d]: duplicated issuer %s", i, iss)
+		}
+		middlewares[iss] = m
+	}
+	primary := middlewares[cfg.Issuers[0].IssuerURL.String()]
+
+	return func(next http.Handler) http.Handler {
+		fallback := primary.CheckJWT(next)
+		byIssuer := make(map[string]http.Handler, len(middlewares))
+		for iss, m := range middlewares {
+			byIssuer[iss] = m.CheckJWT(next)
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if h, ok := byIssuer[unverifiedIssuer(r)]; ok {
+				h.ServeHTTP(w, r)
+				return
+			}
+			fallback.ServeHTTP(w, r)
+		})
+	}, nil
+}
+
+func newIssuerMiddleware(cfg CheckAccessTokenConfig, issuer IssuerConfig) (*jwtmiddleware.JWTMiddleware, error) {
+	if issuer.IssuerURL == nil {
+		return nil, errors.New("issuer url is missing")
+	}
+	algorithms := issuer.Algorithms
+	if len(algorithms) == 0 {
+		algorithms = []validator.SignatureAlgorithm{validator.RS256}
+	}
+	for _, alg := range algorithms {
+		if !slices.Contains(SupportedAlgorithms, alg) {
+			return nil, fmt.Errorf("unsupported algorithm %s", alg)
+		}
+	}
+
+	providerOpts := []any{
+		jwks.WithIssuerURL(issuer.IssuerURL),
 		jwks.WithCacheTTL(cfg.CacheTTL),
 		jwks.WithCustomClient(cfg.HTTPClient),
-	)
+	}
+	if issuer.JWKSURL != nil {
+		providerOpts = append(providerOpts, jwks.WithCustomJWKSURI(issuer.JWKSURL))
+	}
+	jwksProvider, err := jwks.NewCachingProvider(providerOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("new jwks provider: %w", err)
 	}
 
 	v, err := validator.New(
 		validator.WithKeyFunc(jwksProvider.KeyFunc),
-		validator.WithAlgorithm(validator.RS256),
-		validator.WithIssuer(cfg.IssuerURL.String()),
-		validator.WithAudiences(cfg.Audiences),
+		validator.WithAlgorithms(algorithms),
+		validator.WithIssuer(issuer.IssuerURL.String()),
+		validator.WithAudiences(issuer.Audiences),
+		validator.WithCustomClaims(func() *ctxhelper.Claims { return &ctxhelper.Claims{} }),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("new validator: %w", err)
 	}
 
 	var opts []jwtmiddleware.Option
-	opts = append(opts, jwtmiddleware.WithValidator(v), jwtmiddleware.WithLogger(cfg.Logger))
-	if len(cfg.ExclusionURLs) > 0 {
-		opts = append(opts, jwtmiddleware.WithExclusionUrls(cfg.ExclusionURLs))
-	}
+	opts = append(
+		opts,
+		jwtmiddleware.WithValidator(v),
+		jwtmiddleware.WithLogger(cfg.Logger),
+		jwtmiddleware.WithTokenExtractor(extractToken),
+		jwtmiddleware.WithErrorHandler(writeAccessTokenError),
+	)
 
 	middleware, err := jwtmiddleware.New(opts...)
 	if err != nil {
 		return nil, fmt.Errorf("new jwt middleware: %w", err)
 	}
+	return middleware, nil
+}
+
+// writeAccessTokenError writes error of access token validation in the same manner as other errors.
+func writeAccessTokenError(w http.ResponseWriter, r *http.Request, err error) {
+	// RFC 6750 section 3.1. Error code should not be included if request has no access token.
+	if errors.Is(err, jwtmiddleware.ErrJWTMissing) {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+	} else {
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+	}
+	writeError(w, r, apperr.New("validate access token", "Invalid token", apperr.WithMessageID(message.InvalidToken), apperr.WithCause(err), apperr.CodeUnAuthn))
+}
+
+// unverifiedIssuer returns iss claim of access token without verifying signature, only to select validator.
+// Empty is returned if request has no access token or access token is malformed.
+func unverifiedIssuer(r *http.Request) string {
+	token, err := extractToken(r)
+	if err != nil {
+		return ""
+	}
+	parts := strings.Split(token.Token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return ""
+	}
+	var claims struct {
+		Issuer string `json:"iss"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return ""
+	}
+	return claims.Issuer
+}
+
+// accessTokenParam is query parameter name to carry access token on WebSocket handshake.
+const accessTokenParam = "access_token"
 
-	return middleware.CheckJWT, nil
+// extractToken extracts access token from Authorization header.
+// Browsers can not set Authorization header on WebSocket handshake,
+// so access token in query parameter is accepted only for upgrade requests.
+func extractToken(r *http.Request) (jwtmiddleware.ExtractedToken, error) {
+	token, err := jwtmiddleware.AuthHeaderTokenExtractor(r)
+	if err != nil || token.Token != "" || !isUpgrade(r) {
+		return token, err
+	}
+	param := r.URL.Query().Get(accessTokenParam)
+	if param == "" {
+		return jwtmiddleware.ExtractedToken{}, nil
+	}
+	return jwtmiddleware.ExtractedToken{Token: param, Scheme: jwtmiddleware.AuthSchemeBearer}, nil
 }
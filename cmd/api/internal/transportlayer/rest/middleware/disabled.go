@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"net/http"
+	"slices"
+)
+
+// UserFinder is interface for [usecase.UserUseCase] to find user of authenticated subject.
+type UserFinder interface {
+	FindBySub(ctx context.Context, sub string) (entity.User, error)
+}
+
+type userKey struct{}
+
+// User returns user of authenticated subject put on ctx by [NewRejectDisabledUser].
+func User(ctx context.Context) (entity.User, bool) {
+	user, ok := ctx.Value(userKey{}).(entity.User)
+	return user, ok
+}
+
+// NewRejectDisabledUser creates middleware which rejects requests of disabled user with 403.
+// It must be applied after access token is validated.
+// User is put on context of accepted request, so that following middlewares do not find it again. See [User].
+//
+// Requests of deleted user are rejected with 403 as well unless operation is one of allowedWhileDeleted,
+// so that user can only restore or export account during grace period. Operation is read by [OperationID].
+//
+// Requests without subject or of user not registered yet are passed through so that users can be created.
+func NewRejectDisabledUser(finder UserFinder, allowedWhileDeleted ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			sub, ok := ctxhelper.Subject(ctx)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			user, err := finder.FindBySub(ctx, sub)
+			if apperr.IsCode(err, apperr.CodeNotFound) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if err != nil {
+				writeError(w, r, err)
+				return
+			}
+			if user.Disabled() {
+				writeError(w, r, apperr.New("request by disabled user "+user.ID.String(), "Account is disabled", apperr.WithMessageID(message.AccountDisabled), apperr.CodeUnAuthz))
+				return
+			}
+			if user.Deleted() && !slices.Contains(allowedWhileDeleted, OperationID(ctx)) {
+				writeError(w, r, apperr.New(
+					fmt.Sprintf("request %q by deleted user %s", OperationID(ctx), user.ID),
+					"Account is deleted. Only restoring or exporting it is allowed until it is purged",
+					apperr.WithMessageID(message.AccountDeleted),
+					apperr.CodeUnAuthz,
+				))
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(ctx, userKey{}, user)))
+		})
+	}
+}
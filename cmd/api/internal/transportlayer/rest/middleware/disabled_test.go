@@ -0,0 +1,117 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/transportlayer/rest/middleware"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockUserFinder struct {
+	mock.Mock
+}
+
+func (mck *mockUserFinder) FindBySub(ctx context.Context, sub string) (entity.User, error) {
+	args := mck.Called(ctx, sub)
+	return args.Get(0).(entity.User), args.Error(1)
+}
+
+func TestNewRejectDisabledUser(t *testing.T) {
+	deletedAt := time.Date(2024, 11, 9, 14, 40, 33, 0, time.UTC)
+	type want struct {
+		status int
+		body   string
+		user   entity.User
+	}
+	tests := map[string]struct {
+		ctx   context.Context
+		setup func(*mockUserFinder)
+		want  want
+	}{
+		"pass active user": {
+			ctx: ctxhelper.WithSubject(context.Background(), "sub1"),
+			setup: func(mck *mockUserFinder) {
+				mck.On("FindBySub", mock.Anything, "sub1").Return(entity.User{Sub: "sub1"}, nil)
+			},
+			want: want{status: http.StatusNoContent, user: entity.User{Sub: "sub1"}},
+		},
+		"pass user not registered yet": {
+			ctx: ctxhelper.WithSubject(context.Background(), "sub1"),
+			setup: func(mck *mockUserFinder) {
+				mck.On("FindBySub", mock.Anything, "sub1").Return(entity.User{}, apperr.New("find user by sub", "not found user", apperr.CodeNotFound))
+			},
+			want: want{status: http.StatusNoContent},
+		},
+		"pass unauthenticated request": {
+			ctx:   context.Background(),
+			setup: func(mck *mockUserFinder) {},
+			want:  want{status: http.StatusNoContent},
+		},
+		"reject disabled user": {
+			ctx: ctxhelper.WithSubject(context.Background(), "sub1"),
+			setup: func(mck *mockUserFinder) {
+				mck.On("FindBySub", mock.Anything, "sub1").Return(entity.User{Sub: "sub1", DisabledAt: time.Date(2024, 11, 9, 14, 40, 33, 0, time.UTC)}, nil)
+			},
+			want: want{status: http.StatusForbidden, body: `{"code":"unauthorized","detail":"Account is disabled","message":"Account is disabled","status":403,"title":"Forbidden","type":"about:blank"}`},
+		},
+		"pass deleted user to allowed operation": {
+			ctx: middleware.WithOperationID(ctxhelper.WithSubject(context.Background(), "sub1"), "RestoreMe"),
+			setup: func(mck *mockUserFinder) {
+				mck.On("FindBySub", mock.Anything, "sub1").Return(entity.User{Sub: "sub1", DeletedAt: deletedAt}, nil)
+			},
+			want: want{status: http.StatusNoContent, user: entity.User{Sub: "sub1", DeletedAt: deletedAt}},
+		},
+		"reject deleted user": {
+			ctx: middleware.WithOperationID(ctxhelper.WithSubject(context.Background(), "sub1"), "ListTasks"),
+			setup: func(mck *mockUserFinder) {
+				mck.On("FindBySub", mock.Anything, "sub1").Return(entity.User{Sub: "sub1", DeletedAt: deletedAt}, nil)
+			},
+			want: want{status: http.StatusForbidden, body: `{"code":"unauthorized","detail":"Account is deleted. Only restoring or exporting it is allowed until it is purged","message":"Account is deleted. Only restoring or exporting it is allowed until it is purged","status":403,"title":"Forbidden","type":"about:blank"}`},
+		},
+		"reject deleted user out of operations": {
+			ctx: ctxhelper.WithSubject(context.Background(), "sub1"),
+			setup: func(mck *mockUserFinder) {
+				mck.On("FindBySub", mock.Anything, "sub1").Return(entity.User{Sub: "sub1", DeletedAt: deletedAt}, nil)
+			},
+			want: want{status: http.StatusForbidden, body: `{"code":"unauthorized","detail":"Account is deleted. Only restoring or exporting it is allowed until it is purged","message":"Account is deleted. Only restoring or exporting it is allowed until it is purged","status":403,"title":"Forbidden","type":"about:blank"}`},
+		},
+		"failure to find user": {
+			ctx: ctxhelper.WithSubject(context.Background(), "sub1"),
+			setup: func(mck *mockUserFinder) {
+				mck.On("FindBySub", mock.Anything, "sub1").Return(entity.User{}, errors.New("unexpected"))
+			},
+			want: want{status: http.StatusInternalServerError, body: `{"code":"internalServerError","detail":"Internal server error","message":"Internal server error","status":500,"title":"Internal Server Error","type":"about:blank"}`},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mck := new(mockUserFinder)
+			tc.setup(mck)
+			var got entity.User
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got, _ = middleware.User(r.Context())
+				w.WriteHeader(http.StatusNoContent)
+			})
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(tc.ctx, http.MethodGet, "/users/me", nil)
+
+			middleware.NewRejectDisabledUser(mck, "RestoreMe", "RequestMyExport")(next).ServeHTTP(w, r)
+
+			assert.Equal(t, tc.want.status, w.Code)
+			if tc.want.body != "" {
+				assert.JSONEq(t, tc.want.body, w.Body.String())
+			}
+			assert.Equal(t, tc.want.user, got)
+			mck.AssertExpectations(t)
+		})
+	}
+}
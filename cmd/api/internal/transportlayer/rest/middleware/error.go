@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"errors"
+	"go-playground/cmd/api/internal/transportlayer/rest"
+	"go-playground/pkg/apperr"
+	"log/slog"
+	"net/http"
+)
+
+// writeError writes err as error response in the same manner as handlers.
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	var appErr *apperr.Error
+	if !errors.As(err, &appErr) {
+		slog.ErrorContext(r.Context(), "caught unhandled error", slog.String("error", err.Error()))
+		rest.Err(w, r, apperr.CodeInternal, rest.ErrMessage(r, err))
+		return
+	}
+	slog.LogAttrs(r.Context(), appErr.Level(), appErr.Error(), appErr.Attrs()...)
+	rest.RetryAfter(w, appErr)
+	rest.Err(w, r, appErr.Code(), rest.ErrMessage(r, appErr), appErr.FieldErrors()...)
+}
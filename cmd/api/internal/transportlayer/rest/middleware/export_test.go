@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+)
+
+var (
+	ExtractToken     = extractToken
+	IsUpgrade        = isUpgrade
+	UnverifiedIssuer = unverifiedIssuer
+)
+
+// WithOperationID puts operationId on ctx as [OperationRouter] does.
+func WithOperationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, operationIDKey{}, id)
+}
+
+// WithUser puts user on ctx as [NewRejectDisabledUser] does.
+func WithUser(ctx context.Context, user entity.User) context.Context {
+	return context.WithValue(ctx, userKey{}, user)
+}
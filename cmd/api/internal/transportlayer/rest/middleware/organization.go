@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"context"
+	"go-playground/pkg/ctxhelper"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// OrganizationHeader is request header to choose active organization.
+const OrganizationHeader = "X-Org-ID"
+
+// OrganizationResolver is interface for [usecase.OrganizationUseCase] to decide active organization.
+type OrganizationResolver interface {
+	ActiveOrganization(ctx context.Context, userID uuid.UUID, requested string) (string, error)
+}
+
+// NewResolveOrganization creates middleware which puts active organization of request on context.
+// It must be applied after [NewRejectDisabledUser].
+//
+// Organization is requested by [OrganizationHeader], or by org_id claim of access token if header is absent.
+// Request for organization which user does not belong to is rejected with 403.
+// Requests without user, that is without subject or of user not registered yet, are passed through without active organization.
+func NewResolveOrganization(resolver OrganizationResolver) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			user, ok := User(ctx)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			requested := r.Header.Get(OrganizationHeader)
+			if requested == "" {
+				claims, _ := ctxhelper.CustomClaims(ctx)
+				requested = claims.OrgID
+			}
+			orgID, err := resolver.ActiveOrganization(ctx, user.ID, requested)
+			if err != nil {
+				writeError(w, r, err)
+				return
+			}
+			if orgID != "" {
+				r = r.WithContext(ctxhelper.WithOrganizationID(ctx, orgID))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/transportlayer/rest/middleware"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockOrganizationResolver struct {
+	mock.Mock
+}
+
+func (mck *mockOrganizationResolver) ActiveOrganization(ctx context.Context, userID uuid.UUID, requested string) (string, error) {
+	args := mck.Called(ctx, userID, requested)
+	return args.String(0), args.Error(1)
+}
+
+func TestNewResolveOrganization(t *testing.T) {
+	const orgID = "0194c3a1-0000-7000-8000-000000000001"
+	user := entity.User{ID: uuid.MustParse("0194c3a1-0000-7000-8000-0000000000a1")}
+	type want struct {
+		status int
+		body   string
+		orgID  string
+	}
+	tests := map[string]struct {
+		ctx    context.Context
+		header string
+		setup  func(*mockOrganizationResolver)
+		want   want
+	}{
+		"organization requested by header": {
+			ctx:    middleware.WithUser(ctxhelper.WithClaims(context.Background(), "sub1", ctxhelper.Claims{OrgID: "0194c3a1-0000-7000-8000-000000000002"}, time.Now()), user),
+			header: orgID,
+			setup: func(mck *mockOrganizationResolver) {
+				mck.On("ActiveOrganization", mock.Anything, user.ID, orgID).Return(orgID, nil)
+			},
+			want: want{status: http.StatusNoContent, orgID: orgID},
+		},
+		"organization requested by claim": {
+			ctx: middleware.WithUser(ctxhelper.WithClaims(context.Background(), "sub1", ctxhelper.Claims{OrgID: orgID}, time.Now()), user),
+			setup: func(mck *mockOrganizationResolver) {
+				mck.On("ActiveOrganization", mock.Anything, user.ID, orgID).Return(orgID, nil)
+			},
+			want: want{status: http.StatusNoContent, orgID: orgID},
+		},
+		"organization decided by membership": {
+			ctx: middleware.WithUser(ctxhelper.WithSubject(context.Background(), "sub1"), user),
+			setup: func(mck *mockOrganizationResolver) {
+				mck.On("ActiveOrganization", mock.Anything, user.ID, "").Return(orgID, nil)
+			},
+			want: want{status: http.StatusNoContent, orgID: orgID},
+		},
+		"no organization is active": {
+			ctx: middleware.WithUser(ctxhelper.WithSubject(context.Background(), "sub1"), user),
+			setup: func(mck *mockOrganizationResolver) {
+				mck.On("ActiveOrganization", mock.Anything, user.ID, "").Return("", nil)
+			},
+			want: want{status: http.StatusNoContent},
+		},
+		"pass user not registered yet": {
+			ctx:    ctxhelper.WithSubject(context.Background(), "sub1"),
+			header: orgID,
+			setup:  func(mck *mockOrganizationResolver) {},
+			want:   want{status: http.StatusNoContent},
+		},
+		"pass unauthenticated request": {
+			ctx:    context.Background(),
+			header: orgID,
+			setup:  func(mck *mockOrganizationResolver) {},
+			want:   want{status: http.StatusNoContent},
+		},
+		"reject non-member": {
+			ctx:    middleware.WithUser(ctxhelper.WithSubject(context.Background(), "sub1"), user),
+			header: orgID,
+			setup: func(mck *mockOrganizationResolver) {
+				mck.On("ActiveOrganization", mock.Anything, user.ID, orgID).Return("", apperr.New("not member", "not member of organization", apperr.CodeUnAuthz))
+			},
+			want: want{status: http.StatusForbidden, body: `{"code":"unauthorized","detail":"not member of organization","message":"not member of organization","status":403,"title":"Forbidden","type":"about:blank"}`},
+		},
+		"reject non-member even if cause is not found": {
+			ctx:    middleware.WithUser(ctxhelper.WithSubject(context.Background(), "sub1"), user),
+			header: orgID,
+			setup: func(mck *mockOrganizationResolver) {
+				notFound := apperr.New("find member", "not found member", apperr.CodeNotFound)
+				mck.On("ActiveOrganization", mock.Anything, user.ID, orgID).Return("", apperr.New("not member", "not member of organization", apperr.WithCause(notFound), apperr.CodeUnAuthz))
+			},
+			want: want{status: http.StatusForbidden, body: `{"code":"unauthorized","detail":"not member of organization","message":"not member of organization","status":403,"title":"Forbidden","type":"about:blank"}`},
+		},
+		"failure to resolve organization": {
+			ctx: middleware.WithUser(ctxhelper.WithSubject(context.Background(), "sub1"), user),
+			setup: func(mck *mockOrganizationResolver) {
+				mck.On("ActiveOrganization", mock.Anything, user.ID, "").Return("", errors.New("unexpected"))
+			},
+			want: want{status: http.StatusInternalServerError, body: `{"code":"internalServerError","detail":"Internal server error","message":"Internal server error","status":500,"title":"Internal Server Error","type":"about:blank"}`},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mck := new(mockOrganizationResolver)
+			tc.setup(mck)
+			var gotOrgID string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotOrgID, _ = ctxhelper.OrganizationID(r.Context())
+				w.WriteHeader(http.StatusNoContent)
+			})
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(tc.ctx, http.MethodGet, "/tasks", nil)
+			if tc.header != "" {
+				r.Header.Set(middleware.OrganizationHeader, tc.header)
+			}
+
+			middleware.NewResolveOrganization(mck)(next).ServeHTTP(w, r)
+
+			assert.Equal(t, tc.want.status, w.Code)
+			if tc.want.body != "" {
+				assert.JSONEq(t, tc.want.body, w.Body.String())
+			}
+			assert.Equal(t, tc.want.orgID, gotOrgID)
+			mck.AssertExpectations(t)
+		})
+	}
+}
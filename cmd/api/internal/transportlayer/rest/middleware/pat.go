@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"net/http"
+
+	jwtmiddleware "github.com/auth0/go-jwt-middleware/v3"
+)
+
+// PersonalAccessTokenAuthenticator is interface for [usecase.PersonalAccessTokenUseCase] to authenticate personal access token.
+type PersonalAccessTokenAuthenticator interface {
+	Authenticate(ctx context.Context, raw string) (sub string, scopes []string, err error)
+}
+
+// NewCheckPersonalAccessToken creates middleware which authenticates request with personal access token in Authorization header.
+// Requests with other bearer token or without token are delegated to checkJWT.
+//
+// Subject and scopes of authenticated token are put into context as well as JWT,
+// so that [ctxhelper.Subject] and [ctxhelper.Scopes] work regardless of credential.
+func NewCheckPersonalAccessToken(authenticator PersonalAccessTokenAuthenticator, checkJWT func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		jwtNext := checkJWT(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, err := jwtmiddleware.AuthHeaderTokenExtractor(r)
+			if err != nil || !entity.IsPersonalAccessToken(token.Token) {
+				jwtNext.ServeHTTP(w, r)
+				return
+			}
+			sub, scopes, err := authenticator.Authenticate(r.Context(), token.Token)
+			if err != nil {
+				if apperr.IsCode(err, apperr.CodeUnAuthn) {
+					// RFC 6750 section 3.1
+					w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+				}
+				writeError(w, r, err)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(ctxhelper.WithScopes(r.Context(), sub, scopes)))
+		})
+	}
+}
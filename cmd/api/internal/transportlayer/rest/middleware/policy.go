@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/transportlayer/rest/oapi"
+	"net/http"
+	"slices"
+)
+
+// NewRequireCredential creates middleware which applies checkCredential to operations according to their security in OpenAPI.
+//
+//   - Operations declaring BearerAuth security require access token, whose required scopes are put on request context by generated code.
+//   - Operations of authOptional, such as [oapi.AuthOptionalOperationIDs], validate credential only if present
+//     and handle requests without credential anonymously. Handler can read subject only when credential is present.
+//   - Other operations without security such as health check are public, and credential is never validated even if present.
+//
+// Operation of request is read by [OperationID].
+func NewRequireCredential(checkCredential func(http.Handler) http.Handler, authOptional ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		checked := checkCredential(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if slices.Contains(authOptional, OperationID(r.Context())) {
+				token, err := extractToken(r)
+				if err == nil && token.Token == "" {
+					next.ServeHTTP(w, r)
+					return
+				}
+				checked.ServeHTTP(w, r)
+				return
+			}
+			if _, ok := r.Context().Value(oapi.BearerAuthScopes).([]string); !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			checked.ServeHTTP(w, r)
+		})
+	}
+}
+
+type operationIDKey struct{}
+
+// OperationID returns OpenAPI operationId of request put by [OperationRouter]. Empty is returned if not found.
+func OperationID(ctx context.Context) string {
+	id, _ := ctx.Value(operationIDKey{}).(string)
+	return id
+}
+
+// OperationRouter is base router of generated server which puts operationId of route on request context.
+//
+// OperationIDs are operationIds keyed by pattern which generated code registers each operation with, such as [oapi.OperationIDs].
+type OperationRouter struct {
+	*http.ServeMux
+	OperationIDs map[string]string
+}
+
+// HandleFunc registers handler for pattern like [http.ServeMux.HandleFunc].
+func (m OperationRouter) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	id := m.OperationIDs[pattern]
+	m.ServeMux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		handler(w, r.WithContext(context.WithValue(r.Context(), operationIDKey{}, id)))
+	})
+}
@@ -0,0 +1,134 @@
+package middleware_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/transportlayer/rest/middleware"
+	"go-playground/cmd/api/internal/transportlayer/rest/oapi"
+	"go-playground/pkg/ctxhelper"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperationRouter(t *testing.T) {
+	router := middleware.OperationRouter{ServeMux: http.NewServeMux(), OperationIDs: map[string]string{"GET /tasks": "ListTasks"}}
+	router.HandleFunc("GET /tasks", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(middleware.OperationID(r.Context())))
+	})
+	router.HandleFunc("GET /unknown", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(middleware.OperationID(r.Context())))
+	})
+	tests := map[string]struct {
+		target string
+		want   string
+	}{
+		"operation":         {target: "/tasks", want: "ListTasks"},
+		"unknown operation": {target: "/unknown"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, tc.target, nil)
+
+			router.ServeHTTP(w, r)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tc.want, w.Body.String())
+		})
+	}
+}
+
+func TestOperationIDs(t *testing.T) {
+	assert.Equal(t, "HealthCheck", oapi.OperationIDs["GET /health"])
+	assert.Equal(t, "GetTask", oapi.OperationIDs["GET /tasks/{taskId}"])
+}
+
+func TestNewRequireCredential(t *testing.T) {
+	// checkCredential accepts only "valid" token and attaches subject.
+	checkCredential := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer valid" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(ctxhelper.WithSubject(r.Context(), "sub1")))
+		})
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sub, _ := ctxhelper.Subject(r.Context())
+		_, _ = w.Write([]byte(sub))
+	})
+	type want struct {
+		status int
+		sub    string
+	}
+	tests := map[string]struct {
+		operationID   string
+		scopes        []string
+		authorization string
+		want          want
+	}{
+		"public without token": {
+			want: want{status: http.StatusOK},
+		},
+		"public ignores token": {
+			authorization: "Bearer invalid",
+			want:          want{status: http.StatusOK},
+		},
+		"required with valid token": {
+			scopes:        []string{"read:tasks"},
+			authorization: "Bearer valid",
+			want:          want{status: http.StatusOK, sub: "sub1"},
+		},
+		"required without token": {
+			scopes: []string{"read:tasks"},
+			want:   want{status: http.StatusUnauthorized},
+		},
+		"required without scopes": {
+			scopes: []string{},
+			want:   want{status: http.StatusUnauthorized},
+		},
+		"optional without token": {
+			operationID: "ListPublicTasks",
+			want:        want{status: http.StatusOK},
+		},
+		"optional with valid token": {
+			operationID:   "ListPublicTasks",
+			authorization: "Bearer valid",
+			want:          want{status: http.StatusOK, sub: "sub1"},
+		},
+		"optional with invalid token": {
+			operationID:   "ListPublicTasks",
+			authorization: "Bearer invalid",
+			want:          want{status: http.StatusUnauthorized},
+		},
+		"optional with malformed authorization": {
+			operationID:   "ListPublicTasks",
+			authorization: "Basic dXNlcjpwYXNz",
+			want:          want{status: http.StatusUnauthorized},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := middleware.WithOperationID(context.Background(), tc.operationID)
+			if tc.scopes != nil {
+				// Generated code puts required scopes on context for operations declaring BearerAuth security.
+				ctx = context.WithValue(ctx, oapi.BearerAuthScopes, tc.scopes)
+			}
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/", nil)
+			if tc.authorization != "" {
+				r.Header.Set("Authorization", tc.authorization)
+			}
+
+			middleware.NewRequireCredential(checkCredential, "ListPublicTasks")(next).ServeHTTP(w, r)
+
+			assert.Equal(t, tc.want.status, w.Code)
+			if tc.want.status == http.StatusOK {
+				assert.Equal(t, tc.want.sub, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/pkg/ctxhelper"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// PreferencesFinder is interface for [usecase.UserPreferencesUseCase] to find preferences of authenticated user.
+type PreferencesFinder interface {
+	FindPreferencesByUserID(ctx context.Context, userID uuid.UUID) (entity.UserPreferences, error)
+}
+
+// NewLoadPreferences creates middleware which puts preferences of user on context.
+// It must be applied after [NewRejectDisabledUser].
+//
+// Requests without user, that is without subject or of user not registered yet, are passed through without preferences.
+func NewLoadPreferences(finder PreferencesFinder) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			user, ok := User(ctx)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			p, err := finder.FindPreferencesByUserID(ctx, user.ID)
+			if err != nil {
+				writeError(w, r, err)
+				return
+			}
+			r = r.WithContext(ctxhelper.WithPreferences(ctx, ctxhelper.UserPreferences{
+				Location:  p.Location(),
+				Locale:    p.Locale,
+				PageSize:  p.PageSize,
+				WeekStart: p.WeekStart,
+			}))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/transportlayer/rest/middleware"
+	"go-playground/pkg/ctxhelper"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockPreferencesFinder struct {
+	mock.Mock
+}
+
+func (mck *mockPreferencesFinder) FindPreferencesByUserID(ctx context.Context, userID uuid.UUID) (entity.UserPreferences, error) {
+	args := mck.Called(ctx, userID)
+	return args.Get(0).(entity.UserPreferences), args.Error(1)
+}
+
+func TestNewLoadPreferences(t *testing.T) {
+	user := entity.User{ID: uuid.MustParse("0194c3a1-0000-7000-8000-0000000000a1")}
+	type want struct {
+		status int
+		body   string
+		prefs  ctxhelper.UserPreferences
+		ok     bool
+	}
+	tests := map[string]struct {
+		ctx   context.Context
+		setup func(*mockPreferencesFinder)
+		want  want
+	}{
+		"load preferences": {
+			ctx: middleware.WithUser(ctxhelper.WithSubject(context.Background(), "sub1"), user),
+			setup: func(mck *mockPreferencesFinder) {
+				mck.On("FindPreferencesByUserID", mock.Anything, user.ID).Return(entity.UserPreferences{
+					Timezone:  "UTC",
+					Locale:    "en-US",
+					PageSize:  50,
+					WeekStart: time.Sunday,
+				}, nil)
+			},
+			want: want{
+				status: http.StatusNoContent,
+				prefs:  ctxhelper.UserPreferences{Location: time.UTC, Locale: "en-US", PageSize: 50, WeekStart: time.Sunday},
+				ok:     true,
+			},
+		},
+		"pass user not registered yet": {
+			ctx:   ctxhelper.WithSubject(context.Background(), "sub1"),
+			setup: func(mck *mockPreferencesFinder) {},
+			want:  want{status: http.StatusNoContent},
+		},
+		"pass unauthenticated request": {
+			ctx:   context.Background(),
+			setup: func(mck *mockPreferencesFinder) {},
+			want:  want{status: http.StatusNoContent},
+		},
+		"failure to find preferences": {
+			ctx: middleware.WithUser(ctxhelper.WithSubject(context.Background(), "sub1"), user),
+			setup: func(mck *mockPreferencesFinder) {
+				mck.On("FindPreferencesByUserID", mock.Anything, user.ID).Return(entity.UserPreferences{}, errors.New("unexpected"))
+			},
+			want: want{status: http.StatusInternalServerError, body: `{"code":"internalServerError","detail":"Internal server error","message":"Internal server error","status":500,"title":"Internal Server Error","type":"about:blank"}`},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mck := new(mockPreferencesFinder)
+			tc.setup(mck)
+			var (
+				got ctxhelper.UserPreferences
+				ok  bool
+			)
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got, ok = ctxhelper.Preferences(r.Context())
+				w.WriteHeader(http.StatusNoContent)
+			})
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(tc.ctx, http.MethodGet, "/tasks", nil)
+
+			middleware.NewLoadPreferences(mck)(next).ServeHTTP(w, r)
+
+			assert.Equal(t, tc.want.status, w.Code)
+			if tc.want.body != "" {
+				assert.JSONEq(t, tc.want.body, w.Body.String())
+			}
+			assert.Equal(t, tc.want.prefs, got)
+			assert.Equal(t, tc.want.ok, ok)
+			mck.AssertExpectations(t)
+		})
+	}
+}
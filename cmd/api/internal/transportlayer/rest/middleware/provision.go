@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// UserProvisioner is interface for [usecase.UserUseCase] to provision user from access token.
+type UserProvisioner interface {
+	ProvisionUser(ctx context.Context, sub string, givenName, familyName string, email string, emailVerified bool, issuedAt time.Time) error
+}
+
+// NewProvisionUser creates middleware which creates or syncs user of authenticated subject from custom claims of access token.
+// It must be applied after access token is validated.
+//
+// Requests with access token without email claim, such as machine to machine token, are passed through.
+// Provisioning is best effort. Failure is logged and request is handled as if user is not provisioned.
+func NewProvisionUser(provisioner UserProvisioner) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			sub, ok := ctxhelper.Subject(ctx)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			claims, ok := ctxhelper.CustomClaims(ctx)
+			if !ok || claims.Email == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			issuedAt, ok := ctxhelper.IssuedAt(ctx)
+			if !ok {
+				issuedAt = time.Now()
+			}
+			err := provisioner.ProvisionUser(ctx, sub, claims.GivenName, claims.FamilyName, claims.Email, claims.EmailVerified, issuedAt)
+			if err != nil {
+				level := slog.LevelError
+				var appErr *apperr.Error
+				if errors.As(err, &appErr) {
+					level = appErr.Level()
+				}
+				slog.Log(ctx, level, "Failed to provision user from access token", slog.String("error", err.Error()))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
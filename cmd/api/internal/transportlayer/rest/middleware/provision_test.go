@@ -0,0 +1,72 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"go-playground/cmd/api/internal/transportlayer/rest/middleware"
+	"go-playground/pkg/ctxhelper"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockUserProvisioner struct {
+	mock.Mock
+}
+
+func (mck *mockUserProvisioner) ProvisionUser(ctx context.Context, sub string, givenName, familyName string, email string, emailVerified bool, issuedAt time.Time) error {
+	args := mck.Called(ctx, sub, givenName, familyName, email, emailVerified, issuedAt)
+	return args.Error(0)
+}
+
+func TestNewProvisionUser(t *testing.T) {
+	iat := time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC)
+	claims := ctxhelper.Claims{GivenName: "Alice", FamilyName: "Kozey", Email: "alice@example.com", EmailVerified: true}
+	tests := map[string]struct {
+		ctx   context.Context
+		setup func(*mockUserProvisioner)
+	}{
+		"provision user from custom claims": {
+			ctx: ctxhelper.WithClaims(context.Background(), "sub1", claims, iat),
+			setup: func(mck *mockUserProvisioner) {
+				mck.On("ProvisionUser", mock.Anything, "sub1", "Alice", "Kozey", "alice@example.com", true, mock.MatchedBy(iat.Equal)).Return(nil).Once()
+			},
+		},
+		"request is handled even if provisioning fails": {
+			ctx: ctxhelper.WithClaims(context.Background(), "sub1", claims, iat),
+			setup: func(mck *mockUserProvisioner) {
+				mck.On("ProvisionUser", mock.Anything, "sub1", "Alice", "Kozey", "alice@example.com", true, mock.Anything).Return(errors.New("unexpected")).Once()
+			},
+		},
+		"skip token without email claim": {
+			ctx:   ctxhelper.WithClaims(context.Background(), "sub1", ctxhelper.Claims{}, iat),
+			setup: func(mck *mockUserProvisioner) {},
+		},
+		"skip token without custom claims": {
+			ctx:   ctxhelper.WithSubject(context.Background(), "sub1"),
+			setup: func(mck *mockUserProvisioner) {},
+		},
+		"skip unauthenticated request": {
+			ctx:   context.Background(),
+			setup: func(mck *mockUserProvisioner) {},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mck := new(mockUserProvisioner)
+			tc.setup(mck)
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusNoContent) })
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(tc.ctx, http.MethodGet, "/users/me", nil)
+
+			middleware.NewProvisionUser(mck)(next).ServeHTTP(w, r)
+
+			assert.Equal(t, http.StatusNoContent, w.Code)
+			mck.AssertExpectations(t)
+		})
+	}
+}
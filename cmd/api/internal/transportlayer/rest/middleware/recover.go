@@ -1,9 +1,12 @@
 package middleware
 
 import (
+	"go-playground/cmd/api/internal/message"
 	"go-playground/cmd/api/internal/transportlayer/rest"
+	"go-playground/pkg/apperr"
 	"log/slog"
 	"net/http"
+	"strings"
 )
 
 const (
@@ -26,15 +29,24 @@ var Recover = func(next http.Handler) http.Handler {
 					"Panic was happened. So check detail as soon as possible the reason why happened panic.",
 					slog.Any("error", err),
 				)
-				if r.Header.Get(connectionHeader) != connectionHeaderValue {
-					rest.Err(
-						w,
-						"Unexpected error was happened. Please report this error you have checked.",
-						http.StatusInternalServerError,
-					)
+				if !isUpgrade(r) {
+					rest.Err(w, r, apperr.CodeInternal, rest.Message(r, message.InternalPanic))
 				}
 			}
 		}()
 		next.ServeHTTP(w, r)
 	})
 }
+
+// isUpgrade reports whether r is protocol upgrade request such as WebSocket handshake.
+// Connection header may hold multiple tokens(e.g. "keep-alive, Upgrade").
+func isUpgrade(r *http.Request) bool {
+	for _, v := range r.Header.Values(connectionHeader) {
+		for token := range strings.SplitSeq(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(token), connectionHeaderValue) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -17,8 +17,8 @@ func Test_Recover(t *testing.T) {
 	r := httptest.NewRequest(http.MethodGet, "https://example.com/panic", nil)
 	middleware.Recover(handler).ServeHTTP(w, r)
 	require.Equal(t, 500, w.Code)
-	require.Equal(t, "application/json", w.Header().Get("Content-Type"))
-	require.JSONEq(t, `{"message":"Unexpected error was happened. Please report this error you have checked."}`, w.Body.String())
+	require.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+	require.JSONEq(t, `{"code":"internalServerError","detail":"Unexpected error was happened. Please report this error you have checked.","message":"Unexpected error was happened. Please report this error you have checked.","status":500,"title":"Internal Server Error","type":"about:blank"}`, w.Body.String())
 }
 
 func Test_Recover_PanicWithErrAbortHandler(t *testing.T) {
@@ -33,3 +33,38 @@ func Test_Recover_PanicWithErrAbortHandler(t *testing.T) {
 	}()
 	middleware.Recover(handler).ServeHTTP(w, r)
 }
+
+func Test_Recover_UpgradeRequest(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("always panic")
+	})
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "https://example.com/ws", nil)
+	r.Header.Set("Connection", "Upgrade")
+	middleware.Recover(handler).ServeHTTP(w, r)
+	require.Empty(t, w.Body.String())
+}
+
+func TestIsUpgrade(t *testing.T) {
+	tests := map[string]struct {
+		connection []string
+		want       bool
+	}{
+		"upgrade":                     {connection: []string{"Upgrade"}, want: true},
+		"lower case upgrade":          {connection: []string{"upgrade"}, want: true},
+		"upgrade with other tokens":   {connection: []string{"keep-alive, Upgrade"}, want: true},
+		"upgrade in multiple headers": {connection: []string{"keep-alive", "Upgrade"}, want: true},
+		"keep-alive":                  {connection: []string{"keep-alive"}},
+		"no connection header":        {},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "https://example.com/ws", nil)
+			for _, v := range tc.connection {
+				r.Header.Add("Connection", v)
+			}
+
+			require.Equal(t, tc.want, middleware.IsUpgrade(r))
+		})
+	}
+}
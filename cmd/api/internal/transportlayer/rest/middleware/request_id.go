@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"go-playground/pkg/ctxhelper"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// RequestIDHeader is header which identifies request. It is echoed in response.
+	RequestIDHeader = "X-Request-ID"
+	// TraceParentHeader is header of W3C Trace Context.
+	TraceParentHeader = "traceparent"
+
+	maxRequestIDLength = 128
+)
+
+// RequestID identifies request by X-Request-ID header, trace id of W3C traceparent header or generated id in this order,
+// and attaches the id into context so that it is written in logs and error responses. The id is echoed by X-Request-ID header of response.
+// traceparent is also attached into context to be propagated by [PropagateRequestID], and it is generated if not given.
+var RequestID = func(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceParent := r.Header.Get(TraceParentHeader)
+		traceID, _, ok := parseTraceParent(traceParent)
+		if !ok {
+			traceParent = newTraceParent(randomHex(16), "00")
+		}
+		id := r.Header.Get(RequestIDHeader)
+		if !validRequestID(id) {
+			if ok {
+				id = traceID
+			} else {
+				id = newRequestID()
+			}
+		}
+		w.Header().Set(RequestIDHeader, id)
+		ctx := ctxhelper.WithTraceParent(ctxhelper.WithRequestID(r.Context(), id), traceParent)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// PropagateRequestID forwards id and traceparent of incoming request in context on outgoing request.
+// traceparent is forwarded with new parent id, since this service is parent of outgoing request.
+func PropagateRequestID(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		id, hasID := ctxhelper.RequestID(r.Context())
+		traceParent, _ := ctxhelper.TraceParent(r.Context())
+		traceID, flags, hasTraceParent := parseTraceParent(traceParent)
+		if !hasID && !hasTraceParent {
+			return next.RoundTrip(r)
+		}
+		// RoundTripper must not modify given request.
+		r = r.Clone(r.Context())
+		if hasID {
+			r.Header.Set(RequestIDHeader, id)
+		}
+		if hasTraceParent {
+			r.Header.Set(TraceParentHeader, newTraceParent(traceID, flags))
+		}
+		return next.RoundTrip(r)
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return fn(r)
+}
+
+// validRequestID reports whether id given by client is safe to be written in logs and headers.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := range len(id) {
+		// Visible ASCII characters only.
+		if id[i] < '!' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// parseTraceParent parses traceparent of version 00 such as "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01".
+// Trace id and parent id of all zeros are invalid.
+func parseTraceParent(v string) (traceID, flags string, ok bool) {
+	parts := strings.Split(v, "-")
+	if len(parts) != 4 || parts[0] != "00" {
+		return "", "", false
+	}
+	traceID, parentID, flags := parts[1], parts[2], parts[3]
+	if !isLowerHex(traceID, 32) || !isLowerHex(parentID, 16) || !isLowerHex(flags, 2) {
+		return "", "", false
+	}
+	if strings.Trim(traceID, "0") == "" || strings.Trim(parentID, "0") == "" {
+		return "", "", false
+	}
+	return traceID, flags, true
+}
+
+// newTraceParent creates traceparent of given trace with new parent id.
+func newTraceParent(traceID, flags string) string {
+	return "00-" + traceID + "-" + randomHex(8) + "-" + flags
+}
+
+func isLowerHex(s string, n int) bool {
+	if len(s) != n {
+		return false
+	}
+	for i := range len(s) {
+		if (s[i] < '0' || s[i] > '9') && (s[i] < 'a' || s[i] > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// newRequestID generates id ordered by time, which falls back to random one if time based one fails.
+func newRequestID() string {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return uuid.NewString()
+	}
+	return id.String()
+}
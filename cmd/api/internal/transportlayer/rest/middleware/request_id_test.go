@@ -0,0 +1,154 @@
+package middleware_test
+
+import (
+	"go-playground/cmd/api/internal/transportlayer/rest/middleware"
+	"go-playground/pkg/ctxhelper"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestID(t *testing.T) {
+	const traceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	uuidPattern := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-7[0-9a-f]{3}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+	generatedTraceParent := regexp.MustCompile(`^00-[0-9a-f]{32}-[0-9a-f]{16}-00$`)
+	tests := map[string]struct {
+		requestID       string
+		traceParent     string
+		wantID          func(t *testing.T, id string)
+		wantTraceParent func(t *testing.T, traceParent string)
+	}{
+		"request id is given": {
+			requestID:   "req-1",
+			traceParent: traceParent,
+			wantID:      func(t *testing.T, id string) { assert.Equal(t, "req-1", id) },
+			wantTraceParent: func(t *testing.T, got string) {
+				assert.Equal(t, traceParent, got)
+			},
+		},
+		"trace id is used without request id": {
+			traceParent: traceParent,
+			wantID:      func(t *testing.T, id string) { assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", id) },
+			wantTraceParent: func(t *testing.T, got string) {
+				assert.Equal(t, traceParent, got)
+			},
+		},
+		"generated": {
+			wantID: func(t *testing.T, id string) { assert.Regexp(t, uuidPattern, id) },
+			wantTraceParent: func(t *testing.T, got string) {
+				assert.Regexp(t, generatedTraceParent, got)
+			},
+		},
+		"invalid request id and traceparent are replaced": {
+			requestID:   "req\n1",
+			traceParent: "00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+			wantID:      func(t *testing.T, id string) { assert.Regexp(t, uuidPattern, id) },
+			wantTraceParent: func(t *testing.T, got string) {
+				assert.Regexp(t, generatedTraceParent, got)
+			},
+		},
+		"too long request id is replaced": {
+			requestID: strings.Repeat("a", 129),
+			wantID:    func(t *testing.T, id string) { assert.Regexp(t, uuidPattern, id) },
+			wantTraceParent: func(t *testing.T, got string) {
+				assert.Regexp(t, generatedTraceParent, got)
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var (
+				gotID, gotTraceParent string
+				identified, traced    bool
+			)
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotID, identified = ctxhelper.RequestID(r.Context())
+				gotTraceParent, traced = ctxhelper.TraceParent(r.Context())
+			})
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/tasks", nil)
+			if tc.requestID != "" {
+				r.Header.Set("X-Request-ID", tc.requestID)
+			}
+			if tc.traceParent != "" {
+				r.Header.Set("traceparent", tc.traceParent)
+			}
+
+			middleware.RequestID(next).ServeHTTP(w, r)
+
+			require.True(t, identified)
+			require.True(t, traced)
+			tc.wantID(t, gotID)
+			tc.wantTraceParent(t, gotTraceParent)
+			assert.Equal(t, gotID, w.Header().Get("X-Request-ID"))
+		})
+	}
+}
+
+func TestRequestID_ProblemInstance(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("always panic")
+	})
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/tasks", nil)
+	r.Header.Set("X-Request-ID", "req-1")
+
+	middleware.RequestID(middleware.Recover(next)).ServeHTTP(w, r)
+
+	assert.Equal(t, "req-1", w.Header().Get("X-Request-ID"))
+	assert.JSONEq(t, `{"code":"internalServerError","detail":"Unexpected error was happened. Please report this error you have checked.","instance":"req-1","message":"Unexpected error was happened. Please report this error you have checked.","status":500,"title":"Internal Server Error","type":"about:blank"}`, w.Body.String())
+}
+
+func TestPropagateRequestID(t *testing.T) {
+	tests := map[string]struct {
+		requestID       string
+		traceParent     string
+		wantID          string
+		wantTraceParent *regexp.Regexp
+	}{
+		"propagated": {
+			requestID:       "req-1",
+			traceParent:     "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			wantID:          "req-1",
+			wantTraceParent: regexp.MustCompile(`^00-4bf92f3577b34da6a3ce929d0e0e4736-[0-9a-f]{16}-01$`),
+		},
+		"not identified": {},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got *http.Request
+			transport := middleware.PropagateRequestID(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				got = r
+				return &http.Response{StatusCode: http.StatusOK}, nil
+			}))
+			ctx := t.Context()
+			if tc.requestID != "" {
+				ctx = ctxhelper.WithTraceParent(ctxhelper.WithRequestID(ctx, tc.requestID), tc.traceParent)
+			}
+			r := httptest.NewRequestWithContext(ctx, http.MethodGet, "https://idp.example.com/.well-known/jwks.json", nil)
+
+			_, err := transport.RoundTrip(r)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantID, got.Header.Get("X-Request-ID"))
+			if tc.wantTraceParent != nil {
+				assert.Regexp(t, tc.wantTraceParent, got.Header.Get("traceparent"))
+				assert.NotEqual(t, tc.traceParent, got.Header.Get("traceparent"))
+			} else {
+				assert.Empty(t, got.Header.Get("traceparent"))
+			}
+			assert.Empty(t, r.Header, "given request must not be modified")
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return fn(r)
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/cmd/api/internal/transportlayer/rest/oapi"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// RequireScopes rejects request if access token is not granted all scopes required by operation.
+// Required scopes are declared by security of each operation in OpenAPI and put on request context by generated code.
+// It must be applied after access token is validated.
+// Anonymous request is passed through since it reaches here only for public or auth optional operations. See [NewRequireCredential].
+var RequireScopes = func(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := ctxhelper.Subject(r.Context()); !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		required, _ := r.Context().Value(oapi.BearerAuthScopes).([]string)
+		granted := ctxhelper.Scopes(r.Context())
+		var missing []string
+		for _, scope := range required {
+			if !slices.Contains(granted, scope) {
+				missing = append(missing, scope)
+			}
+		}
+		if len(missing) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+		// RFC 6750 section 3.1
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer error="insufficient_scope", scope="%s"`, strings.Join(required, " ")))
+		writeError(w, r, apperr.New(
+			fmt.Sprintf("access token is not granted scopes %v", missing),
+			"Insufficient scope",
+			apperr.WithMessageID(message.InsufficientScope),
+			apperr.CodeUnAuthz,
+		))
+	})
+}
+
+// NewRequireScopes creates middleware which rejects request if access token is not granted all given scopes like [RequireScopes].
+// This is for routes outside of generated server, such as realtime, whose scopes are not put on request context by generated code.
+func NewRequireScopes(scopes ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		checked := RequireScopes(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			checked.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), oapi.BearerAuthScopes, scopes)))
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package middleware_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/transportlayer/rest/middleware"
+	"go-playground/cmd/api/internal/transportlayer/rest/oapi"
+	"go-playground/pkg/ctxhelper"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRequireScopes(t *testing.T) {
+	type want struct {
+		status          int
+		wwwAuthenticate string
+	}
+	tests := map[string]struct {
+		ctx  context.Context
+		want want
+	}{
+		"granted": {
+			ctx:  ctxhelper.WithClaims(context.Background(), "sub1", ctxhelper.Claims{Scope: "read:tasks"}, time.Now()),
+			want: want{status: http.StatusNoContent},
+		},
+		"insufficient scope": {
+			ctx: ctxhelper.WithClaims(context.Background(), "sub1", ctxhelper.Claims{Scope: "read:users"}, time.Now()),
+			want: want{
+				status:          http.StatusForbidden,
+				wwwAuthenticate: `Bearer error="insufficient_scope", scope="read:tasks"`,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusNoContent) })
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(tc.ctx, http.MethodGet, "/ws", nil)
+
+			middleware.NewRequireScopes("read:tasks")(next).ServeHTTP(w, r)
+
+			assert.Equal(t, tc.want.status, w.Code)
+			assert.Equal(t, tc.want.wwwAuthenticate, w.Header().Get("WWW-Authenticate"))
+		})
+	}
+}
+
+func TestRequireScopes(t *testing.T) {
+	type want struct {
+		status          int
+		wwwAuthenticate string
+		body            string
+	}
+	tests := map[string]struct {
+		ctx  context.Context
+		want want
+	}{
+		"granted by scope claim": {
+			ctx: context.WithValue(
+				ctxhelper.WithClaims(context.Background(), "sub1", ctxhelper.Claims{Scope: "openid read:tasks write:tasks"}, time.Now()),
+				oapi.BearerAuthScopes, []string{"read:tasks", "write:tasks"},
+			),
+			want: want{status: http.StatusNoContent},
+		},
+		"granted by permissions claim": {
+			ctx: context.WithValue(
+				ctxhelper.WithClaims(context.Background(), "sub1", ctxhelper.Claims{Permissions: []string{"read:tasks"}}, time.Now()),
+				oapi.BearerAuthScopes, []string{"read:tasks"},
+			),
+			want: want{status: http.StatusNoContent},
+		},
+		"operation requires no scope": {
+			ctx:  context.Background(),
+			want: want{status: http.StatusNoContent},
+		},
+		"anonymous request": {
+			ctx:  context.WithValue(context.Background(), oapi.BearerAuthScopes, []string{"read:tasks"}),
+			want: want{status: http.StatusNoContent},
+		},
+		"insufficient scope": {
+			ctx: context.WithValue(
+				ctxhelper.WithClaims(context.Background(), "sub1", ctxhelper.Claims{Scope: "read:tasks"}, time.Now()),
+				oapi.BearerAuthScopes, []string{"read:tasks", "write:tasks"},
+			),
+			want: want{
+				status:          http.StatusForbidden,
+				wwwAuthenticate: `Bearer error="insufficient_scope", scope="read:tasks write:tasks"`,
+				body:            `{"code":"unauthorized","detail":"Insufficient scope","message":"Insufficient scope","status":403,"title":"Forbidden","type":"about:blank"}`,
+			},
+		},
+		"token without custom claims": {
+			ctx: context.WithValue(ctxhelper.WithSubject(context.Background(), "sub1"), oapi.BearerAuthScopes, []string{"read:tasks"}),
+			want: want{
+				status:          http.StatusForbidden,
+				wwwAuthenticate: `Bearer error="insufficient_scope", scope="read:tasks"`,
+				body:            `{"code":"unauthorized","detail":"Insufficient scope","message":"Insufficient scope","status":403,"title":"Forbidden","type":"about:blank"}`,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusNoContent) })
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(tc.ctx, http.MethodGet, "/tasks", nil)
+
+			middleware.RequireScopes(next).ServeHTTP(w, r)
+
+			assert.Equal(t, tc.want.status, w.Code)
+			assert.Equal(t, tc.want.wwwAuthenticate, w.Header().Get("WWW-Authenticate"))
+			if tc.want.body != "" {
+				assert.JSONEq(t, tc.want.body, w.Body.String())
+			}
+		})
+	}
+}
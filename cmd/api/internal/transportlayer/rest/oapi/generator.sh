@@ -4,3 +4,4 @@ BASE_PATH=../../../../../../doc/oas
 
 sh $BASE_PATH/bundle.sh
 oapi-codegen --config=./config.yml $BASE_PATH/openapi.yml
+oapi-codegen --config=./operation.config.yml $BASE_PATH/openapi.yml
@@ -6,6 +6,7 @@
 package oapi
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -15,12 +16,471 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-// Error defines model for Error.
+const (
+	BearerAuthScopes bearerAuthContextKey = "BearerAuth.Scopes"
+)
+
+// Defines values for ErrorCode.
+const (
+	AlreadyExists       ErrorCode = "alreadyExists"
+	Canceled            ErrorCode = "canceled"
+	Conflict            ErrorCode = "conflict"
+	DeadlineExceeded    ErrorCode = "deadlineExceeded"
+	FailedPrecondition  ErrorCode = "failedPrecondition"
+	InternalServerError ErrorCode = "internalServerError"
+	InvalidArgument     ErrorCode = "invalidArgument"
+	Notfound            ErrorCode = "notfound"
+	ResourceExhausted   ErrorCode = "resourceExhausted"
+	Unauthenticated     ErrorCode = "unauthenticated"
+	Unauthorized        ErrorCode = "unauthorized"
+	Unavailable         ErrorCode = "unavailable"
+	Unimplemented       ErrorCode = "unimplemented"
+	Unknown             ErrorCode = "unknown"
+)
+
+// Valid indicates whether the value is a known member of the ErrorCode enum.
+func (e ErrorCode) Valid() bool {
+	switch e {
+	case AlreadyExists:
+		return true
+	case Canceled:
+		return true
+	case Conflict:
+		return true
+	case DeadlineExceeded:
+		return true
+	case FailedPrecondition:
+		return true
+	case InternalServerError:
+		return true
+	case InvalidArgument:
+		return true
+	case Notfound:
+		return true
+	case ResourceExhausted:
+		return true
+	case Unauthenticated:
+		return true
+	case Unauthorized:
+		return true
+	case Unavailable:
+		return true
+	case Unimplemented:
+		return true
+	case Unknown:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrganizationMemberRole.
+const (
+	OrganizationMemberRoleAdmin  OrganizationMemberRole = "admin"
+	OrganizationMemberRoleMember OrganizationMemberRole = "member"
+	OrganizationMemberRoleOwner  OrganizationMemberRole = "owner"
+)
+
+// Valid indicates whether the value is a known member of the OrganizationMemberRole enum.
+func (e OrganizationMemberRole) Valid() bool {
+	switch e {
+	case OrganizationMemberRoleAdmin:
+		return true
+	case OrganizationMemberRoleMember:
+		return true
+	case OrganizationMemberRoleOwner:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for UserRole.
+const (
+	RoleAdmin   UserRole = "admin"
+	RoleSupport UserRole = "support"
+	RoleUser    UserRole = "user"
+)
+
+// Valid indicates whether the value is a known member of the UserRole enum.
+func (e UserRole) Valid() bool {
+	switch e {
+	case RoleAdmin:
+		return true
+	case RoleSupport:
+		return true
+	case RoleUser:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for UserExportStatus.
+const (
+	Failed  UserExportStatus = "failed"
+	Pending UserExportStatus = "pending"
+	Ready   UserExportStatus = "ready"
+)
+
+// Valid indicates whether the value is a known member of the UserExportStatus enum.
+func (e UserExportStatus) Valid() bool {
+	switch e {
+	case Failed:
+		return true
+	case Pending:
+		return true
+	case Ready:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for UserPreferencesWeekStart.
+const (
+	Friday    UserPreferencesWeekStart = "friday"
+	Monday    UserPreferencesWeekStart = "monday"
+	Saturday  UserPreferencesWeekStart = "saturday"
+	Sunday    UserPreferencesWeekStart = "sunday"
+	Thursday  UserPreferencesWeekStart = "thursday"
+	Tuesday   UserPreferencesWeekStart = "tuesday"
+	Wednesday UserPreferencesWeekStart = "wednesday"
+)
+
+// Valid indicates whether the value is a known member of the UserPreferencesWeekStart enum.
+func (e UserPreferencesWeekStart) Valid() bool {
+	switch e {
+	case Friday:
+		return true
+	case Monday:
+		return true
+	case Saturday:
+		return true
+	case Sunday:
+		return true
+	case Thursday:
+		return true
+	case Tuesday:
+		return true
+	case Wednesday:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GroupBy.
+const (
+	GroupByDay  GroupBy = "day"
+	GroupByTask GroupBy = "task"
+	GroupByWeek GroupBy = "week"
+)
+
+// Valid indicates whether the value is a known member of the GroupBy enum.
+func (e GroupBy) Valid() bool {
+	switch e {
+	case GroupByDay:
+		return true
+	case GroupByTask:
+		return true
+	case GroupByWeek:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for Render.
+const (
+	RenderHtml Render = "html"
+)
+
+// Valid indicates whether the value is a known member of the Render enum.
+func (e Render) Valid() bool {
+	switch e {
+	case RenderHtml:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for RequestOrganizationMemberRole.
+const (
+	RequestOrganizationMemberRoleAdmin  RequestOrganizationMemberRole = "admin"
+	RequestOrganizationMemberRoleMember RequestOrganizationMemberRole = "member"
+)
+
+// Valid indicates whether the value is a known member of the RequestOrganizationMemberRole enum.
+func (e RequestOrganizationMemberRole) Valid() bool {
+	switch e {
+	case RequestOrganizationMemberRoleAdmin:
+		return true
+	case RequestOrganizationMemberRoleMember:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for RequestPersonalAccessTokenScopes.
+const (
+	RequestPersonalAccessTokenScopesReadTasks  RequestPersonalAccessTokenScopes = "read:tasks"
+	RequestPersonalAccessTokenScopesReadUsers  RequestPersonalAccessTokenScopes = "read:users"
+	RequestPersonalAccessTokenScopesWriteTasks RequestPersonalAccessTokenScopes = "write:tasks"
+	RequestPersonalAccessTokenScopesWriteUsers RequestPersonalAccessTokenScopes = "write:users"
+)
+
+// Valid indicates whether the value is a known member of the RequestPersonalAccessTokenScopes enum.
+func (e RequestPersonalAccessTokenScopes) Valid() bool {
+	switch e {
+	case RequestPersonalAccessTokenScopesReadTasks:
+		return true
+	case RequestPersonalAccessTokenScopesReadUsers:
+		return true
+	case RequestPersonalAccessTokenScopesWriteTasks:
+		return true
+	case RequestPersonalAccessTokenScopesWriteUsers:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for InviteOrganizationMemberJSONBodyRole.
+const (
+	InviteOrganizationMemberJSONBodyRoleAdmin  InviteOrganizationMemberJSONBodyRole = "admin"
+	InviteOrganizationMemberJSONBodyRoleMember InviteOrganizationMemberJSONBodyRole = "member"
+)
+
+// Valid indicates whether the value is a known member of the InviteOrganizationMemberJSONBodyRole enum.
+func (e InviteOrganizationMemberJSONBodyRole) Valid() bool {
+	switch e {
+	case InviteOrganizationMemberJSONBodyRoleAdmin:
+		return true
+	case InviteOrganizationMemberJSONBodyRoleMember:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetTimeReportParamsGroupBy.
+const (
+	GetTimeReportParamsGroupByDay  GetTimeReportParamsGroupBy = "day"
+	GetTimeReportParamsGroupByTask GetTimeReportParamsGroupBy = "task"
+	GetTimeReportParamsGroupByWeek GetTimeReportParamsGroupBy = "week"
+)
+
+// Valid indicates whether the value is a known member of the GetTimeReportParamsGroupBy enum.
+func (e GetTimeReportParamsGroupBy) Valid() bool {
+	switch e {
+	case GetTimeReportParamsGroupByDay:
+		return true
+	case GetTimeReportParamsGroupByTask:
+		return true
+	case GetTimeReportParamsGroupByWeek:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ListTasksParamsRender.
+const (
+	ListTasksParamsRenderHtml ListTasksParamsRender = "html"
+)
+
+// Valid indicates whether the value is a known member of the ListTasksParamsRender enum.
+func (e ListTasksParamsRender) Valid() bool {
+	switch e {
+	case ListTasksParamsRenderHtml:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetTaskParamsRender.
+const (
+	GetTaskParamsRenderHtml GetTaskParamsRender = "html"
+)
+
+// Valid indicates whether the value is a known member of the GetTaskParamsRender enum.
+func (e GetTaskParamsRender) Valid() bool {
+	switch e {
+	case GetTaskParamsRenderHtml:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ListMyMentionsParamsRender.
+const (
+	ListMyMentionsParamsRenderHtml ListMyMentionsParamsRender = "html"
+)
+
+// Valid indicates whether the value is a known member of the ListMyMentionsParamsRender enum.
+func (e ListMyMentionsParamsRender) Valid() bool {
+	switch e {
+	case ListMyMentionsParamsRenderHtml:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for CreateMyTokenJSONBodyScopes.
+const (
+	CreateMyTokenJSONBodyScopesReadTasks  CreateMyTokenJSONBodyScopes = "read:tasks"
+	CreateMyTokenJSONBodyScopesReadUsers  CreateMyTokenJSONBodyScopes = "read:users"
+	CreateMyTokenJSONBodyScopesWriteTasks CreateMyTokenJSONBodyScopes = "write:tasks"
+	CreateMyTokenJSONBodyScopesWriteUsers CreateMyTokenJSONBodyScopes = "write:users"
+)
+
+// Valid indicates whether the value is a known member of the CreateMyTokenJSONBodyScopes enum.
+func (e CreateMyTokenJSONBodyScopes) Valid() bool {
+	switch e {
+	case CreateMyTokenJSONBodyScopesReadTasks:
+		return true
+	case CreateMyTokenJSONBodyScopesReadUsers:
+		return true
+	case CreateMyTokenJSONBodyScopesWriteTasks:
+		return true
+	case CreateMyTokenJSONBodyScopesWriteUsers:
+		return true
+	default:
+		return false
+	}
+}
+
+// Error Problem details of RFC 9457.
 type Error struct {
-	// Message error message
+	// Code stable identifier of error kind
+	//
+	// Example: notfound
+	Code ErrorCode `json:"code"`
+
+	// Detail client message of the problem in language of request
+	//
+	// Example: Task not found
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors validation failures of each field. Absent unless request has invalid fields.
+	Errors *[]FieldError `json:"errors,omitempty"`
+
+	// Instance id of request where the problem occurred, which is the same as `X-Request-ID` response header. Absent if request is not identified.
+	//
+	// Example: 0d0b5b2e-6b0c-4b8e-9a55-3f0a2f6c7f1d
+	Instance *string `json:"instance,omitempty"`
+
+	// Message same as detail. It is kept for clients reading error message before problem details are introduced.
+	//
+	// Example: Task not found
+	Message string `json:"message"`
+
+	// Status HTTP status code
+	//
+	// Example: 404
+	Status int `json:"status"`
+
+	// Title HTTP status phrase of the problem
+	//
+	// Example: Not Found
+	Title string `json:"title"`
+
+	// Type URI reference identifying problem type. It is always about:blank, so code should be used to tell errors apart.
+	//
+	// Example: about:blank
+	Type string `json:"type"`
+}
+
+// ErrorCode stable identifier of error kind
+//
+// Example: notfound
+type ErrorCode string
+
+// FieldError validation failure of a field of request
+type FieldError struct {
+	// Field path to the field, which is name of body property or parameter. Nested properties and elements are joined by dot.
 	//
-	// Example: error message
+	// Example: scopes.0
+	Field string `json:"field"`
+
+	// Message description of the violation
+	//
+	// Example: cannot be blank
 	Message string `json:"message"`
+
+	// Rule name of violated rule
+	//
+	// Example: required
+	Rule string `json:"rule"`
+}
+
+// Organization defines model for Organization.
+type Organization struct {
+	// CreatedAt Example: 2024-10-12T23:26:52Z
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ID Example: 0194c3a1-6f0e-7c2b-9d1a-0a1b2c3d4e5f
+	ID string `json:"id"`
+
+	// Name Example: Platform team
+	Name string `json:"name"`
+
+	// UpdatedAt Example: 2024-10-12T23:26:52Z
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// OrganizationMember defines model for OrganizationMember.
+type OrganizationMember struct {
+	// CreatedAt When user joined organization.
+	//
+	// Example: 2024-10-12T23:26:52Z
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Role Role of member in organization.
+	// - `owner`: creator of organization. Owner can remove any member.
+	// - `admin`: can invite members and remove members.
+	// - `member`: can work on tasks of organization.
+	Role OrganizationMemberRole `json:"role"`
+
+	// UserID Example: 01928120-055d-7edb-a12a-2d290512266e
+	UserID openapi_types.UUID `json:"userId"`
+}
+
+// OrganizationMemberRole Role of member in organization.
+// - `owner`: creator of organization. Owner can remove any member.
+// - `admin`: can invite members and remove members.
+// - `member`: can work on tasks of organization.
+type OrganizationMemberRole string
+
+// PersonalAccessToken defines model for PersonalAccessToken.
+type PersonalAccessToken struct {
+	// CreatedAt Example: 2024-10-12T23:26:52Z
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpiresAt Example: 2025-10-12T23:26:52Z
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// ID Example: 0193dd96-47aa-755b-806e-0b22d6f1849b
+	ID string `json:"id"`
+
+	// Name Label of token given by user.
+	//
+	// Example: ci
+	Name string `json:"name"`
+
+	// RevokedAt When token is revoked. This is present only for revoked token.
+	//
+	// Example: 2024-10-13T23:26:52Z
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+
+	// Scopes Scopes granted to token.
+	Scopes []string `json:"scopes"`
 }
 
 // Simple defines model for Simple.
@@ -33,216 +493,1655 @@ type Simple struct {
 
 // Task defines model for Task.
 type Task struct {
-	// Content Example: go  shopping
+	// Archived True if task is archived. This is present only for archived tasks, which are listed when include_archived=true is given.
+	//
+	// Example: true
+	Archived *bool `json:"archived,omitempty"`
+
+	// Content Content of task in markdown.
+	//
+	// Example: go  shopping
+	Content string `json:"content"`
+
+	// ContentHTML Sanitized HTML rendered from content. This is present only when render=html is given.
+	//
+	// Example: <p>go  shopping</p>
+	ContentHTML *string `json:"contentHtml,omitempty"`
+
+	// CreatedAt Example: 2024-10-12T23:26:52Z
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ID Example: 01928120-055d-7edb-a12a-2d290512266e
+	ID string `json:"id"`
+
+	// UpdatedAt Example: 2024-10-12T23:26:52Z
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// TaskContent defines model for TaskContent.
+type TaskContent struct {
+	// Content Content of task in markdown. Content must be not blank and at most 10000 characters.
+	//
+	// Users are mentioned by `@` followed by their email, such as `@alice@example.com`.
+	// Only members of the organization who verified the email are mentioned. `@username` is not resolved to user since users have no username.
+	//
+	// Example: go shopping!!
 	Content string `json:"content"`
+}
+
+// TimeEntry defines model for TimeEntry.
+type TimeEntry struct {
+	// CreatedAt Example: 2024-12-02T00:00:00Z
+	CreatedAt time.Time `json:"createdAt"`
+
+	// EndedAt When tracking ended. This is absent while timer is running.
+	//
+	// Example: 2024-12-02T01:30:00Z
+	EndedAt *time.Time `json:"endedAt,omitempty"`
+
+	// ID Example: 0193a5b2-7400-7c1d-8e2f-4b9a1d8e3c02
+	ID string `json:"id"`
+
+	// StartedAt Example: 2024-12-02T00:00:00Z
+	StartedAt time.Time `json:"startedAt"`
 
+	// TaskID Example: 01928120-055d-7edb-a12a-2d290512266e
+	TaskID string `json:"taskId"`
+
+	// UpdatedAt Example: 2024-12-02T01:30:00Z
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// TimeReportItem defines model for TimeReportItem.
+type TimeReportItem struct {
+	// Key Date formatted as YYYY-MM-DD when grouped by day, task id when grouped by task.
+	//
+	// Example: 2024-12-01
+	Key string `json:"key"`
+
+	// Seconds Total tracked time in seconds.
+	//
+	// Example: 5400
+	Seconds int64 `json:"seconds"`
+}
+
+// User defines model for User.
+type User struct {
 	// CreatedAt Example: 2024-10-12T23:26:52Z
 	CreatedAt time.Time `json:"createdAt"`
 
+	// DeletedAt When user requested deletion. This is present only for deleted user, who can be restored until purgeAt.
+	//
+	// Example: 2024-10-12T23:26:52Z
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+
+	// DisabledAt When user is disabled by admin. This is present only for disabled user.
+	//
+	// Example: 2024-10-12T23:26:52Z
+	DisabledAt *time.Time `json:"disabledAt,omitempty"`
+
+	// Email Example: foo@example.com
+	Email         openapi_types.Email `json:"email"`
+	EmailVerified bool                `json:"emailVerified"`
+
+	// FamilyName Example: yamakawa
+	FamilyName string `json:"familyName"`
+
+	// GivenName Example: tetsu
+	GivenName string `json:"givenName"`
+
 	// ID Example: 01928120-055d-7edb-a12a-2d290512266e
 	ID string `json:"id"`
 
+	// PurgeAt When deleted user is purged permanently. This is present only for deleted user.
+	//
+	// Example: 2024-11-11T23:26:52Z
+	PurgeAt *time.Time `json:"purgeAt,omitempty"`
+
+	// Role Role of user. Support and admin can use admin API.
+	//
+	// Example: user
+	Role UserRole `json:"role"`
+
+	// Sub Example: 0194f3ad-6b9b-7ddf-8b7e-c45011862c93
+	Sub string `json:"sub"`
+
+	// UpdatedAt Example: 2024-10-12T23:26:52Z
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// UserRole Role of user. Support and admin can use admin API.
+//
+// Example: user
+type UserRole string
+
+// UserExport defines model for UserExport.
+type UserExport struct {
+	// CreatedAt Example: 2024-10-12T23:26:52Z
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ID Example: 0193dd96-47aa-755b-806e-0b22d6f1849b
+	ID string `json:"id"`
+
+	// Status Export is built asynchronously. It can be downloaded when status is ready.
+	Status UserExportStatus `json:"status"`
+
 	// UpdatedAt Example: 2024-10-12T23:26:52Z
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-// TaskContent defines model for TaskContent.
-type TaskContent struct {
-	// Content Content of task. Content must be not blank.
-	//
-	// Example: go shopping!!
-	Content string `json:"content"`
+// UserExportStatus Export is built asynchronously. It can be downloaded when status is ready.
+type UserExportStatus string
+
+// UserPreferences defines model for UserPreferences.
+type UserPreferences struct {
+	// Locale BCP 47 language tag.
+	//
+	// Example: ja-JP
+	Locale string `json:"locale"`
+
+	// PageSize Page size of lists used when request omits limit.
+	//
+	// Example: 10
+	PageSize int32 `json:"pageSize"`
+
+	// Timezone IANA time zone name in which dates are rendered and computed.
+	//
+	// Example: Asia/Tokyo
+	Timezone string `json:"timezone"`
+
+	// WeekStart First day of week.
+	//
+	// Example: monday
+	WeekStart UserPreferencesWeekStart `json:"weekStart"`
+}
+
+// UserPreferencesWeekStart First day of week.
+//
+// Example: monday
+type UserPreferencesWeekStart string
+
+// ExportID ID of personal data export.
+//
+// Example: 0193dd96-47aa-755b-806e-0b22d6f1849b
+type ExportID = string
+
+// From First date of range inclusive.
+//
+// Example: 2024-12-01
+type From = openapi_types.Date
+
+// GroupBy Key to group tracked time. Key of week is first date of week.
+type GroupBy string
+
+// IncludeArchived If true, archived tasks are listed together with active tasks.
+type IncludeArchived = bool
+
+// Limit pagination limit size. Page size of user preferences is used if omitted.
+type Limit = int32
+
+// Next pagination cursor value.
+//
+// Example: eyJpZCI6MX0K
+type Next = string
+
+// OrganizationID ID of organization.
+//
+// Example: 0194c3a1-6f0e-7c2b-9d1a-0a1b2c3d4e5f
+type OrganizationID = string
+
+// Query Prefix of email, given name or family name. All users are listed if empty.
+//
+// Example: alice
+type Query = string
+
+// Render Rendering format of task content. When html is given, sanitized HTML rendered from markdown content is returned as contentHtml.
+type Render string
+
+// TaskID ID of task.
+//
+// Example: 01928120-055d-7edb-a12a-2d290512266e
+type TaskID = string
+
+// To Last date of range inclusive.
+//
+// Example: 2024-12-31
+type To = openapi_types.Date
+
+// TokenID ID of personal access token.
+//
+// Example: 0193dd96-47aa-755b-806e-0b22d6f1849b
+type TokenID = string
+
+// UserID ID of user.
+//
+// Example: 01928120-055d-7edb-a12a-2d290512266e
+type UserID = openapi_types.UUID
+
+// Response400 Problem details of RFC 9457.
+type Response400 = Error
+
+// Response403 Problem details of RFC 9457.
+type Response403 = Error
+
+// Response404 Problem details of RFC 9457.
+type Response404 = Error
+
+// Response409 Problem details of RFC 9457.
+type Response409 = Error
+
+// Response500 Problem details of RFC 9457.
+type Response500 = Error
+
+// ResponseCreatedPersonalAccessToken defines model for ResponseCreatedPersonalAccessToken.
+type ResponseCreatedPersonalAccessToken struct {
+	// CreatedAt Example: 2024-10-12T23:26:52Z
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpiresAt Example: 2025-10-12T23:26:52Z
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// ID Example: 0193dd96-47aa-755b-806e-0b22d6f1849b
+	ID string `json:"id"`
+
+	// Name Label of token given by user.
+	//
+	// Example: ci
+	Name string `json:"name"`
+
+	// RevokedAt When token is revoked. This is present only for revoked token.
+	//
+	// Example: 2024-10-13T23:26:52Z
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+
+	// Scopes Scopes granted to token.
+	Scopes []string `json:"scopes"`
+
+	// Token Raw token to be sent as `Authorization: Bearer <token>`.
+	//
+	// Example: pat_Yk2x0oPq3Jr8Zf7WnLhM1aVt5cE9uS4dG6bR2iN0kQw
+	Token string `json:"token"`
+}
+
+// ResponseHealthCheck defines model for ResponseHealthCheck.
+type ResponseHealthCheck = Simple
+
+// ResponseOrganization defines model for ResponseOrganization.
+type ResponseOrganization = Organization
+
+// ResponseOrganizationMember defines model for ResponseOrganizationMember.
+type ResponseOrganizationMember = OrganizationMember
+
+// ResponseOrganizationMembers defines model for ResponseOrganizationMembers.
+type ResponseOrganizationMembers struct {
+	Items []OrganizationMember `json:"items"`
+}
+
+// ResponseOrganizations defines model for ResponseOrganizations.
+type ResponseOrganizations struct {
+	Items []Organization `json:"items"`
+}
+
+// ResponsePersonalAccessTokens defines model for ResponsePersonalAccessTokens.
+type ResponsePersonalAccessTokens struct {
+	Items []PersonalAccessToken `json:"items"`
+}
+
+// ResponseTask defines model for ResponseTask.
+type ResponseTask = Task
+
+// ResponseTaskID defines model for ResponseTaskID.
+type ResponseTaskID struct {
+	// ID ID of task.
+	//
+	// Example: 01928120-055d-7edb-a12a-2d290512266e
+	ID string `json:"id"`
+}
+
+// ResponseTasks defines model for ResponseTasks.
+type ResponseTasks struct {
+	// HasNext whether has next items.
+	HasNext bool `json:"hasNext"`
+
+	// Items Items of task
+	Items []Task `json:"items"`
+
+	// Next cursor of next item.
+	//
+	// Example: eyJpZCI6IjAxOTIzM2Y1LTQzYzMtNzk4Yi1iMjRkLWVjYmM3NThhZTVmYiJ9
+	Next string `json:"next"`
+}
+
+// ResponseTimeEntry defines model for ResponseTimeEntry.
+type ResponseTimeEntry = TimeEntry
+
+// ResponseTimeReport defines model for ResponseTimeReport.
+type ResponseTimeReport struct {
+	// Items Items sorted by key.
+	Items []TimeReportItem `json:"items"`
+
+	// Timezone IANA timezone in which day boundaries are computed. This is timezone of user preferences.
+	//
+	// Example: Asia/Tokyo
+	Timezone string `json:"timezone"`
+}
+
+// ResponseUser defines model for ResponseUser.
+type ResponseUser = User
+
+// ResponseUserExport defines model for ResponseUserExport.
+type ResponseUserExport = UserExport
+
+// ResponseUserID defines model for ResponseUserID.
+type ResponseUserID struct {
+	// ID ID of user id
+	//
+	// Example: 01928120-055d-7edb-a12a-2d290512266e
+	ID openapi_types.UUID `json:"id"`
+}
+
+// ResponseUserPreferences defines model for ResponseUserPreferences.
+type ResponseUserPreferences = UserPreferences
+
+// ResponseUsers defines model for ResponseUsers.
+type ResponseUsers struct {
+	// HasNext whether has next items.
+	HasNext bool `json:"hasNext"`
+
+	// Items Items of user
+	Items []User `json:"items"`
+
+	// Next cursor of next item.
+	//
+	// Example: eyJpZCI6IjAxOTMwYzNiLWU4MmItNzAwYS1iNDFhLTZmNThiNWMyYjgxMyJ9
+	Next string `json:"next"`
+}
+
+// RequestEmailVerify defines model for RequestEmailVerify.
+type RequestEmailVerify struct {
+	// Token Verification token in mail.
+	Token string `json:"token"`
+}
+
+// RequestOrganization defines model for RequestOrganization.
+type RequestOrganization struct {
+	// Name Name of organization.
+	//
+	// Example: Platform team
+	Name string `json:"name"`
+}
+
+// RequestOrganizationMember defines model for RequestOrganizationMember.
+type RequestOrganizationMember struct {
+	// Email Verified email of user to invite.
+	//
+	// Example: foo@example.com
+	Email openapi_types.Email `json:"email"`
+
+	// Role Role of invited member. Admin can not be granted by member lower than admin.
+	Role RequestOrganizationMemberRole `json:"role"`
+}
+
+// RequestOrganizationMemberRole Role of invited member. Admin can not be granted by member lower than admin.
+type RequestOrganizationMemberRole string
+
+// RequestPersonalAccessToken defines model for RequestPersonalAccessToken.
+type RequestPersonalAccessToken struct {
+	// ExpiresAt When token expires. It must be within a year.
+	//
+	// Example: 2025-10-12T23:26:52Z
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Name Label of token.
+	//
+	// Example: ci
+	Name string `json:"name"`
+
+	// Scopes Scopes granted to token. They must be granted to credential of this request as well.
+	Scopes []RequestPersonalAccessTokenScopes `json:"scopes"`
+}
+
+// RequestPersonalAccessTokenScopes defines model for RequestPersonalAccessToken.Scopes.
+type RequestPersonalAccessTokenScopes string
+
+// RequestTask defines model for RequestTask.
+type RequestTask = TaskContent
+
+// RequestTimeEntry defines model for RequestTimeEntry.
+type RequestTimeEntry struct {
+	// EndedAt Example: 2024-12-02T01:30:00Z
+	EndedAt time.Time `json:"endedAt"`
+
+	// StartedAt Example: 2024-12-02T00:00:00Z
+	StartedAt time.Time `json:"startedAt"`
+}
+
+// RequestUser defines model for RequestUser.
+type RequestUser struct {
+	// Email user email
+	//
+	// Example: Jonathan74@example.com
+	Email openapi_types.Email `json:"email"`
+
+	// EmailVerified Ignored. New user has unverified email.
+	//
+	// Example: true
+	// Deprecated: Email is verified by POST /users/me/email/verify.
+	EmailVerified *bool `json:"emailVerified,omitempty"`
+
+	// FamilyName user family name
+	//
+	// Example: Kozey
+	FamilyName string `json:"familyName"`
+
+	// GivenName user given name
+	//
+	// Example: Dibbert
+	GivenName string `json:"givenName"`
+}
+
+// RequestUserPatch defines model for RequestUserPatch.
+type RequestUserPatch struct {
+	// Email user email. Changing email resets emailVerified to false.
+	//
+	// Example: Jonathan74@example.com
+	Email *openapi_types.Email `json:"email,omitempty"`
+
+	// FamilyName user family name
+	//
+	// Example: Kozey
+	FamilyName *string `json:"familyName,omitempty"`
+
+	// GivenName user given name
+	//
+	// Example: Dibbert
+	GivenName *string `json:"givenName,omitempty"`
+}
+
+// RequestUserPreferences defines model for RequestUserPreferences.
+type RequestUserPreferences = UserPreferences
+
+// bearerAuthContextKey is the context key for BearerAuth security scheme
+type bearerAuthContextKey string
+
+// SearchUsersParams defines parameters for SearchUsers.
+type SearchUsersParams struct {
+	Query *Query `form:"q,omitempty" json:"q,omitempty"`
+	Next  *Next  `form:"next,omitempty" json:"next,omitempty"`
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// CreateOrganizationJSONBody defines parameters for CreateOrganization.
+type CreateOrganizationJSONBody struct {
+	// Name Name of organization.
+	//
+	// Example: Platform team
+	Name string `json:"name"`
+}
+
+// InviteOrganizationMemberJSONBody defines parameters for InviteOrganizationMember.
+type InviteOrganizationMemberJSONBody struct {
+	// Email Verified email of user to invite.
+	//
+	// Example: foo@example.com
+	Email openapi_types.Email `json:"email"`
+
+	// Role Role of invited member. Admin can not be granted by member lower than admin.
+	Role InviteOrganizationMemberJSONBodyRole `json:"role"`
+}
+
+// InviteOrganizationMemberJSONBodyRole defines parameters for InviteOrganizationMember.
+type InviteOrganizationMemberJSONBodyRole string
+
+// GetTimeReportParams defines parameters for GetTimeReport.
+type GetTimeReportParams struct {
+	From    From                        `form:"from" json:"from"`
+	To      To                          `form:"to" json:"to"`
+	GroupBy *GetTimeReportParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+}
+
+// GetTimeReportParamsGroupBy defines parameters for GetTimeReport.
+type GetTimeReportParamsGroupBy string
+
+// ListTasksParams defines parameters for ListTasks.
+type ListTasksParams struct {
+	Next            *Next                  `form:"next,omitempty" json:"next,omitempty"`
+	Limit           *Limit                 `form:"limit,omitempty" json:"limit,omitempty"`
+	Render          *ListTasksParamsRender `form:"render,omitempty" json:"render,omitempty"`
+	IncludeArchived *IncludeArchived       `form:"include_archived,omitempty" json:"include_archived,omitempty"`
+}
+
+// ListTasksParamsRender defines parameters for ListTasks.
+type ListTasksParamsRender string
+
+// GetTaskParams defines parameters for GetTask.
+type GetTaskParams struct {
+	Render *GetTaskParamsRender `form:"render,omitempty" json:"render,omitempty"`
+}
+
+// GetTaskParamsRender defines parameters for GetTask.
+type GetTaskParamsRender string
+
+// PostTimeEntryJSONBody defines parameters for PostTimeEntry.
+type PostTimeEntryJSONBody struct {
+	// EndedAt Example: 2024-12-02T01:30:00Z
+	EndedAt time.Time `json:"endedAt"`
+
+	// StartedAt Example: 2024-12-02T00:00:00Z
+	StartedAt time.Time `json:"startedAt"`
+}
+
+// PostUserJSONBody defines parameters for PostUser.
+type PostUserJSONBody struct {
+	// Email user email
+	//
+	// Example: Jonathan74@example.com
+	Email openapi_types.Email `json:"email"`
+
+	// EmailVerified Ignored. New user has unverified email.
+	//
+	// Example: true
+	// Deprecated: Email is verified by POST /users/me/email/verify.
+	EmailVerified *bool `json:"emailVerified,omitempty"`
+
+	// FamilyName user family name
+	//
+	// Example: Kozey
+	FamilyName string `json:"familyName"`
+
+	// GivenName user given name
+	//
+	// Example: Dibbert
+	GivenName string `json:"givenName"`
+}
+
+// PatchMeApplicationMergePatchPlusJSONBody defines parameters for PatchMe.
+type PatchMeApplicationMergePatchPlusJSONBody struct {
+	// Email user email. Changing email resets emailVerified to false.
+	//
+	// Example: Jonathan74@example.com
+	Email *openapi_types.Email `json:"email,omitempty"`
+
+	// FamilyName user family name
+	//
+	// Example: Kozey
+	FamilyName *string `json:"familyName,omitempty"`
+
+	// GivenName user given name
+	//
+	// Example: Dibbert
+	GivenName *string `json:"givenName,omitempty"`
+}
+
+// VerifyMyEmailJSONBody defines parameters for VerifyMyEmail.
+type VerifyMyEmailJSONBody struct {
+	// Token Verification token in mail.
+	Token string `json:"token"`
+}
+
+// ListMyMentionsParams defines parameters for ListMyMentions.
+type ListMyMentionsParams struct {
+	Next   *Next                       `form:"next,omitempty" json:"next,omitempty"`
+	Limit  *Limit                      `form:"limit,omitempty" json:"limit,omitempty"`
+	Render *ListMyMentionsParamsRender `form:"render,omitempty" json:"render,omitempty"`
+}
+
+// ListMyMentionsParamsRender defines parameters for ListMyMentions.
+type ListMyMentionsParamsRender string
+
+// CreateMyTokenJSONBody defines parameters for CreateMyToken.
+type CreateMyTokenJSONBody struct {
+	// ExpiresAt When token expires. It must be within a year.
+	//
+	// Example: 2025-10-12T23:26:52Z
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Name Label of token.
+	//
+	// Example: ci
+	Name string `json:"name"`
+
+	// Scopes Scopes granted to token. They must be granted to credential of this request as well.
+	Scopes []CreateMyTokenJSONBodyScopes `json:"scopes"`
+}
+
+// CreateMyTokenJSONBodyScopes defines parameters for CreateMyToken.
+type CreateMyTokenJSONBodyScopes string
+
+// CreateOrganizationJSONRequestBody defines body for CreateOrganization for application/json ContentType.
+type CreateOrganizationJSONRequestBody CreateOrganizationJSONBody
+
+// InviteOrganizationMemberJSONRequestBody defines body for InviteOrganizationMember for application/json ContentType.
+type InviteOrganizationMemberJSONRequestBody InviteOrganizationMemberJSONBody
+
+// PostTaskJSONRequestBody defines body for PostTask for application/json ContentType.
+type PostTaskJSONRequestBody = TaskContent
+
+// PutTaskJSONRequestBody defines body for PutTask for application/json ContentType.
+type PutTaskJSONRequestBody = TaskContent
+
+// PostTimeEntryJSONRequestBody defines body for PostTimeEntry for application/json ContentType.
+type PostTimeEntryJSONRequestBody PostTimeEntryJSONBody
+
+// PostUserJSONRequestBody defines body for PostUser for application/json ContentType.
+type PostUserJSONRequestBody PostUserJSONBody
+
+// PatchMeApplicationMergePatchPlusJSONRequestBody defines body for PatchMe for application/merge-patch+json ContentType.
+type PatchMeApplicationMergePatchPlusJSONRequestBody PatchMeApplicationMergePatchPlusJSONBody
+
+// VerifyMyEmailJSONRequestBody defines body for VerifyMyEmail for application/json ContentType.
+type VerifyMyEmailJSONRequestBody VerifyMyEmailJSONBody
+
+// UpdateMyPreferencesJSONRequestBody defines body for UpdateMyPreferences for application/json ContentType.
+type UpdateMyPreferencesJSONRequestBody = UserPreferences
+
+// CreateMyTokenJSONRequestBody defines body for CreateMyToken for application/json ContentType.
+type CreateMyTokenJSONRequestBody CreateMyTokenJSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// AdminGetTask Get any task
+	// (GET /admin/tasks/{taskId})
+	AdminGetTask(w http.ResponseWriter, r *http.Request, taskID TaskID)
+	// SearchUsers Search users
+	// (GET /admin/users)
+	SearchUsers(w http.ResponseWriter, r *http.Request, params SearchUsersParams)
+	// DisableUser Disable user
+	// (POST /admin/users/{userId}/disable)
+	DisableUser(w http.ResponseWriter, r *http.Request, userID UserID)
+	// EnableUser Enable user
+	// (POST /admin/users/{userId}/enable)
+	EnableUser(w http.ResponseWriter, r *http.Request, userID UserID)
+	// HealthCheck Health check API
+	// (GET /health)
+	HealthCheck(w http.ResponseWriter, r *http.Request)
+	// ListMyOrganizations List own organizations
+	// (GET /organizations)
+	ListMyOrganizations(w http.ResponseWriter, r *http.Request)
+	// CreateOrganization Create organization
+	// (POST /organizations)
+	CreateOrganization(w http.ResponseWriter, r *http.Request)
+	// ListOrganizationMembers List members of organization
+	// (GET /organizations/{organizationId}/members)
+	ListOrganizationMembers(w http.ResponseWriter, r *http.Request, organizationID OrganizationID)
+	// InviteOrganizationMember Invite member to organization
+	// (POST /organizations/{organizationId}/members)
+	InviteOrganizationMember(w http.ResponseWriter, r *http.Request, organizationID OrganizationID)
+	// RemoveOrganizationMember Remove member from organization
+	// (DELETE /organizations/{organizationId}/members/{userId})
+	RemoveOrganizationMember(w http.ResponseWriter, r *http.Request, organizationID OrganizationID, userID UserID)
+	// GetTimeReport Get time report
+	// (GET /reports/time)
+	GetTimeReport(w http.ResponseWriter, r *http.Request, params GetTimeReportParams)
+	// ListTasks List tasks
+	// (GET /tasks)
+	ListTasks(w http.ResponseWriter, r *http.Request, params ListTasksParams)
+	// PostTask Post task
+	// (POST /tasks)
+	PostTask(w http.ResponseWriter, r *http.Request)
+	// GetTask Get task
+	// (GET /tasks/{taskId})
+	GetTask(w http.ResponseWriter, r *http.Request, taskID TaskID, params GetTaskParams)
+	// PutTask Put task
+	// (PUT /tasks/{taskId})
+	PutTask(w http.ResponseWriter, r *http.Request, taskID TaskID)
+	// PostTimeEntry Post time entry
+	// (POST /tasks/{taskId}/time-entries)
+	PostTimeEntry(w http.ResponseWriter, r *http.Request, taskID TaskID)
+	// StartTimer Start timer
+	// (POST /tasks/{taskId}/timer/start)
+	StartTimer(w http.ResponseWriter, r *http.Request, taskID TaskID)
+	// StopTimer Stop timer
+	// (POST /tasks/{taskId}/timer/stop)
+	StopTimer(w http.ResponseWriter, r *http.Request, taskID TaskID)
+	// UnarchiveTask Unarchive task
+	// (POST /tasks/{taskId}/unarchive)
+	UnarchiveTask(w http.ResponseWriter, r *http.Request, taskID TaskID)
+	// PostUser Post user
+	// (POST /users)
+	PostUser(w http.ResponseWriter, r *http.Request)
+	// DeleteMe Delete own account
+	// (DELETE /users/me)
+	DeleteMe(w http.ResponseWriter, r *http.Request)
+	// GetMe Get own info
+	// (GET /users/me)
+	GetMe(w http.ResponseWriter, r *http.Request)
+	// PatchMe Patch own info
+	// (PATCH /users/me)
+	PatchMe(w http.ResponseWriter, r *http.Request)
+	// RequestMyEmailVerification Request verification of own email
+	// (POST /users/me/email/verification)
+	RequestMyEmailVerification(w http.ResponseWriter, r *http.Request)
+	// VerifyMyEmail Verify own email
+	// (POST /users/me/email/verify)
+	VerifyMyEmail(w http.ResponseWriter, r *http.Request)
+	// RequestMyExport Request export of personal data
+	// (POST /users/me/export)
+	RequestMyExport(w http.ResponseWriter, r *http.Request)
+	// GetMyExport Get export of personal data
+	// (GET /users/me/exports/{exportId})
+	GetMyExport(w http.ResponseWriter, r *http.Request, exportID ExportID)
+	// DownloadMyExport Download export of personal data
+	// (GET /users/me/exports/{exportId}/download)
+	DownloadMyExport(w http.ResponseWriter, r *http.Request, exportID ExportID)
+	// ListMyMentions List own mentions
+	// (GET /users/me/mentions)
+	ListMyMentions(w http.ResponseWriter, r *http.Request, params ListMyMentionsParams)
+	// GetMyPreferences Get own preferences
+	// (GET /users/me/preferences)
+	GetMyPreferences(w http.ResponseWriter, r *http.Request)
+	// UpdateMyPreferences Update own preferences
+	// (PUT /users/me/preferences)
+	UpdateMyPreferences(w http.ResponseWriter, r *http.Request)
+	// RestoreMe Restore own account
+	// (POST /users/me/restore)
+	RestoreMe(w http.ResponseWriter, r *http.Request)
+	// ListMyTokens List own personal access tokens
+	// (GET /users/me/tokens)
+	ListMyTokens(w http.ResponseWriter, r *http.Request)
+	// CreateMyToken Create personal access token
+	// (POST /users/me/tokens)
+	CreateMyToken(w http.ResponseWriter, r *http.Request)
+	// RevokeMyToken Revoke personal access token
+	// (DELETE /users/me/tokens/{tokenId})
+	RevokeMyToken(w http.ResponseWriter, r *http.Request, tokenID TokenID)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// AdminGetTask operation middleware
+func (siw *ServerInterfaceWrapper) AdminGetTask(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "taskId" -------------
+	var taskID TaskID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin:tasks"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdminGetTask(w, r, taskID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchUsers operation middleware
+func (siw *ServerInterfaceWrapper) SearchUsers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin:users"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchUsersParams
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "q", r.URL.Query(), &params.Query, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "next", r.URL.Query(), &params.Next, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "next"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "next", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchUsers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DisableUser operation middleware
+func (siw *ServerInterfaceWrapper) DisableUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "userId" -------------
+	var userID UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin:users"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DisableUser(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EnableUser operation middleware
+func (siw *ServerInterfaceWrapper) EnableUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "userId" -------------
+	var userID UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin:users"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EnableUser(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// HealthCheck operation middleware
+func (siw *ServerInterfaceWrapper) HealthCheck(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HealthCheck(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMyOrganizations operation middleware
+func (siw *ServerInterfaceWrapper) ListMyOrganizations(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"read:users"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMyOrganizations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateOrganization operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"write:users"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOrganization(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOrganizationMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganizationMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationID OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", r.PathValue("organizationId"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"read:users"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrganizationMembers(w, r, organizationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// InviteOrganizationMember operation middleware
+func (siw *ServerInterfaceWrapper) InviteOrganizationMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationID OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", r.PathValue("organizationId"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"write:users"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InviteOrganizationMember(w, r, organizationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveOrganizationMember operation middleware
+func (siw *ServerInterfaceWrapper) RemoveOrganizationMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationID OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", r.PathValue("organizationId"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userID UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"write:users"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveOrganizationMember(w, r, organizationID, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTimeReport operation middleware
+func (siw *ServerInterfaceWrapper) GetTimeReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"read:tasks"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTimeReportParams
+
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "from", r.URL.Query(), &params.From, runtime.BindQueryParameterOptions{Type: "string", Format: "date"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		}
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "to", r.URL.Query(), &params.To, runtime.BindQueryParameterOptions{Type: "string", Format: "date"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "group_by", r.URL.Query(), &params.GroupBy, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "group_by"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_by", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTimeReport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTasks operation middleware
+func (siw *ServerInterfaceWrapper) ListTasks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"read:tasks"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTasksParams
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "next", r.URL.Query(), &params.Next, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "next"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "next", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "render" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "render", r.URL.Query(), &params.Render, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "render"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "render", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "include_archived" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "include_archived", r.URL.Query(), &params.IncludeArchived, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "include_archived"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_archived", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTasks(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTask operation middleware
+func (siw *ServerInterfaceWrapper) PostTask(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"write:tasks"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTask(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTask operation middleware
+func (siw *ServerInterfaceWrapper) GetTask(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "taskId" -------------
+	var taskID TaskID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"read:tasks"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskParams
+
+	// ------------- Optional query parameter "render" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "render", r.URL.Query(), &params.Render, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "render"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "render", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTask(w, r, taskID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutTask operation middleware
+func (siw *ServerInterfaceWrapper) PutTask(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "taskId" -------------
+	var taskID TaskID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"write:tasks"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutTask(w, r, taskID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) PostTimeEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "taskId" -------------
+	var taskID TaskID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"write:tasks"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTimeEntry(w, r, taskID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartTimer operation middleware
+func (siw *ServerInterfaceWrapper) StartTimer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "taskId" -------------
+	var taskID TaskID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"write:tasks"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartTimer(w, r, taskID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StopTimer operation middleware
+func (siw *ServerInterfaceWrapper) StopTimer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "taskId" -------------
+	var taskID TaskID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"write:tasks"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StopTimer(w, r, taskID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UnarchiveTask operation middleware
+func (siw *ServerInterfaceWrapper) UnarchiveTask(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "taskId" -------------
+	var taskID TaskID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"write:tasks"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnarchiveTask(w, r, taskID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUser operation middleware
+func (siw *ServerInterfaceWrapper) PostUser(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"write:users"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteMe operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMe(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"write:users"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMe operation middleware
+func (siw *ServerInterfaceWrapper) GetMe(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"read:users"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchMe operation middleware
+func (siw *ServerInterfaceWrapper) PatchMe(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"write:users"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchMe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// User defines model for User.
-type User struct {
-	// CreatedAt Example: 2024-10-12T23:26:52Z
-	CreatedAt time.Time `json:"createdAt"`
+// RequestMyEmailVerification operation middleware
+func (siw *ServerInterfaceWrapper) RequestMyEmailVerification(w http.ResponseWriter, r *http.Request) {
 
-	// Email Example: foo@example.com
-	Email         openapi_types.Email `json:"email"`
-	EmailVerified bool                `json:"emailVerified"`
+	ctx := r.Context()
 
-	// FamilyName Example: yamakawa
-	FamilyName string `json:"familyName"`
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"write:users"})
 
-	// GivenName Example: tetsu
-	GivenName string `json:"givenName"`
+	r = r.WithContext(ctx)
 
-	// ID Example: 01928120-055d-7edb-a12a-2d290512266e
-	ID string `json:"id"`
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RequestMyEmailVerification(w, r)
+	}))
 
-	// Sub Example: 0194f3ad-6b9b-7ddf-8b7e-c45011862c93
-	Sub string `json:"sub"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	// UpdatedAt Example: 2024-10-12T23:26:52Z
-	UpdatedAt time.Time `json:"updatedAt"`
+	handler.ServeHTTP(w, r)
 }
 
-// Limit pagination limit size.
-type Limit = int32
+// VerifyMyEmail operation middleware
+func (siw *ServerInterfaceWrapper) VerifyMyEmail(w http.ResponseWriter, r *http.Request) {
 
-// Next pagination cursor value.
-//
-// Example: eyJpZCI6MX0K
-type Next = string
+	ctx := r.Context()
 
-// TaskID ID of task.
-//
-// Example: 01928120-055d-7edb-a12a-2d290512266e
-type TaskID = string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"write:users"})
 
-// Response400 defines model for Response400.
-type Response400 = Error
+	r = r.WithContext(ctx)
 
-// Response404 defines model for Response404.
-type Response404 = Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyMyEmail(w, r)
+	}))
 
-// Response500 defines model for Response500.
-type Response500 = Error
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// ResponseHealthCheck defines model for ResponseHealthCheck.
-type ResponseHealthCheck = Simple
+	handler.ServeHTTP(w, r)
+}
 
-// ResponseTask defines model for ResponseTask.
-type ResponseTask = Task
+// RequestMyExport operation middleware
+func (siw *ServerInterfaceWrapper) RequestMyExport(w http.ResponseWriter, r *http.Request) {
 
-// ResponseTaskID defines model for ResponseTaskID.
-type ResponseTaskID struct {
-	// ID ID of task.
-	//
-	// Example: 01928120-055d-7edb-a12a-2d290512266e
-	ID string `json:"id"`
-}
+	ctx := r.Context()
 
-// ResponseTasks defines model for ResponseTasks.
-type ResponseTasks struct {
-	// HasNext whether has next items.
-	HasNext bool `json:"hasNext"`
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"write:users"})
 
-	// Items Items of task
-	Items []Task `json:"items"`
+	r = r.WithContext(ctx)
 
-	// Next cursor of next item.
-	//
-	// Example: eyJpZCI6IjAxOTIzM2Y1LTQzYzMtNzk4Yi1iMjRkLWVjYmM3NThhZTVmYiJ9
-	Next string `json:"next"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RequestMyExport(w, r)
+	}))
 
-// ResponseUser defines model for ResponseUser.
-type ResponseUser = User
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// ResponseUserID defines model for ResponseUserID.
-type ResponseUserID struct {
-	// ID ID of user id
-	//
-	// Example: 01928120-055d-7edb-a12a-2d290512266e
-	ID openapi_types.UUID `json:"id"`
+	handler.ServeHTTP(w, r)
 }
 
-// RequestTask defines model for RequestTask.
-type RequestTask = TaskContent
+// GetMyExport operation middleware
+func (siw *ServerInterfaceWrapper) GetMyExport(w http.ResponseWriter, r *http.Request) {
 
-// RequestUser defines model for RequestUser.
-type RequestUser struct {
-	// Email user email
-	//
-	// Example: Jonathan74@example.com
-	Email openapi_types.Email `json:"email"`
+	var err error
+	_ = err
 
-	// EmailVerified whether email is verified
-	//
-	// Example: true
-	EmailVerified bool `json:"emailVerified"`
+	// ------------- Path parameter "exportId" -------------
+	var exportID ExportID
 
-	// FamilyName user family name
-	//
-	// Example: Kozey
-	FamilyName string `json:"familyName"`
+	err = runtime.BindStyledParameterWithOptions("simple", "exportId", r.PathValue("exportId"), &exportID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exportId", Err: err})
+		return
+	}
 
-	// GivenName user given name
-	//
-	// Example: Dibbert
-	GivenName string `json:"givenName"`
-}
+	ctx := r.Context()
 
-// ListTasksParams defines parameters for ListTasks.
-type ListTasksParams struct {
-	Next  *Next  `form:"next,omitempty" json:"next,omitempty"`
-	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"read:users"})
 
-// PostUserJSONBody defines parameters for PostUser.
-type PostUserJSONBody struct {
-	// Email user email
-	//
-	// Example: Jonathan74@example.com
-	Email openapi_types.Email `json:"email"`
+	r = r.WithContext(ctx)
 
-	// EmailVerified whether email is verified
-	//
-	// Example: true
-	EmailVerified bool `json:"emailVerified"`
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMyExport(w, r, exportID)
+	}))
 
-	// FamilyName user family name
-	//
-	// Example: Kozey
-	FamilyName string `json:"familyName"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	// GivenName user given name
-	//
-	// Example: Dibbert
-	GivenName string `json:"givenName"`
+	handler.ServeHTTP(w, r)
 }
 
-// PostTaskJSONRequestBody defines body for PostTask for application/json ContentType.
-type PostTaskJSONRequestBody = TaskContent
+// DownloadMyExport operation middleware
+func (siw *ServerInterfaceWrapper) DownloadMyExport(w http.ResponseWriter, r *http.Request) {
 
-// PutTaskJSONRequestBody defines body for PutTask for application/json ContentType.
-type PutTaskJSONRequestBody = TaskContent
+	var err error
+	_ = err
 
-// PostUserJSONRequestBody defines body for PostUser for application/json ContentType.
-type PostUserJSONRequestBody PostUserJSONBody
+	// ------------- Path parameter "exportId" -------------
+	var exportID ExportID
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// HealthCheck Health check API
-	// (GET /health)
-	HealthCheck(w http.ResponseWriter, r *http.Request)
-	// ListTasks List tasks
-	// (GET /tasks)
-	ListTasks(w http.ResponseWriter, r *http.Request, params ListTasksParams)
-	// PostTask Post task
-	// (POST /tasks)
-	PostTask(w http.ResponseWriter, r *http.Request)
-	// GetTask Get task
-	// (GET /tasks/{taskId})
-	GetTask(w http.ResponseWriter, r *http.Request, taskID TaskID)
-	// PutTask Put task
-	// (PUT /tasks/{taskId})
-	PutTask(w http.ResponseWriter, r *http.Request, taskID TaskID)
-	// PostUser Post user
-	// (POST /users)
-	PostUser(w http.ResponseWriter, r *http.Request)
-	// GetMe Get own info
-	// (GET /users/me)
-	GetMe(w http.ResponseWriter, r *http.Request)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "exportId", r.PathValue("exportId"), &exportID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exportId", Err: err})
+		return
+	}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	ctx := r.Context()
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"read:users"})
 
-// HealthCheck operation middleware
-func (siw *ServerInterfaceWrapper) HealthCheck(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.HealthCheck(w, r)
+		siw.Handler.DownloadMyExport(w, r, exportID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -252,14 +2151,20 @@ func (siw *ServerInterfaceWrapper) HealthCheck(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// ListTasks operation middleware
-func (siw *ServerInterfaceWrapper) ListTasks(w http.ResponseWriter, r *http.Request) {
+// ListMyMentions operation middleware
+func (siw *ServerInterfaceWrapper) ListMyMentions(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"read:tasks"})
+
+	r = r.WithContext(ctx)
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListTasksParams
+	var params ListMyMentionsParams
 
 	// ------------- Optional query parameter "next" -------------
 
@@ -287,8 +2192,21 @@ func (siw *ServerInterfaceWrapper) ListTasks(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	// ------------- Optional query parameter "render" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "render", r.URL.Query(), &params.Render, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "render"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "render", Err: err})
+		}
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTasks(w, r, params)
+		siw.Handler.ListMyMentions(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -298,11 +2216,17 @@ func (siw *ServerInterfaceWrapper) ListTasks(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// PostTask operation middleware
-func (siw *ServerInterfaceWrapper) PostTask(w http.ResponseWriter, r *http.Request) {
+// GetMyPreferences operation middleware
+func (siw *ServerInterfaceWrapper) GetMyPreferences(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"read:users"})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTask(w, r)
+		siw.Handler.GetMyPreferences(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -312,23 +2236,17 @@ func (siw *ServerInterfaceWrapper) PostTask(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r)
 }
 
-// GetTask operation middleware
-func (siw *ServerInterfaceWrapper) GetTask(w http.ResponseWriter, r *http.Request) {
+// UpdateMyPreferences operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMyPreferences(w http.ResponseWriter, r *http.Request) {
 
-	var err error
-	_ = err
+	ctx := r.Context()
 
-	// ------------- Path parameter "taskId" -------------
-	var taskID TaskID
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"write:users"})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTask(w, r, taskID)
+		siw.Handler.UpdateMyPreferences(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -338,23 +2256,37 @@ func (siw *ServerInterfaceWrapper) GetTask(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// PutTask operation middleware
-func (siw *ServerInterfaceWrapper) PutTask(w http.ResponseWriter, r *http.Request) {
+// RestoreMe operation middleware
+func (siw *ServerInterfaceWrapper) RestoreMe(w http.ResponseWriter, r *http.Request) {
 
-	var err error
-	_ = err
+	ctx := r.Context()
 
-	// ------------- Path parameter "taskId" -------------
-	var taskID TaskID
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"write:users"})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
-		return
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreMe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// ListMyTokens operation middleware
+func (siw *ServerInterfaceWrapper) ListMyTokens(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"read:users"})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutTask(w, r, taskID)
+		siw.Handler.ListMyTokens(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -364,11 +2296,17 @@ func (siw *ServerInterfaceWrapper) PutTask(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// PostUser operation middleware
-func (siw *ServerInterfaceWrapper) PostUser(w http.ResponseWriter, r *http.Request) {
+// CreateMyToken operation middleware
+func (siw *ServerInterfaceWrapper) CreateMyToken(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"write:users"})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUser(w, r)
+		siw.Handler.CreateMyToken(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -378,11 +2316,29 @@ func (siw *ServerInterfaceWrapper) PostUser(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r)
 }
 
-// GetMe operation middleware
-func (siw *ServerInterfaceWrapper) GetMe(w http.ResponseWriter, r *http.Request) {
+// RevokeMyToken operation middleware
+func (siw *ServerInterfaceWrapper) RevokeMyToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "tokenId" -------------
+	var tokenID TokenID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tokenId", r.PathValue("tokenId"), &tokenID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tokenId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"write:users"})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetMe(w, r)
+		siw.Handler.RevokeMyToken(w, r, tokenID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -517,8 +2473,36 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/tasks", wrapper.PostTask)
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/tasks/{taskId}", wrapper.GetTask)
 	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/tasks/{taskId}", wrapper.PutTask)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/tasks/{taskId}/unarchive", wrapper.UnarchiveTask)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/tasks/{taskId}/timer/start", wrapper.StartTimer)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/tasks/{taskId}/timer/stop", wrapper.StopTimer)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/tasks/{taskId}/time-entries", wrapper.PostTimeEntry)
 	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/users", wrapper.PostUser)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/users/me", wrapper.DeleteMe)
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/users/me", wrapper.GetMe)
+	m.HandleFunc(http.MethodPatch+" "+options.BaseURL+"/users/me", wrapper.PatchMe)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/users/me/email/verification", wrapper.RequestMyEmailVerification)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/users/me/email/verify", wrapper.VerifyMyEmail)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/users/me/mentions", wrapper.ListMyMentions)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/users/me/restore", wrapper.RestoreMe)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/users/me/export", wrapper.RequestMyExport)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/users/me/exports/{exportId}", wrapper.GetMyExport)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/users/me/exports/{exportId}/download", wrapper.DownloadMyExport)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/users/me/preferences", wrapper.GetMyPreferences)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/users/me/preferences", wrapper.UpdateMyPreferences)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/users/me/tokens", wrapper.ListMyTokens)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/users/me/tokens", wrapper.CreateMyToken)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/users/me/tokens/{tokenId}", wrapper.RevokeMyToken)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/reports/time", wrapper.GetTimeReport)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/organizations", wrapper.ListMyOrganizations)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/organizations", wrapper.CreateOrganization)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/organizations/{organizationId}/members", wrapper.ListOrganizationMembers)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/organizations/{organizationId}/members", wrapper.InviteOrganizationMember)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/organizations/{organizationId}/members/{userId}", wrapper.RemoveOrganizationMember)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/admin/users", wrapper.SearchUsers)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/admin/users/{userId}/disable", wrapper.DisableUser)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/admin/users/{userId}/enable", wrapper.EnableUser)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/admin/tasks/{taskId}", wrapper.AdminGetTask)
 
 	return m
 }
@@ -0,0 +1,53 @@
+//go:build go1.22
+
+// Package oapi provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.8.0 DO NOT EDIT.
+package oapi
+
+import (
+	"net/http"
+)
+
+// OperationIDs are operationIds keyed by pattern which [HandlerWithOptions] registers each operation to [ServeMux] with.
+var OperationIDs = map[string]string{
+	http.MethodGet + " " + "/health":                                             "HealthCheck",
+	http.MethodGet + " " + "/tasks":                                              "ListTasks",
+	http.MethodPost + " " + "/tasks":                                             "PostTask",
+	http.MethodGet + " " + "/tasks/{taskId}":                                     "GetTask",
+	http.MethodPut + " " + "/tasks/{taskId}":                                     "PutTask",
+	http.MethodPost + " " + "/tasks/{taskId}/unarchive":                          "UnarchiveTask",
+	http.MethodPost + " " + "/tasks/{taskId}/timer/start":                        "StartTimer",
+	http.MethodPost + " " + "/tasks/{taskId}/timer/stop":                         "StopTimer",
+	http.MethodPost + " " + "/tasks/{taskId}/time-entries":                       "PostTimeEntry",
+	http.MethodPost + " " + "/users":                                             "PostUser",
+	http.MethodDelete + " " + "/users/me":                                        "DeleteMe",
+	http.MethodGet + " " + "/users/me":                                           "GetMe",
+	http.MethodPatch + " " + "/users/me":                                         "PatchMe",
+	http.MethodPost + " " + "/users/me/email/verification":                       "RequestMyEmailVerification",
+	http.MethodPost + " " + "/users/me/email/verify":                             "VerifyMyEmail",
+	http.MethodGet + " " + "/users/me/mentions":                                  "ListMyMentions",
+	http.MethodPost + " " + "/users/me/restore":                                  "RestoreMe",
+	http.MethodPost + " " + "/users/me/export":                                   "RequestMyExport",
+	http.MethodGet + " " + "/users/me/exports/{exportId}":                        "GetMyExport",
+	http.MethodGet + " " + "/users/me/exports/{exportId}/download":               "DownloadMyExport",
+	http.MethodGet + " " + "/users/me/preferences":                               "GetMyPreferences",
+	http.MethodPut + " " + "/users/me/preferences":                               "UpdateMyPreferences",
+	http.MethodGet + " " + "/users/me/tokens":                                    "ListMyTokens",
+	http.MethodPost + " " + "/users/me/tokens":                                   "CreateMyToken",
+	http.MethodDelete + " " + "/users/me/tokens/{tokenId}":                       "RevokeMyToken",
+	http.MethodGet + " " + "/reports/time":                                       "GetTimeReport",
+	http.MethodGet + " " + "/organizations":                                      "ListMyOrganizations",
+	http.MethodPost + " " + "/organizations":                                     "CreateOrganization",
+	http.MethodGet + " " + "/organizations/{organizationId}/members":             "ListOrganizationMembers",
+	http.MethodPost + " " + "/organizations/{organizationId}/members":            "InviteOrganizationMember",
+	http.MethodDelete + " " + "/organizations/{organizationId}/members/{userId}": "RemoveOrganizationMember",
+	http.MethodGet + " " + "/admin/users":                                        "SearchUsers",
+	http.MethodPost + " " + "/admin/users/{userId}/disable":                      "DisableUser",
+	http.MethodPost + " " + "/admin/users/{userId}/enable":                       "EnableUser",
+	http.MethodGet + " " + "/admin/tasks/{taskId}":                               "AdminGetTask",
+}
+
+// AuthOptionalOperationIDs are operationIds whose security has empty requirement besides BearerAuth, such as [{}, {BearerAuth: []}].
+// Those operations accept anonymous requests as well as ones with access token.
+var AuthOptionalOperationIDs = []string{}
@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/domain/repository"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// LimitSearchUsers is default page size of [AdminUseCase.SearchUsers] for request without preferences.
+const LimitSearchUsers int32 = 10
+
+// AdminUseCase handles operations of admin API.
+// Actor is authorized by role, and every operation is recorded in audit log.
+type AdminUseCase struct {
+	transaction           repository.TransactionRepository
+	userRepository        repository.UserRepository
+	taskArchiveRepository repository.TaskArchiveRepository
+	auditLogRepository    repository.AuditLogRepository
+}
+
+// NewAdminUseCase creates AdminUseCase.
+func NewAdminUseCase(
+	userRepo repository.UserRepository,
+	taskArchiveRepo repository.TaskArchiveRepository,
+	auditLogRepo repository.AuditLogRepository,
+	transaction repository.TransactionRepository,
+) *AdminUseCase {
+	return &AdminUseCase{
+		transaction:           transaction,
+		userRepository:        userRepo,
+		taskArchiveRepository: taskArchiveRepo,
+		auditLogRepository:    auditLogRepo,
+	}
+}
+
+// SearchUsers finds users whose email or name starts with query. Empty query lists all users.
+func (u *AdminUseCase) SearchUsers(ctx context.Context, sub, query, next string, limit int32) (entity.Page[entity.User], error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/AdminUseCase/SearchUsers").End()
+
+	actor, err := u.authorize(ctx, sub, entity.PermissionReadUsers)
+	if err != nil {
+		return entity.Page[entity.User]{}, err
+	}
+	limit = limitOrPreferred(ctx, limit, LimitSearchUsers)
+	cursor, err := entity.DecodeUserCursor(next)
+	if err != nil {
+		return entity.Page[entity.User]{}, err
+	}
+	var from uuid.UUID
+	if cursor.ID != "" {
+		from = uuid.MustParse(cursor.ID)
+	}
+	page, err := u.userRepository.Search(ctx, query, from, limit)
+	if err != nil {
+		return entity.Page[entity.User]{}, err
+	}
+	err = u.audit(ctx, actor, entity.AuditActionAdminUsersSearched, "users", map[string]string{
+		"query": query,
+		"next":  next,
+		"count": strconv.Itoa(len(page.Items)),
+	})
+	if err != nil {
+		return entity.Page[entity.User]{}, err
+	}
+	return page, nil
+}
+
+// DisableUser disables user of given id. Disabled user is rejected even if access token is valid.
+func (u *AdminUseCase) DisableUser(ctx context.Context, sub string, id uuid.UUID) (entity.User, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/AdminUseCase/DisableUser").End()
+
+	return u.changeDisabled(ctx, sub, id, entity.AuditActionAdminUserDisabled, (*entity.User).Disable)
+}
+
+// EnableUser enables disabled user of given id.
+func (u *AdminUseCase) EnableUser(ctx context.Context, sub string, id uuid.UUID) (entity.User, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/AdminUseCase/EnableUser").End()
+
+	return u.changeDisabled(ctx, sub, id, entity.AuditActionAdminUserEnabled, (*entity.User).Enable)
+}
+
+func (u *AdminUseCase) changeDisabled(ctx context.Context, sub string, id uuid.UUID, action entity.AuditAction, change func(*entity.User, time.Time) error) (entity.User, error) {
+	actor, err := u.authorize(ctx, sub, entity.PermissionManageUsers)
+	if err != nil {
+		return entity.User{}, err
+	}
+	if actor.ID == id {
+		return entity.User{}, apperr.New(fmt.Sprintf("admin %q changes own disabled state", actor.ID), "You can not change your own account", apperr.WithMessageID(message.CannotChangeOwnAccount), apperr.CodeInvalidArgument)
+	}
+	var user entity.User
+	err = u.transaction.Do(ctx, func(ctx context.Context) error {
+		var err error
+		user, err = u.userRepository.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		err = change(&user, time.Now())
+		if err != nil {
+			return err
+		}
+		err = u.userRepository.Update(ctx, user)
+		if err != nil {
+			return err
+		}
+		return u.audit(ctx, actor, action, user.ID.String(), nil)
+	})
+	if err != nil {
+		return entity.User{}, err
+	}
+	return user, nil
+}
+
+// FindTask finds any task including archived one for support cases.
+func (u *AdminUseCase) FindTask(ctx context.Context, sub string, id entity.TaskID) (entity.Task, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/AdminUseCase/FindTask").End()
+
+	actor, err := u.authorize(ctx, sub, entity.PermissionReadTasks)
+	if err != nil {
+		return entity.Task{}, err
+	}
+	task, err := u.taskArchiveRepository.FindByID(ctx, id)
+	if err != nil {
+		return entity.Task{}, err
+	}
+	err = u.audit(ctx, actor, entity.AuditActionAdminTaskViewed, task.ID, nil)
+	if err != nil {
+		return entity.Task{}, err
+	}
+	return task, nil
+}
+
+// authorize finds actor of given sub and checks whether role of actor allows given permission.
+func (u *AdminUseCase) authorize(ctx context.Context, sub string, p entity.Permission) (entity.User, error) {
+	actor, err := u.userRepository.FindBySub(ctx, sub)
+	if err != nil {
+		if apperr.IsCode(err, apperr.CodeNotFound) {
+			return entity.User{}, apperr.New(fmt.Sprintf("actor %q of admin API is not found", sub), "Permission denied", apperr.WithMessageID(message.PermissionDenied), apperr.CodeUnAuthz)
+		}
+		return entity.User{}, err
+	}
+	if !actor.Role.Allows(p) {
+		return entity.User{}, apperr.New(fmt.Sprintf("user %q with role %q is not allowed %s", actor.ID, actor.Role, p), "Permission denied", apperr.WithMessageID(message.PermissionDenied), apperr.CodeUnAuthz)
+	}
+	return actor, nil
+}
+
+func (u *AdminUseCase) audit(ctx context.Context, actor entity.User, action entity.AuditAction, targetID string, detail map[string]string) error {
+	log, err := entity.NewAuditLog(actor.ID, action, targetID, detail)
+	if err != nil {
+		return err
+	}
+	return u.auditLogRepository.Create(ctx, log)
+}
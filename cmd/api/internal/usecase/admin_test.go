@@ -0,0 +1,255 @@
+package usecase_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/usecase"
+	"go-playground/pkg/apperr"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testTargetUserID = uuid.MustParse("01930c3b-e82b-700a-b41a-6f58b5c2b813")
+
+func newMockActorRepository(role entity.Role) *MockUserRepository {
+	mck := new(MockUserRepository)
+	mck.On("FindBySub", context.Background(), testSub).Return(entity.User{ID: testUserID, Sub: testSub, Role: role}, nil)
+	return mck
+}
+
+func auditLogOf(action entity.AuditAction, targetID string) any {
+	return mock.MatchedBy(func(l entity.AuditLog) bool {
+		return l.ActorID == testUserID && l.Action == action && l.TargetID == targetID
+	})
+}
+
+func TestAdminUseCase_SearchUsers(t *testing.T) {
+	page := entity.Page[entity.User]{Items: []entity.User{{ID: testTargetUserID}}}
+	type want struct {
+		page    entity.Page[entity.User]
+		err     string
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		next  string
+		setup func(t *testing.T) (*MockUserRepository, *MockAuditLogRepository)
+		want  want
+	}{
+		"success first page by support": {
+			setup: func(t *testing.T) (*MockUserRepository, *MockAuditLogRepository) {
+				users := newMockActorRepository(entity.RoleSupport)
+				users.On("Search", context.Background(), "alice", uuid.Nil, usecase.LimitSearchUsers).Return(page, nil).Once()
+				logs := new(MockAuditLogRepository)
+				logs.On("Create", context.Background(), auditLogOf(entity.AuditActionAdminUsersSearched, "users")).Return(nil).Once()
+				return users, logs
+			},
+			want: want{page: page},
+		},
+		"success next page": {
+			next: "eyJpZCI6IjAxOTMwYzNiLWU4MmItNzAwYS1iNDFhLTZmNThiNWMyYjgxMyJ9",
+			setup: func(t *testing.T) (*MockUserRepository, *MockAuditLogRepository) {
+				users := newMockActorRepository(entity.RoleAdmin)
+				users.On("Search", context.Background(), "alice", testTargetUserID, usecase.LimitSearchUsers).Return(page, nil).Once()
+				logs := new(MockAuditLogRepository)
+				logs.On("Create", context.Background(), auditLogOf(entity.AuditActionAdminUsersSearched, "users")).Return(nil).Once()
+				return users, logs
+			},
+			want: want{page: page},
+		},
+		"failure user role is not allowed": {
+			setup: func(t *testing.T) (*MockUserRepository, *MockAuditLogRepository) {
+				return newMockActorRepository(entity.RoleUser), new(MockAuditLogRepository)
+			},
+			want: want{
+				err:     `user "01930c3a-e82b-700a-b41a-6f58b5c2b812" with role "user" is not allowed read:users`,
+				errCode: apperr.CodeUnAuthz,
+			},
+		},
+		"failure actor is not found": {
+			setup: func(t *testing.T) (*MockUserRepository, *MockAuditLogRepository) {
+				users := new(MockUserRepository)
+				users.On("FindBySub", context.Background(), testSub).Return(entity.User{}, apperr.New("not found", "user is not found", apperr.CodeNotFound))
+				return users, new(MockAuditLogRepository)
+			},
+			want: want{
+				err:     `actor "80dbb87a-5ce8-4b45-85a0-3b8aec488b7a" of admin API is not found`,
+				errCode: apperr.CodeUnAuthz,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			users, logs := tc.setup(t)
+			u := usecase.NewAdminUseCase(users, new(MockTaskArchiveRepository), logs, &MockTransactionRepository{})
+
+			got, err := u.SearchUsers(context.Background(), testSub, "alice", tc.next, 0)
+
+			assert.Equal(t, tc.want.page, got)
+			if tc.want.err != "" {
+				assert.EqualError(t, err, tc.want.err)
+				assert.True(t, apperr.IsCode(err, tc.want.errCode))
+			} else {
+				assert.NoError(t, err)
+			}
+			users.AssertExpectations(t)
+			logs.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAdminUseCase_DisableUser(t *testing.T) {
+	type want struct {
+		err     string
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		target uuid.UUID
+		setup  func(t *testing.T) (*MockUserRepository, *MockAuditLogRepository)
+		want   want
+	}{
+		"success": {
+			target: testTargetUserID,
+			setup: func(t *testing.T) (*MockUserRepository, *MockAuditLogRepository) {
+				users := newMockActorRepository(entity.RoleAdmin)
+				users.On("FindByID", context.Background(), testTargetUserID).Return(entity.User{ID: testTargetUserID}, nil).Once()
+				users.On("Update", context.Background(), mock.MatchedBy(func(u entity.User) bool {
+					return u.ID == testTargetUserID && u.Disabled()
+				})).Return(nil).Once()
+				logs := new(MockAuditLogRepository)
+				logs.On("Create", context.Background(), auditLogOf(entity.AuditActionAdminUserDisabled, testTargetUserID.String())).Return(nil).Once()
+				return users, logs
+			},
+		},
+		"failure support can not disable user": {
+			target: testTargetUserID,
+			setup: func(t *testing.T) (*MockUserRepository, *MockAuditLogRepository) {
+				return newMockActorRepository(entity.RoleSupport), new(MockAuditLogRepository)
+			},
+			want: want{
+				err:     `user "01930c3a-e82b-700a-b41a-6f58b5c2b812" with role "support" is not allowed manage:users`,
+				errCode: apperr.CodeUnAuthz,
+			},
+		},
+		"failure disable self": {
+			target: testUserID,
+			setup: func(t *testing.T) (*MockUserRepository, *MockAuditLogRepository) {
+				return newMockActorRepository(entity.RoleAdmin), new(MockAuditLogRepository)
+			},
+			want: want{
+				err:     `admin "01930c3a-e82b-700a-b41a-6f58b5c2b812" changes own disabled state`,
+				errCode: apperr.CodeInvalidArgument,
+			},
+		},
+		"failure already disabled": {
+			target: testTargetUserID,
+			setup: func(t *testing.T) (*MockUserRepository, *MockAuditLogRepository) {
+				users := newMockActorRepository(entity.RoleAdmin)
+				users.On("FindByID", context.Background(), testTargetUserID).Return(entity.User{ID: testTargetUserID, DisabledAt: time.Now()}, nil).Once()
+				return users, new(MockAuditLogRepository)
+			},
+			want: want{
+				err:     `disable user "01930c3b-e82b-700a-b41a-6f58b5c2b813" but user is already disabled`,
+				errCode: apperr.CodeFailedPrecondition,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			users, logs := tc.setup(t)
+			u := usecase.NewAdminUseCase(users, new(MockTaskArchiveRepository), logs, &MockTransactionRepository{})
+
+			got, err := u.DisableUser(context.Background(), testSub, tc.target)
+
+			if tc.want.err != "" {
+				assert.Zero(t, got)
+				assert.EqualError(t, err, tc.want.err)
+				assert.True(t, apperr.IsCode(err, tc.want.errCode))
+			} else {
+				require.NoError(t, err)
+				assert.True(t, got.Disabled())
+			}
+			users.AssertExpectations(t)
+			logs.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAdminUseCase_EnableUser(t *testing.T) {
+	users := newMockActorRepository(entity.RoleAdmin)
+	users.On("FindByID", context.Background(), testTargetUserID).Return(entity.User{ID: testTargetUserID, DisabledAt: time.Now()}, nil).Once()
+	users.On("Update", context.Background(), mock.MatchedBy(func(u entity.User) bool { return !u.Disabled() })).Return(nil).Once()
+	logs := new(MockAuditLogRepository)
+	logs.On("Create", context.Background(), auditLogOf(entity.AuditActionAdminUserEnabled, testTargetUserID.String())).Return(nil).Once()
+	u := usecase.NewAdminUseCase(users, new(MockTaskArchiveRepository), logs, &MockTransactionRepository{})
+
+	got, err := u.EnableUser(context.Background(), testSub, testTargetUserID)
+
+	require.NoError(t, err)
+	assert.False(t, got.Disabled())
+	users.AssertExpectations(t)
+	logs.AssertExpectations(t)
+}
+
+func TestAdminUseCase_FindTask(t *testing.T) {
+	task := entity.Task{ID: "018f5c1e-2b3a-7c4d-8e5f-6a7b8c9d0e1f", Archived: true}
+	type want struct {
+		task    entity.Task
+		err     string
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		setup func(t *testing.T) (*MockUserRepository, *MockTaskArchiveRepository, *MockAuditLogRepository)
+		want  want
+	}{
+		"success": {
+			setup: func(t *testing.T) (*MockUserRepository, *MockTaskArchiveRepository, *MockAuditLogRepository) {
+				tasks := new(MockTaskArchiveRepository)
+				tasks.On("FindByID", context.Background(), task.ID).Return(task, nil).Once()
+				logs := new(MockAuditLogRepository)
+				logs.On("Create", context.Background(), auditLogOf(entity.AuditActionAdminTaskViewed, task.ID)).Return(nil).Once()
+				return newMockActorRepository(entity.RoleSupport), tasks, logs
+			},
+			want: want{task: task},
+		},
+		"failure task not found": {
+			setup: func(t *testing.T) (*MockUserRepository, *MockTaskArchiveRepository, *MockAuditLogRepository) {
+				tasks := new(MockTaskArchiveRepository)
+				tasks.On("FindByID", context.Background(), task.ID).Return(entity.Task{}, apperr.New("not found", "not found task", apperr.CodeNotFound)).Once()
+				return newMockActorRepository(entity.RoleSupport), tasks, new(MockAuditLogRepository)
+			},
+			want: want{err: "not found", errCode: apperr.CodeNotFound},
+		},
+		"failure user role is not allowed": {
+			setup: func(t *testing.T) (*MockUserRepository, *MockTaskArchiveRepository, *MockAuditLogRepository) {
+				return newMockActorRepository(entity.RoleUser), new(MockTaskArchiveRepository), new(MockAuditLogRepository)
+			},
+			want: want{
+				err:     `user "01930c3a-e82b-700a-b41a-6f58b5c2b812" with role "user" is not allowed read:tasks`,
+				errCode: apperr.CodeUnAuthz,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			users, tasks, logs := tc.setup(t)
+			u := usecase.NewAdminUseCase(users, tasks, logs, &MockTransactionRepository{})
+
+			got, err := u.FindTask(context.Background(), testSub, task.ID)
+
+			assert.Equal(t, tc.want.task, got)
+			if tc.want.err != "" {
+				assert.EqualError(t, err, tc.want.err)
+				assert.True(t, apperr.IsCode(err, tc.want.errCode))
+			} else {
+				assert.NoError(t, err)
+			}
+			tasks.AssertExpectations(t)
+			logs.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/domain/repository"
+	"net/url"
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// emailVerificationSubject is subject of mail to verify email.
+const emailVerificationSubject = "Verify your email"
+
+// EmailVerificationUseCase verifies email of user by token sent to the email.
+type EmailVerificationUseCase struct {
+	transaction        repository.TransactionRepository
+	userRepository     repository.UserRepository
+	auditLogRepository repository.AuditLogRepository
+	mailRepository     repository.MailRepository
+	secret             []byte
+	verifyURL          *url.URL
+}
+
+// NewEmailVerificationUseCase creates EmailVerificationUseCase.
+// Token is signed with secret and sent as token query parameter of verifyURL, which is page calling verification API.
+func NewEmailVerificationUseCase(
+	userRepo repository.UserRepository,
+	auditLogRepo repository.AuditLogRepository,
+	mailRepo repository.MailRepository,
+	transaction repository.TransactionRepository,
+	secret []byte,
+	verifyURL *url.URL,
+) *EmailVerificationUseCase {
+	return &EmailVerificationUseCase{
+		transaction:        transaction,
+		userRepository:     userRepo,
+		auditLogRepository: auditLogRepo,
+		mailRepository:     mailRepo,
+		secret:             secret,
+		verifyURL:          verifyURL,
+	}
+}
+
+// RequestVerification sends token to verify email to current email of user with given sub.
+// Tokens sent before are valid until they expire, unless email is changed.
+func (u *EmailVerificationUseCase) RequestVerification(ctx context.Context, sub string) error {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/EmailVerificationUseCase/RequestVerification").End()
+
+	user, err := u.userRepository.FindBySub(ctx, sub)
+	if err != nil {
+		return err
+	}
+	verification, err := entity.NewEmailVerification(user, time.Now())
+	if err != nil {
+		return err
+	}
+	token, err := verification.Sign(u.secret)
+	if err != nil {
+		return err
+	}
+	link := *u.verifyURL
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+	body := fmt.Sprintf(
+		"Hello %s,\n\nOpen the link below within %d hours to verify your email.\n%s\n\nIf you did not request this, you can ignore this mail.\n",
+		user.GivenName,
+		int(entity.EmailVerificationLifetime.Hours()),
+		link.String(),
+	)
+	return u.mailRepository.Send(ctx, user.Email, emailVerificationSubject, body)
+}
+
+// VerifyEmail verifies email of user with given sub by token sent by [EmailVerificationUseCase.RequestVerification].
+// Verification is recorded in audit log.
+func (u *EmailVerificationUseCase) VerifyEmail(ctx context.Context, sub string, token string) (entity.User, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/EmailVerificationUseCase/VerifyEmail").End()
+
+	now := time.Now()
+	verification, err := entity.ParseEmailVerification(token, u.secret, now)
+	if err != nil {
+		return entity.User{}, err
+	}
+	var user entity.User
+	err = u.transaction.Do(ctx, func(ctx context.Context) error {
+		var err error
+		user, err = u.userRepository.FindBySubForUpdate(ctx, sub)
+		if err != nil {
+			return err
+		}
+		err = user.VerifyEmail(verification, now)
+		if err != nil {
+			return err
+		}
+		err = u.userRepository.Update(ctx, user)
+		if err != nil {
+			return err
+		}
+		log, err := entity.NewAuditLog(user.ID, entity.AuditActionUserEmailVerified, user.ID.String(), nil)
+		if err != nil {
+			return err
+		}
+		return u.auditLogRepository.Create(ctx, log)
+	})
+	if err != nil {
+		return entity.User{}, err
+	}
+	return user, nil
+}
@@ -0,0 +1,184 @@
+package usecase_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/usecase"
+	"go-playground/pkg/apperr"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testEmailVerificationSecret = []byte(strings.Repeat("s", entity.EmailVerificationMinSecretLength))
+	testEmailVerificationURL    = &url.URL{Scheme: "https", Host: "app.example.com", Path: "/verify-email"}
+)
+
+func TestEmailVerificationUseCase_RequestVerification(t *testing.T) {
+	type want struct {
+		err     string
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		setup func() (*MockUserRepository, *MockMailRepository)
+		want  want
+	}{
+		"success": {
+			setup: func() (*MockUserRepository, *MockMailRepository) {
+				users := new(MockUserRepository)
+				users.On("FindBySub", context.Background(), testSub).Return(entity.User{ID: testUserID, Sub: testSub, GivenName: "Dibbert", Email: "user@example.com"}, nil)
+				mails := new(MockMailRepository)
+				mails.On("Send", context.Background(), "user@example.com", "Verify your email", mock.MatchedBy(func(body string) bool {
+					return strings.HasPrefix(body, "Hello Dibbert,") && strings.Contains(body, "https://app.example.com/verify-email?token=")
+				})).Return(nil).Once()
+				return users, mails
+			},
+		},
+		"failure already verified": {
+			setup: func() (*MockUserRepository, *MockMailRepository) {
+				users := new(MockUserRepository)
+				users.On("FindBySub", context.Background(), testSub).Return(entity.User{ID: testUserID, Sub: testSub, Email: "user@example.com", EmailVerified: true}, nil)
+				return users, new(MockMailRepository)
+			},
+			want: want{
+				err:     `request email verification but email of user "01930c3a-e82b-700a-b41a-6f58b5c2b812" is already verified`,
+				errCode: apperr.CodeFailedPrecondition,
+			},
+		},
+		"failure to send mail": {
+			setup: func() (*MockUserRepository, *MockMailRepository) {
+				users := new(MockUserRepository)
+				users.On("FindBySub", context.Background(), testSub).Return(entity.User{ID: testUserID, Sub: testSub, Email: "user@example.com"}, nil)
+				mails := new(MockMailRepository)
+				mails.On("Send", context.Background(), "user@example.com", "Verify your email", mock.Anything).Return(apperr.New("send mail", "failed to send mail"))
+				return users, mails
+			},
+			want: want{
+				err:     "send mail",
+				errCode: apperr.CodeInternal,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			users, mails := tc.setup()
+			u := usecase.NewEmailVerificationUseCase(users, new(MockAuditLogRepository), mails, new(MockTransactionRepository), testEmailVerificationSecret, testEmailVerificationURL)
+
+			err := u.RequestVerification(context.Background(), testSub)
+
+			if tc.want.err != "" {
+				assert.EqualError(t, err, tc.want.err)
+				assert.True(t, apperr.IsCode(err, tc.want.errCode))
+			} else {
+				assert.NoError(t, err)
+			}
+			users.AssertExpectations(t)
+			mails.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEmailVerificationUseCase_VerifyEmail(t *testing.T) {
+	user := entity.User{ID: testUserID, Sub: testSub, Email: "user@example.com"}
+	sign := func(t *testing.T, v entity.EmailVerification) string {
+		token, err := v.Sign(testEmailVerificationSecret)
+		require.NoError(t, err)
+		return token
+	}
+	type want struct {
+		err     string
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		token func(t *testing.T) string
+		setup func() (*MockUserRepository, *MockAuditLogRepository)
+		want  want
+	}{
+		"success": {
+			token: func(t *testing.T) string {
+				return sign(t, entity.EmailVerification{UserID: testUserID, Email: "user@example.com", ExpiresAt: time.Now().Add(time.Hour)})
+			},
+			setup: func() (*MockUserRepository, *MockAuditLogRepository) {
+				users := new(MockUserRepository)
+				users.On("FindBySubForUpdate", context.Background(), testSub).Return(user, nil)
+				users.On("Update", context.Background(), mock.MatchedBy(func(u entity.User) bool { return u.EmailVerified })).Return(nil).Once()
+				logs := new(MockAuditLogRepository)
+				logs.On("Create", context.Background(), auditLogOf(entity.AuditActionUserEmailVerified, testUserID.String())).Return(nil).Once()
+				return users, logs
+			},
+		},
+		"failure expired token": {
+			token: func(t *testing.T) string {
+				return sign(t, entity.EmailVerification{UserID: testUserID, Email: "user@example.com", ExpiresAt: time.Now().Add(-time.Hour)})
+			},
+			setup: func() (*MockUserRepository, *MockAuditLogRepository) {
+				return new(MockUserRepository), new(MockAuditLogRepository)
+			},
+			want: want{
+				err:     "email verification token expired at",
+				errCode: apperr.CodeInvalidArgument,
+			},
+		},
+		"failure token of changed email": {
+			token: func(t *testing.T) string {
+				return sign(t, entity.EmailVerification{UserID: testUserID, Email: "old@example.com", ExpiresAt: time.Now().Add(time.Hour)})
+			},
+			setup: func() (*MockUserRepository, *MockAuditLogRepository) {
+				users := new(MockUserRepository)
+				users.On("FindBySubForUpdate", context.Background(), testSub).Return(user, nil)
+				return users, new(MockAuditLogRepository)
+			},
+			want: want{
+				err:     `verify email of user "01930c3a-e82b-700a-b41a-6f58b5c2b812" by verification of user "01930c3a-e82b-700a-b41a-6f58b5c2b812" and email "old@example.com"`,
+				errCode: apperr.CodeInvalidArgument,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			users, logs := tc.setup()
+			u := usecase.NewEmailVerificationUseCase(users, logs, new(MockMailRepository), new(MockTransactionRepository), testEmailVerificationSecret, testEmailVerificationURL)
+
+			got, err := u.VerifyEmail(context.Background(), testSub, tc.token(t))
+
+			if tc.want.err != "" {
+				assert.Zero(t, got)
+				assert.ErrorContains(t, err, tc.want.err)
+				assert.True(t, apperr.IsCode(err, tc.want.errCode))
+			} else {
+				require.NoError(t, err)
+				assert.True(t, got.EmailVerified)
+			}
+			users.AssertExpectations(t)
+			logs.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEmailVerificationUseCase_RoundTrip(t *testing.T) {
+	users := new(MockUserRepository)
+	users.On("FindBySub", context.Background(), testSub).Return(entity.User{ID: testUserID, Sub: testSub, Email: "user@example.com"}, nil)
+	users.On("FindBySubForUpdate", context.Background(), testSub).Return(entity.User{ID: testUserID, Sub: testSub, Email: "user@example.com"}, nil)
+	users.On("Update", context.Background(), mock.Anything).Return(nil)
+	logs := new(MockAuditLogRepository)
+	logs.On("Create", context.Background(), mock.Anything).Return(nil)
+	var body string
+	mails := new(MockMailRepository)
+	mails.On("Send", context.Background(), "user@example.com", "Verify your email", mock.Anything).Run(func(args mock.Arguments) { body = args.String(3) }).Return(nil)
+	u := usecase.NewEmailVerificationUseCase(users, logs, mails, new(MockTransactionRepository), testEmailVerificationSecret, testEmailVerificationURL)
+
+	require.NoError(t, u.RequestVerification(context.Background(), testSub))
+	match := regexp.MustCompile(`token=([\w.-]+)`).FindStringSubmatch(body)
+	require.Len(t, match, 2)
+	got, err := u.VerifyEmail(context.Background(), testSub, match[1])
+
+	require.NoError(t, err)
+	assert.True(t, got.EmailVerified)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"go-playground/cmd/api/internal/domain/entity"
 	"go-playground/cmd/api/internal/domain/repository"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -52,8 +54,241 @@ func (mck *MockUserRepository) FindBySub(ctx context.Context, sub string) (entit
 	return args.Get(0).(entity.User), args.Error(1)
 }
 
+func (mck *MockUserRepository) ListByEmails(ctx context.Context, emails []string) ([]entity.User, error) {
+	args := mck.Called(ctx, emails)
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (mck *MockUserRepository) FindBySubForUpdate(ctx context.Context, sub string) (entity.User, error) {
+	args := mck.Called(ctx, sub)
+	return args.Get(0).(entity.User), args.Error(1)
+}
+
+func (mck *MockUserRepository) Update(ctx context.Context, user entity.User) error {
+	args := mck.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (mck *MockUserRepository) FindByID(ctx context.Context, id uuid.UUID) (entity.User, error) {
+	args := mck.Called(ctx, id)
+	return args.Get(0).(entity.User), args.Error(1)
+}
+
+func (mck *MockUserRepository) Search(ctx context.Context, query string, next uuid.UUID, limit int32) (entity.Page[entity.User], error) {
+	args := mck.Called(ctx, query, next, limit)
+	return args.Get(0).(entity.Page[entity.User]), args.Error(1)
+}
+
+func (mck *MockUserRepository) PurgeDeleted(ctx context.Context, before time.Time, limit int32) (int64, error) {
+	args := mck.Called(ctx, before, limit)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+type MockMentionRepository struct {
+	mock.Mock
+}
+
+func (mck *MockMentionRepository) ReplaceTaskMentions(ctx context.Context, taskID entity.TaskID, userIDs []uuid.UUID) error {
+	args := mck.Called(ctx, taskID, userIDs)
+	return args.Error(0)
+}
+
+func (mck *MockMentionRepository) ListMentionedTasks(ctx context.Context, userID uuid.UUID, next entity.TaskID, limit int32) (entity.Page[entity.Task], error) {
+	args := mck.Called(ctx, userID, next, limit)
+	return args.Get(0).(entity.Page[entity.Task]), args.Error(1)
+}
+
+type MockTimeEntryRepository struct {
+	mock.Mock
+}
+
+func (mck *MockTimeEntryRepository) FindRunningForUpdate(ctx context.Context, userID uuid.UUID) (entity.TimeEntry, error) {
+	args := mck.Called(ctx, userID)
+	return args.Get(0).(entity.TimeEntry), args.Error(1)
+}
+
+func (mck *MockTimeEntryRepository) ListInRange(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]entity.TimeEntry, error) {
+	args := mck.Called(ctx, userID, from, to)
+	return args.Get(0).([]entity.TimeEntry), args.Error(1)
+}
+
+func (mck *MockTimeEntryRepository) Create(ctx context.Context, entry entity.TimeEntry) error {
+	args := mck.Called(ctx, entry)
+	return args.Error(0)
+}
+
+func (mck *MockTimeEntryRepository) Update(ctx context.Context, entry entity.TimeEntry) error {
+	args := mck.Called(ctx, entry)
+	return args.Error(0)
+}
+
 var (
 	_ repository.TransactionRepository = (*MockTransactionRepository)(nil)
 	_ repository.TaskRepository        = (*MockTaskRepository)(nil)
 	_ repository.UserRepository        = (*MockUserRepository)(nil)
+	_ repository.MentionRepository     = (*MockMentionRepository)(nil)
+	_ repository.TimeEntryRepository   = (*MockTimeEntryRepository)(nil)
 )
+
+type MockTaskArchiveRepository struct {
+	mock.Mock
+}
+
+func (mck *MockTaskArchiveRepository) ArchiveTasks(ctx context.Context, before time.Time, limit int32) (int64, error) {
+	args := mck.Called(ctx, before, limit)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (mck *MockTaskArchiveRepository) ArchiveOwnedTasks(ctx context.Context, ownerID uuid.UUID, at time.Time) (int64, error) {
+	args := mck.Called(ctx, ownerID, at)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (mck *MockTaskArchiveRepository) UnarchiveOwnedTasks(ctx context.Context, ownerID uuid.UUID, since time.Time) (int64, error) {
+	args := mck.Called(ctx, ownerID, since)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (mck *MockTaskArchiveRepository) Unarchive(ctx context.Context, id entity.TaskID) error {
+	args := mck.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (mck *MockTaskArchiveRepository) FindByID(ctx context.Context, id entity.TaskID) (entity.Task, error) {
+	args := mck.Called(ctx, id)
+	return args.Get(0).(entity.Task), args.Error(1)
+}
+
+func (mck *MockTaskArchiveRepository) ListTasks(ctx context.Context, next entity.TaskID, limit int32) (entity.Page[entity.Task], error) {
+	args := mck.Called(ctx, next, limit)
+	return args.Get(0).(entity.Page[entity.Task]), args.Error(1)
+}
+
+func (mck *MockTaskArchiveRepository) ListOwnedTasks(ctx context.Context, ownerID uuid.UUID) ([]entity.Task, error) {
+	args := mck.Called(ctx, ownerID)
+	return args.Get(0).([]entity.Task), args.Error(1)
+}
+
+type MockAuditLogRepository struct {
+	mock.Mock
+}
+
+func (mck *MockAuditLogRepository) Create(ctx context.Context, log entity.AuditLog) error {
+	args := mck.Called(ctx, log)
+	return args.Error(0)
+}
+
+func (mck *MockAuditLogRepository) ListByTarget(ctx context.Context, targetID string) ([]entity.AuditLog, error) {
+	args := mck.Called(ctx, targetID)
+	return args.Get(0).([]entity.AuditLog), args.Error(1)
+}
+
+type MockUserExportRepository struct {
+	mock.Mock
+}
+
+func (mck *MockUserExportRepository) Create(ctx context.Context, export entity.UserExport) error {
+	args := mck.Called(ctx, export)
+	return args.Error(0)
+}
+
+func (mck *MockUserExportRepository) FindByID(ctx context.Context, id entity.UserExportID) (entity.UserExport, error) {
+	args := mck.Called(ctx, id)
+	return args.Get(0).(entity.UserExport), args.Error(1)
+}
+
+func (mck *MockUserExportRepository) FindPendingForUpdate(ctx context.Context) (entity.UserExport, error) {
+	args := mck.Called(ctx)
+	return args.Get(0).(entity.UserExport), args.Error(1)
+}
+
+func (mck *MockUserExportRepository) Update(ctx context.Context, export entity.UserExport) error {
+	args := mck.Called(ctx, export)
+	return args.Error(0)
+}
+
+type MockPersonalAccessTokenRepository struct {
+	mock.Mock
+}
+
+func (mck *MockPersonalAccessTokenRepository) Create(ctx context.Context, token entity.PersonalAccessToken) error {
+	args := mck.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (mck *MockPersonalAccessTokenRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]entity.PersonalAccessToken, error) {
+	args := mck.Called(ctx, userID)
+	return args.Get(0).([]entity.PersonalAccessToken), args.Error(1)
+}
+
+func (mck *MockPersonalAccessTokenRepository) FindByIDForUpdate(ctx context.Context, id entity.PersonalAccessTokenID) (entity.PersonalAccessToken, error) {
+	args := mck.Called(ctx, id)
+	return args.Get(0).(entity.PersonalAccessToken), args.Error(1)
+}
+
+func (mck *MockPersonalAccessTokenRepository) FindByHash(ctx context.Context, hash []byte) (entity.PersonalAccessToken, error) {
+	args := mck.Called(ctx, hash)
+	return args.Get(0).(entity.PersonalAccessToken), args.Error(1)
+}
+
+func (mck *MockPersonalAccessTokenRepository) Update(ctx context.Context, token entity.PersonalAccessToken) error {
+	args := mck.Called(ctx, token)
+	return args.Error(0)
+}
+
+type MockMailRepository struct {
+	mock.Mock
+}
+
+func (mck *MockMailRepository) Send(ctx context.Context, to, subject, body string) error {
+	args := mck.Called(ctx, to, subject, body)
+	return args.Error(0)
+}
+
+type MockOrganizationRepository struct {
+	mock.Mock
+}
+
+func (mck *MockOrganizationRepository) Create(ctx context.Context, org entity.Organization) error {
+	args := mck.Called(ctx, org)
+	return args.Error(0)
+}
+
+func (mck *MockOrganizationRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]entity.Organization, error) {
+	args := mck.Called(ctx, userID)
+	return args.Get(0).([]entity.Organization), args.Error(1)
+}
+
+func (mck *MockOrganizationRepository) AddMember(ctx context.Context, member entity.OrganizationMember) error {
+	args := mck.Called(ctx, member)
+	return args.Error(0)
+}
+
+func (mck *MockOrganizationRepository) FindMember(ctx context.Context, orgID entity.OrganizationID, userID uuid.UUID) (entity.OrganizationMember, error) {
+	args := mck.Called(ctx, orgID, userID)
+	return args.Get(0).(entity.OrganizationMember), args.Error(1)
+}
+
+func (mck *MockOrganizationRepository) ListMembers(ctx context.Context, orgID entity.OrganizationID) ([]entity.OrganizationMember, error) {
+	args := mck.Called(ctx, orgID)
+	return args.Get(0).([]entity.OrganizationMember), args.Error(1)
+}
+
+func (mck *MockOrganizationRepository) RemoveMember(ctx context.Context, orgID entity.OrganizationID, userID uuid.UUID) error {
+	args := mck.Called(ctx, orgID, userID)
+	return args.Error(0)
+}
+
+type MockUserPreferencesRepository struct {
+	mock.Mock
+}
+
+func (mck *MockUserPreferencesRepository) Find(ctx context.Context, userID uuid.UUID) (entity.UserPreferences, error) {
+	args := mck.Called(ctx, userID)
+	return args.Get(0).(entity.UserPreferences), args.Error(1)
+}
+
+func (mck *MockUserPreferencesRepository) Save(ctx context.Context, p entity.UserPreferences) error {
+	args := mck.Called(ctx, p)
+	return args.Error(0)
+}
@@ -0,0 +1,246 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/domain/repository"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// OrganizationUseCase handles organizations and their members.
+type OrganizationUseCase struct {
+	transaction            repository.TransactionRepository
+	userRepository         repository.UserRepository
+	organizationRepository repository.OrganizationRepository
+	auditLogRepository     repository.AuditLogRepository
+}
+
+// NewOrganizationUseCase creates OrganizationUseCase.
+func NewOrganizationUseCase(
+	userRepo repository.UserRepository,
+	organizationRepo repository.OrganizationRepository,
+	auditLogRepo repository.AuditLogRepository,
+	transaction repository.TransactionRepository,
+) *OrganizationUseCase {
+	return &OrganizationUseCase{
+		transaction:            transaction,
+		userRepository:         userRepo,
+		organizationRepository: organizationRepo,
+		auditLogRepository:     auditLogRepo,
+	}
+}
+
+// CreateOrganization creates organization owned by user with given sub.
+func (u *OrganizationUseCase) CreateOrganization(ctx context.Context, sub string, name string) (entity.Organization, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/OrganizationUseCase/CreateOrganization").End()
+
+	user, err := u.userRepository.FindBySub(ctx, sub)
+	if err != nil {
+		return entity.Organization{}, err
+	}
+	org, err := entity.NewOrganization(name, time.Now())
+	if err != nil {
+		return entity.Organization{}, err
+	}
+	err = u.transaction.Do(ctx, func(ctx context.Context) error {
+		err := u.organizationRepository.Create(ctx, org)
+		if err != nil {
+			return err
+		}
+		err = u.organizationRepository.AddMember(ctx, entity.NewOrganizationOwner(org, user.ID))
+		if err != nil {
+			return err
+		}
+		log, err := entity.NewAuditLog(user.ID, entity.AuditActionOrganizationCreated, org.ID, map[string]string{
+			"name": org.Name,
+		})
+		if err != nil {
+			return err
+		}
+		return u.auditLogRepository.Create(ctx, log)
+	})
+	if err != nil {
+		return entity.Organization{}, err
+	}
+	return org, nil
+}
+
+// ListOrganizations lists organizations which user with given sub belongs to.
+func (u *OrganizationUseCase) ListOrganizations(ctx context.Context, sub string) ([]entity.Organization, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/OrganizationUseCase/ListOrganizations").End()
+
+	user, err := u.userRepository.FindBySub(ctx, sub)
+	if err != nil {
+		return nil, err
+	}
+	return u.organizationRepository.ListByUserID(ctx, user.ID)
+}
+
+// ActiveOrganization decides active organization of request by user with given id.
+//
+// Requested organization is active if user is member of it. If nothing is requested,
+// the only organization of user is active, and no organization is active for user belonging to several ones.
+// Empty id is returned when no organization is active.
+func (u *OrganizationUseCase) ActiveOrganization(ctx context.Context, userID uuid.UUID, requested entity.OrganizationID) (entity.OrganizationID, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/OrganizationUseCase/ActiveOrganization").End()
+
+	if requested != "" {
+		_, err := u.findMember(ctx, requested, userID)
+		if err != nil {
+			return "", err
+		}
+		return requested, nil
+	}
+	orgs, err := u.organizationRepository.ListByUserID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if len(orgs) != 1 {
+		return "", nil
+	}
+	return orgs[0].ID, nil
+}
+
+// ListMembers lists members of given organization. User with given sub must be member of it.
+func (u *OrganizationUseCase) ListMembers(ctx context.Context, sub string, orgID entity.OrganizationID) ([]entity.OrganizationMember, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/OrganizationUseCase/ListMembers").End()
+
+	user, err := u.userRepository.FindBySub(ctx, sub)
+	if err != nil {
+		return nil, err
+	}
+	_, err = u.findMember(ctx, orgID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	return u.organizationRepository.ListMembers(ctx, orgID)
+}
+
+// InviteMember adds user with given email to given organization with given role.
+// User with given sub must be owner or admin of organization, and invited user must have verified the email.
+// Email is not unique among users, so invitation is rejected if more than one user has it.
+func (u *OrganizationUseCase) InviteMember(
+	ctx context.Context,
+	sub string,
+	orgID entity.OrganizationID,
+	email string,
+	role entity.OrganizationRole,
+) (entity.OrganizationMember, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/OrganizationUseCase/InviteMember").End()
+
+	actor, err := u.userRepository.FindBySub(ctx, sub)
+	if err != nil {
+		return entity.OrganizationMember{}, err
+	}
+	inviter, err := u.findMember(ctx, orgID, actor.ID)
+	if err != nil {
+		return entity.OrganizationMember{}, err
+	}
+	users, err := u.userRepository.ListByEmails(ctx, []string{email})
+	if err != nil {
+		return entity.OrganizationMember{}, err
+	}
+	// Users are not told apart by email, so organization would be shared with unintended one.
+	if len(users) > 1 {
+		return entity.OrganizationMember{}, apperr.New(
+			fmt.Sprintf("invite user to organization %q but %d users have email", orgID, len(users)),
+			"More than one user has this email",
+			apperr.WithMessageID(message.AmbiguousInvitee),
+			apperr.CodeFailedPrecondition,
+		)
+	}
+	// Unverified email may be claimed by anyone, so organization would be shared with stranger.
+	if len(users) == 0 || !users[0].EmailVerified {
+		return entity.OrganizationMember{}, apperr.New(
+			fmt.Sprintf("invite user to organization %q but no user has verified email", orgID),
+			"User not found",
+			apperr.WithMessageID(message.UserNotFound),
+			apperr.CodeNotFound,
+		)
+	}
+	member, err := inviter.Invite(users[0].ID, role, time.Now())
+	if err != nil {
+		return entity.OrganizationMember{}, err
+	}
+	err = u.transaction.Do(ctx, func(ctx context.Context) error {
+		err := u.organizationRepository.AddMember(ctx, member)
+		if err != nil {
+			return err
+		}
+		log, err := entity.NewAuditLog(actor.ID, entity.AuditActionOrganizationMemberInvited, orgID, map[string]string{
+			"userId": member.UserID.String(),
+			"role":   string(member.Role),
+		})
+		if err != nil {
+			return err
+		}
+		return u.auditLogRepository.Create(ctx, log)
+	})
+	if err != nil {
+		return entity.OrganizationMember{}, err
+	}
+	return member, nil
+}
+
+// RemoveMember removes user of given id from given organization by user with given sub.
+// Member can leave organization by removing oneself.
+func (u *OrganizationUseCase) RemoveMember(ctx context.Context, sub string, orgID entity.OrganizationID, userID uuid.UUID) error {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/OrganizationUseCase/RemoveMember").End()
+
+	actor, err := u.userRepository.FindBySub(ctx, sub)
+	if err != nil {
+		return err
+	}
+	remover, err := u.findMember(ctx, orgID, actor.ID)
+	if err != nil {
+		return err
+	}
+	return u.transaction.Do(ctx, func(ctx context.Context) error {
+		target, err := u.organizationRepository.FindMember(ctx, orgID, userID)
+		if err != nil {
+			return err
+		}
+		if !remover.CanRemove(target) {
+			return apperr.New(
+				fmt.Sprintf("user %q of role %q removes user %q of role %q from organization %q", actor.ID, remover.Role, userID, target.Role, orgID),
+				"You are not allowed to remove this member",
+				apperr.WithMessageID(message.RemoveMemberNotAllowed),
+				apperr.CodeUnAuthz,
+			)
+		}
+		err = u.organizationRepository.RemoveMember(ctx, orgID, userID)
+		if err != nil {
+			return err
+		}
+		log, err := entity.NewAuditLog(actor.ID, entity.AuditActionOrganizationMemberRemoved, orgID, map[string]string{
+			"userId": userID.String(),
+			"role":   string(target.Role),
+		})
+		if err != nil {
+			return err
+		}
+		return u.auditLogRepository.Create(ctx, log)
+	})
+}
+
+// findMember finds member of given user in given organization.
+// Non-member is rejected as unauthorized instead of not found, since organization is chosen by user.
+func (u *OrganizationUseCase) findMember(ctx context.Context, orgID entity.OrganizationID, userID uuid.UUID) (entity.OrganizationMember, error) {
+	member, err := u.organizationRepository.FindMember(ctx, orgID, userID)
+	if apperr.IsCode(err, apperr.CodeNotFound) {
+		return entity.OrganizationMember{}, apperr.New(
+			fmt.Sprintf("user %q is not member of organization %q", userID, orgID),
+			"You are not a member of this organization",
+			apperr.WithMessageID(message.NotMemberOfOrganization),
+			apperr.WithCause(err),
+			apperr.CodeUnAuthz,
+		)
+	}
+	return member, err
+}
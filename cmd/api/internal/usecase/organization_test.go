@@ -0,0 +1,319 @@
+package usecase_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/usecase"
+	"go-playground/pkg/apperr"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testOrganizationID      = "0194c3a1-0000-7000-8000-000000000001"
+	testOtherOrganizationID = "0194c3a1-0000-7000-8000-000000000002"
+)
+
+func memberOf(role entity.OrganizationRole) entity.OrganizationMember {
+	return entity.OrganizationMember{OrganizationID: testOrganizationID, UserID: testUserID, Role: role}
+}
+
+func notMember() error {
+	return apperr.New("find member", "not found member", apperr.CodeNotFound)
+}
+
+func TestOrganizationUseCase_CreateOrganization(t *testing.T) {
+	orgs := new(MockOrganizationRepository)
+	orgs.On("Create", context.Background(), mock.MatchedBy(func(o entity.Organization) bool {
+		return o.Name == "Platform team"
+	})).Return(nil).Once()
+	orgs.On("AddMember", context.Background(), mock.MatchedBy(func(m entity.OrganizationMember) bool {
+		return m.UserID == testUserID && m.Role == entity.OrganizationRoleOwner
+	})).Return(nil).Once()
+	logs := new(MockAuditLogRepository)
+	logs.On("Create", context.Background(), mock.MatchedBy(func(l entity.AuditLog) bool {
+		return l.ActorID == testUserID && l.Action == entity.AuditActionOrganizationCreated
+	})).Return(nil).Once()
+	u := usecase.NewOrganizationUseCase(newMockUserRepository(), orgs, logs, new(MockTransactionRepository))
+
+	got, err := u.CreateOrganization(context.Background(), testSub, "Platform team")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Platform team", got.Name)
+	orgs.AssertExpectations(t)
+	logs.AssertExpectations(t)
+}
+
+func TestOrganizationUseCase_ActiveOrganization(t *testing.T) {
+	type want struct {
+		id      string
+		err     string
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		requested string
+		setup     func() *MockOrganizationRepository
+		want      want
+	}{
+		"success requested organization of member": {
+			requested: testOrganizationID,
+			setup: func() *MockOrganizationRepository {
+				orgs := new(MockOrganizationRepository)
+				orgs.On("FindMember", context.Background(), testOrganizationID, testUserID).Return(memberOf(entity.OrganizationRoleMember), nil)
+				return orgs
+			},
+			want: want{id: testOrganizationID},
+		},
+		"success only organization of user": {
+			setup: func() *MockOrganizationRepository {
+				orgs := new(MockOrganizationRepository)
+				orgs.On("ListByUserID", context.Background(), testUserID).Return([]entity.Organization{{ID: testOrganizationID}}, nil)
+				return orgs
+			},
+			want: want{id: testOrganizationID},
+		},
+		"success no organization for user of several organizations": {
+			setup: func() *MockOrganizationRepository {
+				orgs := new(MockOrganizationRepository)
+				orgs.On("ListByUserID", context.Background(), testUserID).Return([]entity.Organization{{ID: testOrganizationID}, {ID: testOtherOrganizationID}}, nil)
+				return orgs
+			},
+		},
+		"failure requested organization of non-member": {
+			requested: testOtherOrganizationID,
+			setup: func() *MockOrganizationRepository {
+				orgs := new(MockOrganizationRepository)
+				orgs.On("FindMember", context.Background(), testOtherOrganizationID, testUserID).Return(entity.OrganizationMember{}, notMember())
+				return orgs
+			},
+			want: want{
+				err:     `user "01930c3a-e82b-700a-b41a-6f58b5c2b812" is not member of organization "0194c3a1-0000-7000-8000-000000000002": find member`,
+				errCode: apperr.CodeUnAuthz,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			u := usecase.NewOrganizationUseCase(newMockUserRepository(), tc.setup(), nil, new(MockTransactionRepository))
+
+			got, err := u.ActiveOrganization(context.Background(), testUserID, tc.requested)
+
+			if tc.want.err != "" {
+				assert.ErrorContains(t, err, tc.want.err)
+				assert.True(t, apperr.IsCode(err, tc.want.errCode))
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.want.id, got)
+		})
+	}
+}
+
+func TestOrganizationUseCase_ListMembers(t *testing.T) {
+	members := []entity.OrganizationMember{memberOf(entity.OrganizationRoleMember)}
+	t.Run("success", func(t *testing.T) {
+		orgs := new(MockOrganizationRepository)
+		orgs.On("FindMember", context.Background(), testOrganizationID, testUserID).Return(memberOf(entity.OrganizationRoleMember), nil)
+		orgs.On("ListMembers", context.Background(), testOrganizationID).Return(members, nil)
+		u := usecase.NewOrganizationUseCase(newMockUserRepository(), orgs, nil, new(MockTransactionRepository))
+
+		got, err := u.ListMembers(context.Background(), testSub, testOrganizationID)
+
+		require.NoError(t, err)
+		assert.Equal(t, members, got)
+	})
+	t.Run("failure non-member", func(t *testing.T) {
+		orgs := new(MockOrganizationRepository)
+		orgs.On("FindMember", context.Background(), testOrganizationID, testUserID).Return(entity.OrganizationMember{}, notMember())
+		u := usecase.NewOrganizationUseCase(newMockUserRepository(), orgs, nil, new(MockTransactionRepository))
+
+		got, err := u.ListMembers(context.Background(), testSub, testOrganizationID)
+
+		assert.Nil(t, got)
+		assert.True(t, apperr.IsCode(err, apperr.CodeUnAuthz))
+	})
+}
+
+func TestOrganizationUseCase_InviteMember(t *testing.T) {
+	email := "invitee@example.com"
+	type want struct {
+		err     string
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		role  entity.OrganizationRole
+		setup func() (*MockUserRepository, *MockOrganizationRepository, *MockAuditLogRepository)
+		want  want
+	}{
+		"success": {
+			role: entity.OrganizationRoleMember,
+			setup: func() (*MockUserRepository, *MockOrganizationRepository, *MockAuditLogRepository) {
+				users := newMockUserRepository()
+				users.On("ListByEmails", context.Background(), []string{email}).Return([]entity.User{{ID: testTargetUserID, Email: email, EmailVerified: true}}, nil)
+				orgs := new(MockOrganizationRepository)
+				orgs.On("FindMember", context.Background(), testOrganizationID, testUserID).Return(memberOf(entity.OrganizationRoleAdmin), nil)
+				orgs.On("AddMember", context.Background(), mock.MatchedBy(func(m entity.OrganizationMember) bool {
+					return m.OrganizationID == testOrganizationID && m.UserID == testTargetUserID && m.Role == entity.OrganizationRoleMember
+				})).Return(nil).Once()
+				logs := new(MockAuditLogRepository)
+				logs.On("Create", context.Background(), auditLogOf(entity.AuditActionOrganizationMemberInvited, testOrganizationID)).Return(nil).Once()
+				return users, orgs, logs
+			},
+		},
+		"failure invitee has not verified email": {
+			role: entity.OrganizationRoleMember,
+			setup: func() (*MockUserRepository, *MockOrganizationRepository, *MockAuditLogRepository) {
+				users := newMockUserRepository()
+				users.On("ListByEmails", context.Background(), []string{email}).Return([]entity.User{{ID: testTargetUserID, Email: email}}, nil)
+				orgs := new(MockOrganizationRepository)
+				orgs.On("FindMember", context.Background(), testOrganizationID, testUserID).Return(memberOf(entity.OrganizationRoleOwner), nil)
+				return users, orgs, new(MockAuditLogRepository)
+			},
+			want: want{
+				err:     `invite user to organization "0194c3a1-0000-7000-8000-000000000001" but no user has verified email`,
+				errCode: apperr.CodeNotFound,
+			},
+		},
+		"failure invitee is unknown": {
+			role: entity.OrganizationRoleMember,
+			setup: func() (*MockUserRepository, *MockOrganizationRepository, *MockAuditLogRepository) {
+				users := newMockUserRepository()
+				users.On("ListByEmails", context.Background(), []string{email}).Return([]entity.User{}, nil)
+				orgs := new(MockOrganizationRepository)
+				orgs.On("FindMember", context.Background(), testOrganizationID, testUserID).Return(memberOf(entity.OrganizationRoleOwner), nil)
+				return users, orgs, new(MockAuditLogRepository)
+			},
+			want: want{
+				err:     `invite user to organization "0194c3a1-0000-7000-8000-000000000001" but no user has verified email`,
+				errCode: apperr.CodeNotFound,
+			},
+		},
+		"failure more than one user has email": {
+			role: entity.OrganizationRoleMember,
+			setup: func() (*MockUserRepository, *MockOrganizationRepository, *MockAuditLogRepository) {
+				users := newMockUserRepository()
+				users.On("ListByEmails", context.Background(), []string{email}).Return([]entity.User{
+					{ID: testTargetUserID, Email: email, EmailVerified: true},
+					{ID: testUserID, Email: email, EmailVerified: true},
+				}, nil)
+				orgs := new(MockOrganizationRepository)
+				orgs.On("FindMember", context.Background(), testOrganizationID, testUserID).Return(memberOf(entity.OrganizationRoleOwner), nil)
+				return users, orgs, new(MockAuditLogRepository)
+			},
+			want: want{
+				err:     `invite user to organization "0194c3a1-0000-7000-8000-000000000001" but 2 users have email`,
+				errCode: apperr.CodeFailedPrecondition,
+			},
+		},
+		"failure member can not invite": {
+			role: entity.OrganizationRoleMember,
+			setup: func() (*MockUserRepository, *MockOrganizationRepository, *MockAuditLogRepository) {
+				users := newMockUserRepository()
+				users.On("ListByEmails", context.Background(), []string{email}).Return([]entity.User{{ID: testTargetUserID, Email: email, EmailVerified: true}}, nil)
+				orgs := new(MockOrganizationRepository)
+				orgs.On("FindMember", context.Background(), testOrganizationID, testUserID).Return(memberOf(entity.OrganizationRoleMember), nil)
+				return users, orgs, new(MockAuditLogRepository)
+			},
+			want: want{
+				err:     `user "01930c3a-e82b-700a-b41a-6f58b5c2b812" of role "member" invites member to organization "0194c3a1-0000-7000-8000-000000000001" with role "member"`,
+				errCode: apperr.CodeUnAuthz,
+			},
+		},
+		"failure non-member can not invite": {
+			role: entity.OrganizationRoleMember,
+			setup: func() (*MockUserRepository, *MockOrganizationRepository, *MockAuditLogRepository) {
+				orgs := new(MockOrganizationRepository)
+				orgs.On("FindMember", context.Background(), testOrganizationID, testUserID).Return(entity.OrganizationMember{}, notMember())
+				return newMockUserRepository(), orgs, new(MockAuditLogRepository)
+			},
+			want: want{
+				err:     `user "01930c3a-e82b-700a-b41a-6f58b5c2b812" is not member of organization "0194c3a1-0000-7000-8000-000000000001": find member`,
+				errCode: apperr.CodeUnAuthz,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			users, orgs, logs := tc.setup()
+			u := usecase.NewOrganizationUseCase(users, orgs, logs, new(MockTransactionRepository))
+
+			got, err := u.InviteMember(context.Background(), testSub, testOrganizationID, email, tc.role)
+
+			if tc.want.err != "" {
+				assert.Zero(t, got)
+				assert.ErrorContains(t, err, tc.want.err)
+				assert.True(t, apperr.IsCode(err, tc.want.errCode))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testTargetUserID, got.UserID)
+			}
+			orgs.AssertExpectations(t)
+			logs.AssertExpectations(t)
+		})
+	}
+}
+
+func TestOrganizationUseCase_RemoveMember(t *testing.T) {
+	target := entity.OrganizationMember{OrganizationID: testOrganizationID, UserID: testTargetUserID, Role: entity.OrganizationRoleMember}
+	type want struct {
+		err     string
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		setup func() (*MockOrganizationRepository, *MockAuditLogRepository)
+		want  want
+	}{
+		"success": {
+			setup: func() (*MockOrganizationRepository, *MockAuditLogRepository) {
+				orgs := new(MockOrganizationRepository)
+				orgs.On("FindMember", context.Background(), testOrganizationID, testUserID).Return(memberOf(entity.OrganizationRoleAdmin), nil)
+				orgs.On("FindMember", context.Background(), testOrganizationID, testTargetUserID).Return(target, nil)
+				orgs.On("RemoveMember", context.Background(), testOrganizationID, testTargetUserID).Return(nil).Once()
+				logs := new(MockAuditLogRepository)
+				logs.On("Create", context.Background(), auditLogOf(entity.AuditActionOrganizationMemberRemoved, testOrganizationID)).Return(nil).Once()
+				return orgs, logs
+			},
+		},
+		"failure member can not remove other member": {
+			setup: func() (*MockOrganizationRepository, *MockAuditLogRepository) {
+				orgs := new(MockOrganizationRepository)
+				orgs.On("FindMember", context.Background(), testOrganizationID, testUserID).Return(memberOf(entity.OrganizationRoleMember), nil)
+				orgs.On("FindMember", context.Background(), testOrganizationID, testTargetUserID).Return(target, nil)
+				return orgs, new(MockAuditLogRepository)
+			},
+			want: want{
+				err:     `user "01930c3a-e82b-700a-b41a-6f58b5c2b812" of role "member" removes user "01930c3b-e82b-700a-b41a-6f58b5c2b813" of role "member" from organization "0194c3a1-0000-7000-8000-000000000001"`,
+				errCode: apperr.CodeUnAuthz,
+			},
+		},
+		"failure target is not member": {
+			setup: func() (*MockOrganizationRepository, *MockAuditLogRepository) {
+				orgs := new(MockOrganizationRepository)
+				orgs.On("FindMember", context.Background(), testOrganizationID, testUserID).Return(memberOf(entity.OrganizationRoleOwner), nil)
+				orgs.On("FindMember", context.Background(), testOrganizationID, testTargetUserID).Return(entity.OrganizationMember{}, notMember())
+				return orgs, new(MockAuditLogRepository)
+			},
+			want: want{err: "find member", errCode: apperr.CodeNotFound},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			orgs, logs := tc.setup()
+			u := usecase.NewOrganizationUseCase(newMockUserRepository(), orgs, logs, new(MockTransactionRepository))
+
+			err := u.RemoveMember(context.Background(), testSub, testOrganizationID, testTargetUserID)
+
+			if tc.want.err != "" {
+				assert.ErrorContains(t, err, tc.want.err)
+				assert.True(t, apperr.IsCode(err, tc.want.errCode))
+			} else {
+				assert.NoError(t, err)
+			}
+			orgs.AssertExpectations(t)
+			logs.AssertExpectations(t)
+		})
+	}
+}
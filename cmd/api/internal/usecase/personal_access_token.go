@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/domain/repository"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// PersonalAccessTokenUseCase handles personal access tokens of user.
+type PersonalAccessTokenUseCase struct {
+	transaction                   repository.TransactionRepository
+	userRepository                repository.UserRepository
+	personalAccessTokenRepository repository.PersonalAccessTokenRepository
+	auditLogRepository            repository.AuditLogRepository
+}
+
+// NewPersonalAccessTokenUseCase creates PersonalAccessTokenUseCase.
+func NewPersonalAccessTokenUseCase(
+	userRepo repository.UserRepository,
+	personalAccessTokenRepo repository.PersonalAccessTokenRepository,
+	auditLogRepo repository.AuditLogRepository,
+	transaction repository.TransactionRepository,
+) *PersonalAccessTokenUseCase {
+	return &PersonalAccessTokenUseCase{
+		transaction:                   transaction,
+		userRepository:                userRepo,
+		personalAccessTokenRepository: personalAccessTokenRepo,
+		auditLogRepository:            auditLogRepo,
+	}
+}
+
+// CreateToken creates personal access token of user with given sub and returns it with raw token.
+// granted is scopes of credential used for this request. Token can not be granted scopes beyond them.
+func (u *PersonalAccessTokenUseCase) CreateToken(
+	ctx context.Context,
+	sub string,
+	granted []string,
+	name string,
+	scopes []string,
+	expiresAt time.Time,
+) (entity.PersonalAccessToken, string, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/PersonalAccessTokenUseCase/CreateToken").End()
+
+	for _, scope := range scopes {
+		if !slices.Contains(granted, scope) {
+			return entity.PersonalAccessToken{}, "", apperr.New(
+				fmt.Sprintf("create personal access token with scope %q not granted to credential", scope),
+				"Token can not be granted scopes you do not have",
+				apperr.WithMessageID(message.TokenScopeNotGranted),
+				apperr.CodeUnAuthz,
+			)
+		}
+	}
+	user, err := u.userRepository.FindBySub(ctx, sub)
+	if err != nil {
+		return entity.PersonalAccessToken{}, "", err
+	}
+	token, raw, err := entity.NewPersonalAccessToken(user.ID, name, scopes, expiresAt, time.Now())
+	if err != nil {
+		return entity.PersonalAccessToken{}, "", err
+	}
+	err = u.transaction.Do(ctx, func(ctx context.Context) error {
+		err := u.personalAccessTokenRepository.Create(ctx, token)
+		if err != nil {
+			return err
+		}
+		log, err := entity.NewAuditLog(user.ID, entity.AuditActionPersonalAccessTokenCreated, token.ID, map[string]string{
+			"name":      token.Name,
+			"scopes":    strings.Join(token.Scopes, " "),
+			"expiresAt": token.ExpiresAt.Format(time.RFC3339),
+		})
+		if err != nil {
+			return err
+		}
+		return u.auditLogRepository.Create(ctx, log)
+	})
+	if err != nil {
+		return entity.PersonalAccessToken{}, "", err
+	}
+	return token, raw, nil
+}
+
+// ListTokens lists personal access tokens of user with given sub.
+func (u *PersonalAccessTokenUseCase) ListTokens(ctx context.Context, sub string) ([]entity.PersonalAccessToken, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/PersonalAccessTokenUseCase/ListTokens").End()
+
+	user, err := u.userRepository.FindBySub(ctx, sub)
+	if err != nil {
+		return nil, err
+	}
+	return u.personalAccessTokenRepository.ListByUserID(ctx, user.ID)
+}
+
+// RevokeToken revokes personal access token of given id owned by user with given sub.
+// Token of other user is reported as not found.
+func (u *PersonalAccessTokenUseCase) RevokeToken(ctx context.Context, sub string, id entity.PersonalAccessTokenID) error {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/PersonalAccessTokenUseCase/RevokeToken").End()
+
+	user, err := u.userRepository.FindBySub(ctx, sub)
+	if err != nil {
+		return err
+	}
+	return u.transaction.Do(ctx, func(ctx context.Context) error {
+		token, err := u.personalAccessTokenRepository.FindByIDForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+		if token.UserID != user.ID {
+			return apperr.New(fmt.Sprintf("user %q revokes personal access token %q of other user", user.ID, id), "Token not found", apperr.WithMessageID(message.TokenNotFound), apperr.CodeNotFound)
+		}
+		err = token.Revoke(time.Now())
+		if err != nil {
+			return err
+		}
+		err = u.personalAccessTokenRepository.Update(ctx, token)
+		if err != nil {
+			return err
+		}
+		log, err := entity.NewAuditLog(user.ID, entity.AuditActionPersonalAccessTokenRevoked, token.ID, nil)
+		if err != nil {
+			return err
+		}
+		return u.auditLogRepository.Create(ctx, log)
+	})
+}
+
+// Authenticate authenticates raw personal access token and returns subject of its owner and granted scopes.
+// Token of deleted owner is invalid, and token of disabled owner is rejected as well as access token of the owner.
+func (u *PersonalAccessTokenUseCase) Authenticate(ctx context.Context, raw string) (string, []string, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/PersonalAccessTokenUseCase/Authenticate").End()
+
+	token, err := u.personalAccessTokenRepository.FindByHash(ctx, entity.HashPersonalAccessToken(raw))
+	if err != nil {
+		if apperr.IsCode(err, apperr.CodeNotFound) {
+			return "", nil, apperr.New("authenticate unknown personal access token", "Invalid token", apperr.WithMessageID(message.InvalidToken), apperr.CodeUnAuthn)
+		}
+		return "", nil, err
+	}
+	err = token.Verify(time.Now())
+	if err != nil {
+		return "", nil, err
+	}
+	user, err := u.userRepository.FindByID(ctx, token.UserID)
+	if err != nil {
+		return "", nil, err
+	}
+	if user.Deleted() {
+		return "", nil, apperr.New(fmt.Sprintf("authenticate personal access token %q of deleted user %q", token.ID, user.ID), "Invalid token", apperr.WithMessageID(message.InvalidToken), apperr.CodeUnAuthn)
+	}
+	if user.Disabled() {
+		return "", nil, apperr.New(fmt.Sprintf("authenticate personal access token %q of disabled user %q", token.ID, user.ID), "Account is disabled", apperr.WithMessageID(message.AccountDisabled), apperr.CodeUnAuthz)
+	}
+	return user.Sub, token.Scopes, nil
+}
@@ -0,0 +1,238 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/usecase"
+	"go-playground/pkg/apperr"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersonalAccessTokenUseCase_CreateToken(t *testing.T) {
+	expiresAt := time.Now().Add(24 * time.Hour)
+	type want struct {
+		err     string
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		scopes []string
+		setup  func() (*MockUserRepository, *MockPersonalAccessTokenRepository, *MockAuditLogRepository)
+		want   want
+	}{
+		"success": {
+			scopes: []string{"read:tasks"},
+			setup: func() (*MockUserRepository, *MockPersonalAccessTokenRepository, *MockAuditLogRepository) {
+				users := new(MockUserRepository)
+				users.On("FindBySub", context.Background(), testSub).Return(entity.User{ID: testUserID, Sub: testSub}, nil)
+				tokens := new(MockPersonalAccessTokenRepository)
+				tokens.On("Create", context.Background(), mock.MatchedBy(func(token entity.PersonalAccessToken) bool {
+					return token.UserID == testUserID && token.Name == "ci"
+				})).Return(nil).Once()
+				logs := new(MockAuditLogRepository)
+				logs.On("Create", context.Background(), mock.MatchedBy(func(l entity.AuditLog) bool {
+					return l.ActorID == testUserID && l.Action == entity.AuditActionPersonalAccessTokenCreated && l.Detail["scopes"] == "read:tasks"
+				})).Return(nil).Once()
+				return users, tokens, logs
+			},
+		},
+		"failure scope beyond credential": {
+			scopes: []string{"read:tasks", "write:users"},
+			setup: func() (*MockUserRepository, *MockPersonalAccessTokenRepository, *MockAuditLogRepository) {
+				return new(MockUserRepository), new(MockPersonalAccessTokenRepository), new(MockAuditLogRepository)
+			},
+			want: want{
+				err:     `create personal access token with scope "write:users" not granted to credential`,
+				errCode: apperr.CodeUnAuthz,
+			},
+		},
+		"failure invalid token": {
+			scopes: []string{},
+			setup: func() (*MockUserRepository, *MockPersonalAccessTokenRepository, *MockAuditLogRepository) {
+				users := new(MockUserRepository)
+				users.On("FindBySub", context.Background(), testSub).Return(entity.User{ID: testUserID, Sub: testSub}, nil)
+				return users, new(MockPersonalAccessTokenRepository), new(MockAuditLogRepository)
+			},
+			want: want{
+				err:     "validate personal access token entity",
+				errCode: apperr.CodeInvalidArgument,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			users, tokens, logs := tc.setup()
+			u := usecase.NewPersonalAccessTokenUseCase(users, tokens, logs, new(MockTransactionRepository))
+
+			got, raw, err := u.CreateToken(context.Background(), testSub, []string{"read:tasks", "write:tasks"}, "ci", tc.scopes, expiresAt)
+
+			if tc.want.err != "" {
+				assert.Zero(t, got)
+				assert.Empty(t, raw)
+				assert.ErrorContains(t, err, tc.want.err)
+				assert.True(t, apperr.IsCode(err, tc.want.errCode))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, entity.HashPersonalAccessToken(raw), got.Hash)
+			}
+			users.AssertExpectations(t)
+			tokens.AssertExpectations(t)
+			logs.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPersonalAccessTokenUseCase_RevokeToken(t *testing.T) {
+	const tokenID = "0193dd96-47aa-755b-806e-0b22d6f1849b"
+	type want struct {
+		err     string
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		setup func() (*MockPersonalAccessTokenRepository, *MockAuditLogRepository)
+		want  want
+	}{
+		"success": {
+			setup: func() (*MockPersonalAccessTokenRepository, *MockAuditLogRepository) {
+				tokens := new(MockPersonalAccessTokenRepository)
+				tokens.On("FindByIDForUpdate", context.Background(), tokenID).Return(entity.PersonalAccessToken{ID: tokenID, UserID: testUserID}, nil)
+				tokens.On("Update", context.Background(), mock.MatchedBy(entity.PersonalAccessToken.Revoked)).Return(nil).Once()
+				logs := new(MockAuditLogRepository)
+				logs.On("Create", context.Background(), auditLogOf(entity.AuditActionPersonalAccessTokenRevoked, tokenID)).Return(nil).Once()
+				return tokens, logs
+			},
+		},
+		"failure token of other user": {
+			setup: func() (*MockPersonalAccessTokenRepository, *MockAuditLogRepository) {
+				tokens := new(MockPersonalAccessTokenRepository)
+				tokens.On("FindByIDForUpdate", context.Background(), tokenID).Return(entity.PersonalAccessToken{ID: tokenID, UserID: testTargetUserID}, nil)
+				return tokens, new(MockAuditLogRepository)
+			},
+			want: want{
+				err:     `user "01930c3a-e82b-700a-b41a-6f58b5c2b812" revokes personal access token "0193dd96-47aa-755b-806e-0b22d6f1849b" of other user`,
+				errCode: apperr.CodeNotFound,
+			},
+		},
+		"failure already revoked": {
+			setup: func() (*MockPersonalAccessTokenRepository, *MockAuditLogRepository) {
+				tokens := new(MockPersonalAccessTokenRepository)
+				tokens.On("FindByIDForUpdate", context.Background(), tokenID).Return(entity.PersonalAccessToken{ID: tokenID, UserID: testUserID, RevokedAt: time.Now()}, nil)
+				return tokens, new(MockAuditLogRepository)
+			},
+			want: want{
+				err:     `revoke personal access token "0193dd96-47aa-755b-806e-0b22d6f1849b" but token is already revoked`,
+				errCode: apperr.CodeFailedPrecondition,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			users := new(MockUserRepository)
+			users.On("FindBySub", context.Background(), testSub).Return(entity.User{ID: testUserID, Sub: testSub}, nil)
+			tokens, logs := tc.setup()
+			u := usecase.NewPersonalAccessTokenUseCase(users, tokens, logs, new(MockTransactionRepository))
+
+			err := u.RevokeToken(context.Background(), testSub, tokenID)
+
+			if tc.want.err != "" {
+				assert.EqualError(t, err, tc.want.err)
+				assert.True(t, apperr.IsCode(err, tc.want.errCode))
+			} else {
+				assert.NoError(t, err)
+			}
+			tokens.AssertExpectations(t)
+			logs.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPersonalAccessTokenUseCase_Authenticate(t *testing.T) {
+	const raw = "pat_secret"
+	hash := entity.HashPersonalAccessToken(raw)
+	type want struct {
+		sub     string
+		scopes  []string
+		err     string
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		setup func() (*MockUserRepository, *MockPersonalAccessTokenRepository)
+		want  want
+	}{
+		"success": {
+			setup: func() (*MockUserRepository, *MockPersonalAccessTokenRepository) {
+				tokens := new(MockPersonalAccessTokenRepository)
+				tokens.On("FindByHash", context.Background(), hash).Return(entity.PersonalAccessToken{
+					ID: "id1", UserID: testUserID, Scopes: []string{"read:tasks"}, ExpiresAt: time.Now().Add(time.Hour),
+				}, nil)
+				users := new(MockUserRepository)
+				users.On("FindByID", context.Background(), testUserID).Return(entity.User{ID: testUserID, Sub: testSub}, nil)
+				return users, tokens
+			},
+			want: want{sub: testSub, scopes: []string{"read:tasks"}},
+		},
+		"failure unknown token": {
+			setup: func() (*MockUserRepository, *MockPersonalAccessTokenRepository) {
+				tokens := new(MockPersonalAccessTokenRepository)
+				tokens.On("FindByHash", context.Background(), hash).Return(entity.PersonalAccessToken{}, apperr.New("not found", "invalid token", apperr.CodeNotFound))
+				return new(MockUserRepository), tokens
+			},
+			want: want{err: "authenticate unknown personal access token", errCode: apperr.CodeUnAuthn},
+		},
+		"failure expired token": {
+			setup: func() (*MockUserRepository, *MockPersonalAccessTokenRepository) {
+				expiresAt := time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC)
+				tokens := new(MockPersonalAccessTokenRepository)
+				tokens.On("FindByHash", context.Background(), hash).Return(entity.PersonalAccessToken{ID: "id1", UserID: testUserID, ExpiresAt: expiresAt}, nil)
+				return new(MockUserRepository), tokens
+			},
+			want: want{err: `personal access token "id1" is expired at 2024-11-08 14:40:33 +0000 UTC`, errCode: apperr.CodeUnAuthn},
+		},
+		"failure owner is deleted": {
+			setup: func() (*MockUserRepository, *MockPersonalAccessTokenRepository) {
+				tokens := new(MockPersonalAccessTokenRepository)
+				tokens.On("FindByHash", context.Background(), hash).Return(entity.PersonalAccessToken{
+					ID: "id1", UserID: testUserID, Scopes: []string{"read:tasks"}, ExpiresAt: time.Now().Add(time.Hour),
+				}, nil)
+				users := new(MockUserRepository)
+				users.On("FindByID", context.Background(), testUserID).Return(entity.User{ID: testUserID, Sub: testSub, DeletedAt: time.Now()}, nil)
+				return users, tokens
+			},
+			want: want{err: fmt.Sprintf(`authenticate personal access token "id1" of deleted user %q`, testUserID), errCode: apperr.CodeUnAuthn},
+		},
+		"failure owner is disabled": {
+			setup: func() (*MockUserRepository, *MockPersonalAccessTokenRepository) {
+				tokens := new(MockPersonalAccessTokenRepository)
+				tokens.On("FindByHash", context.Background(), hash).Return(entity.PersonalAccessToken{
+					ID: "id1", UserID: testUserID, Scopes: []string{"read:tasks"}, ExpiresAt: time.Now().Add(time.Hour),
+				}, nil)
+				users := new(MockUserRepository)
+				users.On("FindByID", context.Background(), testUserID).Return(entity.User{ID: testUserID, Sub: testSub, DisabledAt: time.Now()}, nil)
+				return users, tokens
+			},
+			want: want{err: fmt.Sprintf(`authenticate personal access token "id1" of disabled user %q`, testUserID), errCode: apperr.CodeUnAuthz},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			users, tokens := tc.setup()
+			u := usecase.NewPersonalAccessTokenUseCase(users, tokens, new(MockAuditLogRepository), new(MockTransactionRepository))
+
+			sub, scopes, err := u.Authenticate(context.Background(), raw)
+
+			if tc.want.err != "" {
+				assert.EqualError(t, err, tc.want.err)
+				assert.True(t, apperr.IsCode(err, tc.want.errCode))
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.want.sub, sub)
+			assert.Equal(t, tc.want.scopes, scopes)
+		})
+	}
+}
@@ -4,29 +4,46 @@ import (
 	"context"
 	"go-playground/cmd/api/internal/domain/entity"
 	"go-playground/cmd/api/internal/domain/repository"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
 
+	"github.com/google/uuid"
 	"github.com/newrelic/go-agent/v3/newrelic"
 )
 
 type TaskUseCase struct {
-	transaction    repository.TransactionRepository
-	taskRepository repository.TaskRepository
+	transaction            repository.TransactionRepository
+	taskRepository         repository.TaskRepository
+	userRepository         repository.UserRepository
+	organizationRepository repository.OrganizationRepository
+	mentionRepository      repository.MentionRepository
 }
 
 const (
 	LimitListTasks int32 = 10
 )
 
-func NewTaskUseCase(taskRepo repository.TaskRepository, transaction repository.TransactionRepository) *TaskUseCase {
-	return &TaskUseCase{transaction: transaction, taskRepository: taskRepo}
+func NewTaskUseCase(
+	taskRepo repository.TaskRepository,
+	userRepo repository.UserRepository,
+	organizationRepo repository.OrganizationRepository,
+	mentionRepo repository.MentionRepository,
+	transaction repository.TransactionRepository,
+) *TaskUseCase {
+	return &TaskUseCase{
+		transaction:            transaction,
+		taskRepository:         taskRepo,
+		userRepository:         userRepo,
+		organizationRepository: organizationRepo,
+		mentionRepository:      mentionRepo,
+	}
 }
 
 func (u *TaskUseCase) ListTasks(ctx context.Context, next string, limit int32) (entity.Page[entity.Task], error) {
 	defer newrelic.FromContext(ctx).StartSegment("usecase/TaskUseCase/ListTasks").End()
 
-	if limit == 0 {
-		limit = LimitListTasks
-	}
+	limit = limitOrPreferred(ctx, limit, LimitListTasks)
 	cursor, err := entity.DecodeTaskCursor(next)
 	if err != nil {
 		return entity.Page[entity.Task]{}, err
@@ -44,14 +61,25 @@ func (u *TaskUseCase) FindTaskByID(ctx context.Context, id string) (entity.Task,
 	return task, nil
 }
 
-func (u *TaskUseCase) CreateTask(ctx context.Context, content string) (entity.TaskID, error) {
+// CreateTask creates task with given content owned by user of given sub.
+func (u *TaskUseCase) CreateTask(ctx context.Context, sub string, content string) (entity.TaskID, error) {
 	defer newrelic.FromContext(ctx).StartSegment("usecase/TaskUseCase/CreateTask").End()
 
-	task, err := entity.NewTask(content)
+	user, err := u.userRepository.FindBySub(ctx, sub)
+	if err != nil {
+		return "", err
+	}
+	task, err := entity.NewTask(content, user.ID)
 	if err != nil {
 		return "", err
 	}
-	err = u.taskRepository.Create(ctx, task)
+	err = u.transaction.Do(ctx, func(ctx context.Context) error {
+		err := u.taskRepository.Create(ctx, task)
+		if err != nil {
+			return err
+		}
+		return u.saveMentions(ctx, task)
+	})
 	if err != nil {
 		return "", err
 	}
@@ -74,6 +102,80 @@ func (u *TaskUseCase) UpdateTask(ctx context.Context, id string, content string)
 		if err != nil {
 			return err
 		}
-		return nil
+		return u.saveMentions(ctx, task)
 	})
 }
+
+// ListMentionedTasks lists tasks mentioning user of given sub.
+func (u *TaskUseCase) ListMentionedTasks(ctx context.Context, sub string, next string, limit int32) (entity.Page[entity.Task], error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/TaskUseCase/ListMentionedTasks").End()
+
+	limit = limitOrPreferred(ctx, limit, LimitListTasks)
+	cursor, err := entity.DecodeTaskCursor(next)
+	if err != nil {
+		return entity.Page[entity.Task]{}, err
+	}
+	user, err := u.userRepository.FindBySub(ctx, sub)
+	if err != nil {
+		return entity.Page[entity.Task]{}, err
+	}
+	return u.mentionRepository.ListMentionedTasks(ctx, user.ID, cursor.ID, limit)
+}
+
+// saveMentions resolves mentions in task content to users and replaces mentions of task.
+//
+// Mentions are resolved by email only since users have no username yet.
+// Mention shares task with mentioned user, so only users who verified the email are resolved.
+// Task is visible to members of active organization only, so users out of the organization are not resolved either.
+func (u *TaskUseCase) saveMentions(ctx context.Context, task entity.Task) error {
+	var emails []string
+	for _, m := range entity.ParseMentions(task.Content) {
+		if m.Email != "" {
+			emails = append(emails, m.Email)
+		}
+	}
+	var userIDs []uuid.UUID
+	if len(emails) > 0 {
+		users, err := u.userRepository.ListByEmails(ctx, emails)
+		if err != nil {
+			return err
+		}
+		var verified []uuid.UUID
+		for _, user := range users {
+			if user.EmailVerified {
+				verified = append(verified, user.ID)
+			}
+		}
+		userIDs, err = u.filterMembers(ctx, verified)
+		if err != nil {
+			return err
+		}
+	}
+	return u.mentionRepository.ReplaceTaskMentions(ctx, task.ID, userIDs)
+}
+
+// filterMembers returns given users who are members of active organization, keeping the order.
+func (u *TaskUseCase) filterMembers(ctx context.Context, userIDs []uuid.UUID) ([]uuid.UUID, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	orgID, ok := ctxhelper.OrganizationID(ctx)
+	if !ok {
+		return nil, apperr.New("resolve mentions without active organization", "Organization is not selected", apperr.WithMessageID(message.OrganizationNotSelected), apperr.CodeInvalidArgument)
+	}
+	members, err := u.organizationRepository.ListMembers(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	memberIDs := make(map[uuid.UUID]struct{}, len(members))
+	for _, m := range members {
+		memberIDs[m.UserID] = struct{}{}
+	}
+	var filtered []uuid.UUID
+	for _, id := range userIDs {
+		if _, ok := memberIDs[id]; ok {
+			filtered = append(filtered, id)
+		}
+	}
+	return filtered, nil
+}
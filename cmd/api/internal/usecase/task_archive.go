@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/domain/repository"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// MaxArchiveBatchSize is max number of tasks moved to archive in one transaction.
+const MaxArchiveBatchSize int32 = 1000
+
+// TaskArchiveUseCase handles moving tasks between active tasks and archive.
+type TaskArchiveUseCase struct {
+	transaction           repository.TransactionRepository
+	taskArchiveRepository repository.TaskArchiveRepository
+}
+
+// NewTaskArchiveUseCase creates TaskArchiveUseCase.
+func NewTaskArchiveUseCase(
+	taskArchiveRepo repository.TaskArchiveRepository,
+	transaction repository.TransactionRepository,
+) *TaskArchiveUseCase {
+	return &TaskArchiveUseCase{
+		transaction:           transaction,
+		taskArchiveRepository: taskArchiveRepo,
+	}
+}
+
+// ArchiveTasks moves tasks not updated since before to archive and returns number of moved tasks.
+//
+// Tasks are moved in batches of batchSize and each batch is committed in its own transaction,
+// so interrupted run can be resumed by running again. Concurrent runs never move the same task.
+func (u *TaskArchiveUseCase) ArchiveTasks(ctx context.Context, before time.Time, batchSize int32) (int64, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/TaskArchiveUseCase/ArchiveTasks").End()
+
+	if batchSize <= 0 || batchSize > MaxArchiveBatchSize {
+		return 0, apperr.New(
+			fmt.Sprintf("archive batch size %d is out of range", batchSize),
+			fmt.Sprintf("Batch size must be between 1 and %d", MaxArchiveBatchSize),
+			apperr.WithMessageID(message.BatchSizeOutOfRange, MaxArchiveBatchSize),
+			apperr.CodeInvalidArgument,
+		)
+	}
+	var total int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, apperr.New("archive tasks is canceled", "archive tasks is canceled", apperr.WithCause(err))
+		}
+		var n int64
+		err := u.transaction.Do(ctx, func(ctx context.Context) error {
+			var err error
+			n, err = u.taskArchiveRepository.ArchiveTasks(ctx, before, batchSize)
+			return err
+		})
+		if err != nil {
+			return total, err
+		}
+		total += n
+		if n < int64(batchSize) {
+			return total, nil
+		}
+	}
+}
+
+// UnarchiveTask moves archived task of given id back to active tasks.
+func (u *TaskArchiveUseCase) UnarchiveTask(ctx context.Context, id entity.TaskID) error {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/TaskArchiveUseCase/UnarchiveTask").End()
+
+	return u.transaction.Do(ctx, func(ctx context.Context) error {
+		return u.taskArchiveRepository.Unarchive(ctx, id)
+	})
+}
+
+// ListTasks lists tasks including archived tasks.
+func (u *TaskArchiveUseCase) ListTasks(ctx context.Context, next string, limit int32) (entity.Page[entity.Task], error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/TaskArchiveUseCase/ListTasks").End()
+
+	limit = limitOrPreferred(ctx, limit, LimitListTasks)
+	cursor, err := entity.DecodeTaskCursor(next)
+	if err != nil {
+		return entity.Page[entity.Task]{}, err
+	}
+	return u.taskArchiveRepository.ListTasks(ctx, cursor.ID, limit)
+}
@@ -0,0 +1,151 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/usecase"
+	"go-playground/pkg/apperr"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTaskArchiveUseCase_ArchiveTasks(t *testing.T) {
+	before := time.Date(2024, 7, 30, 0, 0, 0, 0, time.UTC)
+	type input struct {
+		batchSize int32
+	}
+	type want struct {
+		n       int64
+		err     string
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		input input
+		setup func(t *testing.T) *MockTaskArchiveRepository
+		want  want
+	}{
+		"success in multiple batches": {
+			input: input{batchSize: 2},
+			setup: func(t *testing.T) *MockTaskArchiveRepository {
+				mck := new(MockTaskArchiveRepository)
+				mck.On("ArchiveTasks", mock.Anything, before, int32(2)).Return(int64(2), nil).Twice()
+				mck.On("ArchiveTasks", mock.Anything, before, int32(2)).Return(int64(1), nil).Once()
+				return mck
+			},
+			want: want{n: 5},
+		},
+		"success with nothing to archive": {
+			input: input{batchSize: 2},
+			setup: func(t *testing.T) *MockTaskArchiveRepository {
+				mck := new(MockTaskArchiveRepository)
+				mck.On("ArchiveTasks", mock.Anything, before, int32(2)).Return(int64(0), nil).Once()
+				return mck
+			},
+			want: want{n: 0},
+		},
+		"failure in second batch returns archived count": {
+			input: input{batchSize: 2},
+			setup: func(t *testing.T) *MockTaskArchiveRepository {
+				mck := new(MockTaskArchiveRepository)
+				mck.On("ArchiveTasks", mock.Anything, before, int32(2)).Return(int64(2), nil).Once()
+				mck.On("ArchiveTasks", mock.Anything, before, int32(2)).Return(int64(0), errors.New("unexpected")).Once()
+				return mck
+			},
+			want: want{n: 2, err: "unexpected"},
+		},
+		"failure batch size is zero": {
+			input: input{batchSize: 0},
+			setup: func(t *testing.T) *MockTaskArchiveRepository { return new(MockTaskArchiveRepository) },
+			want:  want{err: "archive batch size 0 is out of range", errCode: apperr.CodeInvalidArgument},
+		},
+		"failure batch size is too large": {
+			input: input{batchSize: usecase.MaxArchiveBatchSize + 1},
+			setup: func(t *testing.T) *MockTaskArchiveRepository { return new(MockTaskArchiveRepository) },
+			want:  want{err: "archive batch size 1001 is out of range", errCode: apperr.CodeInvalidArgument},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mck := tc.setup(t)
+			u := usecase.NewTaskArchiveUseCase(mck, &MockTransactionRepository{})
+
+			got, err := u.ArchiveTasks(context.Background(), before, tc.input.batchSize)
+
+			assert.Equal(t, tc.want.n, got)
+			if tc.want.err != "" {
+				assert.EqualError(t, err, tc.want.err)
+				if tc.want.errCode != apperr.CodeUnknown {
+					assert.True(t, apperr.IsCode(err, tc.want.errCode))
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			mck.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskArchiveUseCase_ArchiveTasks_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	u := usecase.NewTaskArchiveUseCase(new(MockTaskArchiveRepository), &MockTransactionRepository{})
+
+	got, err := u.ArchiveTasks(ctx, time.Now(), 10)
+
+	assert.Zero(t, got)
+	assert.EqualError(t, err, "archive tasks is canceled: context canceled")
+}
+
+func TestTaskArchiveUseCase_UnarchiveTask(t *testing.T) {
+	tests := map[string]struct {
+		setup   func(t *testing.T) *MockTaskArchiveRepository
+		wantErr string
+	}{
+		"success": {
+			setup: func(t *testing.T) *MockTaskArchiveRepository {
+				mck := new(MockTaskArchiveRepository)
+				mck.On("Unarchive", mock.Anything, "0190fe59-6618-7811-8b28-a3e67969a4ef").Return(nil)
+				return mck
+			},
+		},
+		"failure": {
+			setup: func(t *testing.T) *MockTaskArchiveRepository {
+				mck := new(MockTaskArchiveRepository)
+				mck.On("Unarchive", mock.Anything, "0190fe59-6618-7811-8b28-a3e67969a4ef").Return(errors.New("not found"))
+				return mck
+			},
+			wantErr: "not found",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			u := usecase.NewTaskArchiveUseCase(tc.setup(t), &MockTransactionRepository{})
+
+			err := u.UnarchiveTask(context.Background(), "0190fe59-6618-7811-8b28-a3e67969a4ef")
+
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskArchiveUseCase_ListTasks(t *testing.T) {
+	page := entity.Page[entity.Task]{
+		Items: []entity.Task{{ID: "0193dd97-123b-7bbe-8229-fa6c91b07a0e", Archived: true}},
+	}
+	mck := new(MockTaskArchiveRepository)
+	mck.On("ListTasks", context.Background(), "0193dd96-47aa-755b-806e-0b22d6f1849b", int32(10)).Return(page, nil)
+	u := usecase.NewTaskArchiveUseCase(mck, &MockTransactionRepository{})
+
+	got, err := u.ListTasks(context.Background(), "ewogICJpZCI6ICIwMTkzZGQ5Ni00N2FhLTc1NWItODA2ZS0wYjIyZDZmMTg0OWIiCn0K", 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, page, got)
+}
@@ -6,11 +6,13 @@ import (
 	"go-playground/cmd/api/internal/domain/entity"
 	"go-playground/cmd/api/internal/usecase"
 	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -44,7 +46,7 @@ func TestTaskUseCase_ListTasks(t *testing.T) {
 						HasNext:   true,
 						NextToken: "0193dd97-565f-755f-8161-e3265eb7a5df",
 					}, nil)
-				u := usecase.NewTaskUseCase(mck, &MockTransactionRepository{})
+				u := usecase.NewTaskUseCase(mck, nil, nil, nil, &MockTransactionRepository{})
 				return u
 			},
 			want: want{
@@ -66,7 +68,7 @@ func TestTaskUseCase_ListTasks(t *testing.T) {
 						HasNext:   true,
 						NextToken: "0193dd97-565f-755f-8161-e3265eb7a5df",
 					}, nil)
-				u := usecase.NewTaskUseCase(mck, &MockTransactionRepository{})
+				u := usecase.NewTaskUseCase(mck, nil, nil, nil, &MockTransactionRepository{})
 				return u
 			},
 			want: want{
@@ -88,7 +90,7 @@ func TestTaskUseCase_ListTasks(t *testing.T) {
 						HasNext:   false,
 						NextToken: "",
 					}, nil)
-				return usecase.NewTaskUseCase(mck, &MockTransactionRepository{})
+				return usecase.NewTaskUseCase(mck, nil, nil, nil, &MockTransactionRepository{})
 			},
 			want: want{
 				tasks: entity.Page[entity.Task]{
@@ -98,10 +100,21 @@ func TestTaskUseCase_ListTasks(t *testing.T) {
 				},
 			},
 		},
+		"success with preferred page size": {
+			input: input{ctx: ctxhelper.WithPreferences(context.Background(), ctxhelper.UserPreferences{PageSize: 25})},
+			setup: func(t *testing.T) *usecase.TaskUseCase {
+				mck := new(MockTaskRepository)
+				mck.
+					On("ListTasks", ctxhelper.WithPreferences(context.Background(), ctxhelper.UserPreferences{PageSize: 25}), "", int32(25)).
+					Return(entity.Page[entity.Task]{}, nil)
+				return usecase.NewTaskUseCase(mck, nil, nil, nil, &MockTransactionRepository{})
+			},
+			want: want{tasks: entity.Page[entity.Task]{}},
+		},
 		"failure invalid token": {
 			input: input{ctx: context.Background(), next: "invalid"},
 			setup: func(t *testing.T) *usecase.TaskUseCase {
-				return usecase.NewTaskUseCase(nil, nil)
+				return usecase.NewTaskUseCase(nil, nil, nil, nil, nil)
 			},
 			want: want{err: "decode task cursor by base64: illegal base64 data at input byte 4", errCode: apperr.CodeInvalidArgument},
 		},
@@ -147,7 +160,7 @@ func TestTaskUseCase_FindTaskByID(t *testing.T) {
 				mck.
 					On("FindByID", context.Background(), "0193ddaa-6fdb-7bb6-b6ca-3ee5f131f1f4").
 					Return(entity.Task{ID: "0193ddaa-6fdb-7bb6-b6ca-3ee5f131f1f4"}, nil)
-				return usecase.NewTaskUseCase(mck, nil)
+				return usecase.NewTaskUseCase(mck, nil, nil, nil, nil)
 			},
 			want: want{task: entity.Task{ID: "0193ddaa-6fdb-7bb6-b6ca-3ee5f131f1f4"}},
 		},
@@ -158,7 +171,7 @@ func TestTaskUseCase_FindTaskByID(t *testing.T) {
 				mck.
 					On("FindByID", context.Background(), "0193ddb0-0054-777d-a60b-cee300725c64").
 					Return(entity.Task{}, apperr.New("find task", "task not found", apperr.WithCause(sql.ErrNoRows), apperr.CodeNotFound))
-				return usecase.NewTaskUseCase(mck, nil)
+				return usecase.NewTaskUseCase(mck, nil, nil, nil, nil)
 			},
 			want: want{err: "find task: sql: no rows in result set", errCode: apperr.CodeNotFound},
 		},
@@ -201,7 +214,7 @@ func TestTask_CreateTask(t *testing.T) {
 			setup: func(t *testing.T, i input) *usecase.TaskUseCase {
 				mck := new(MockTaskRepository)
 				matcher := mock.MatchedBy(func(task entity.Task) bool {
-					diff := cmp.Diff(task, entity.Task{Content: i.content}, cmpopts.IgnoreFields(entity.Task{}, "ID", "CreatedAt", "UpdatedAt"))
+					diff := cmp.Diff(task, entity.Task{Content: i.content, OwnerID: testUserID}, cmpopts.IgnoreFields(entity.Task{}, "ID", "CreatedAt", "UpdatedAt"))
 					require.Empty(t, diff)
 					require.NotZero(t, task.ID)
 					require.NotZero(t, task.CreatedAt)
@@ -211,16 +224,81 @@ func TestTask_CreateTask(t *testing.T) {
 				mck.
 					On("Create", context.Background(), matcher).
 					Return(nil)
-				return usecase.NewTaskUseCase(mck, nil)
+				user := new(MockUserRepository)
+				user.On("FindBySub", context.Background(), testSub).Return(entity.User{ID: testUserID}, nil)
+				mention := new(MockMentionRepository)
+				mention.On("ReplaceTaskMentions", context.Background(), mock.Anything, []uuid.UUID(nil)).Return(nil)
+				return usecase.NewTaskUseCase(mck, user, nil, mention, &MockTransactionRepository{})
+			},
+			want: want{},
+		},
+		"success with mentions": {
+			input: input{
+				ctx:     ctxhelper.WithOrganizationID(context.Background(), "0194c3a1-0000-7000-8000-000000000001"),
+				content: "review by ＠Jonathan74@example.com and @unknown@example.com and @unverified@example.com and @outsider@example.com, cc @taro",
+			},
+			setup: func(t *testing.T, i input) *usecase.TaskUseCase {
+				mck := new(MockTaskRepository)
+				mck.On("Create", i.ctx, mock.Anything).Return(nil)
+				user := new(MockUserRepository)
+				user.On("FindBySub", i.ctx, testSub).Return(entity.User{ID: testUserID}, nil)
+				user.
+					On("ListByEmails", i.ctx, []string{"jonathan74@example.com", "unknown@example.com", "unverified@example.com", "outsider@example.com"}).
+					Return([]entity.User{
+						{ID: uuid.MustParse("01930c3a-e82b-700a-b41a-6f58b5c2b812"), EmailVerified: true},
+						{ID: uuid.MustParse("01930c3a-e82b-700a-b41a-6f58b5c2b813"), EmailVerified: false},
+						{ID: uuid.MustParse("01930c3a-e82b-700a-b41a-6f58b5c2b814"), EmailVerified: true},
+					}, nil)
+				org := new(MockOrganizationRepository)
+				org.
+					On("ListMembers", i.ctx, "0194c3a1-0000-7000-8000-000000000001").
+					Return([]entity.OrganizationMember{
+						{OrganizationID: "0194c3a1-0000-7000-8000-000000000001", UserID: uuid.MustParse("01930c3a-e82b-700a-b41a-6f58b5c2b811")},
+						{OrganizationID: "0194c3a1-0000-7000-8000-000000000001", UserID: uuid.MustParse("01930c3a-e82b-700a-b41a-6f58b5c2b812")},
+					}, nil)
+				mention := new(MockMentionRepository)
+				mention.
+					On("ReplaceTaskMentions", i.ctx, mock.Anything, []uuid.UUID{uuid.MustParse("01930c3a-e82b-700a-b41a-6f58b5c2b812")}).
+					Return(nil)
+				return usecase.NewTaskUseCase(mck, user, org, mention, &MockTransactionRepository{})
 			},
 			want: want{},
 		},
+		"failure to create task with mentions without active organization": {
+			input: input{ctx: context.Background(), content: "review by @jonathan74@example.com"},
+			setup: func(t *testing.T, i input) *usecase.TaskUseCase {
+				mck := new(MockTaskRepository)
+				mck.On("Create", context.Background(), mock.Anything).Return(nil)
+				user := new(MockUserRepository)
+				user.On("FindBySub", context.Background(), testSub).Return(entity.User{ID: testUserID}, nil)
+				user.
+					On("ListByEmails", context.Background(), []string{"jonathan74@example.com"}).
+					Return([]entity.User{{ID: uuid.MustParse("01930c3a-e82b-700a-b41a-6f58b5c2b812"), EmailVerified: true}}, nil)
+				return usecase.NewTaskUseCase(mck, user, nil, nil, &MockTransactionRepository{})
+			},
+			want: want{err: "resolve mentions without active organization", errCode: apperr.CodeInvalidArgument},
+		},
+		"failure to create task when mentions can not be saved": {
+			input: input{ctx: context.Background(), content: "do test"},
+			setup: func(t *testing.T, i input) *usecase.TaskUseCase {
+				mck := new(MockTaskRepository)
+				mck.On("Create", context.Background(), mock.Anything).Return(nil)
+				mention := new(MockMentionRepository)
+				mention.
+					On("ReplaceTaskMentions", context.Background(), mock.Anything, []uuid.UUID(nil)).
+					Return(apperr.New("failed to save mentions", "failed to save mentions"))
+				user := new(MockUserRepository)
+				user.On("FindBySub", context.Background(), testSub).Return(entity.User{ID: testUserID}, nil)
+				return usecase.NewTaskUseCase(mck, user, nil, mention, &MockTransactionRepository{})
+			},
+			want: want{err: "failed to save mentions", errCode: apperr.CodeInternal},
+		},
 		"failure to create task when repository returned error": {
 			input: input{ctx: context.Background(), content: "do test"},
 			setup: func(t *testing.T, i input) *usecase.TaskUseCase {
 				mck := new(MockTaskRepository)
 				matcher := mock.MatchedBy(func(task entity.Task) bool {
-					diff := cmp.Diff(task, entity.Task{Content: i.content}, cmpopts.IgnoreFields(entity.Task{}, "ID", "CreatedAt", "UpdatedAt"))
+					diff := cmp.Diff(task, entity.Task{Content: i.content, OwnerID: testUserID}, cmpopts.IgnoreFields(entity.Task{}, "ID", "CreatedAt", "UpdatedAt"))
 					require.Empty(t, diff)
 					require.NotZero(t, task.ID)
 					require.NotZero(t, task.CreatedAt)
@@ -228,21 +306,36 @@ func TestTask_CreateTask(t *testing.T) {
 					return true
 				})
 				mck.On("Create", context.Background(), matcher).Return(apperr.New("failed to save", "failed to create task"))
-				return usecase.NewTaskUseCase(mck, nil)
+				user := new(MockUserRepository)
+				user.On("FindBySub", context.Background(), testSub).Return(entity.User{ID: testUserID}, nil)
+				return usecase.NewTaskUseCase(mck, user, nil, nil, &MockTransactionRepository{})
 			},
 			want: want{err: "failed to save", errCode: apperr.CodeInternal},
 		},
 		"failure to create task when content is blank": {
 			input: input{ctx: context.Background()},
-			setup: func(t *testing.T, i input) *usecase.TaskUseCase { return usecase.NewTaskUseCase(nil, nil) },
-			want:  want{err: "task content must be non empty", errCode: apperr.CodeInvalidArgument},
+			setup: func(t *testing.T, i input) *usecase.TaskUseCase {
+				user := new(MockUserRepository)
+				user.On("FindBySub", context.Background(), testSub).Return(entity.User{ID: testUserID}, nil)
+				return usecase.NewTaskUseCase(nil, user, nil, nil, nil)
+			},
+			want: want{err: "task content must be non empty", errCode: apperr.CodeInvalidArgument},
+		},
+		"failure to create task when user is not found": {
+			input: input{ctx: context.Background(), content: "do test"},
+			setup: func(t *testing.T, i input) *usecase.TaskUseCase {
+				user := new(MockUserRepository)
+				user.On("FindBySub", context.Background(), testSub).Return(entity.User{}, apperr.New("user not found", "User is not found", apperr.CodeNotFound))
+				return usecase.NewTaskUseCase(nil, user, nil, nil, nil)
+			},
+			want: want{err: "user not found", errCode: apperr.CodeNotFound},
 		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			u := tc.setup(t, tc.input)
 
-			got, err := u.CreateTask(tc.input.ctx, tc.input.content)
+			got, err := u.CreateTask(tc.input.ctx, testSub, tc.input.content)
 
 			if tc.want.err != "" {
 				assert.Zero(t, got)
@@ -292,7 +385,9 @@ func TestTask_UpdateTask(t *testing.T) {
 					return true
 				})
 				mck.On("Update", context.Background(), matcher).Return(nil)
-				return usecase.NewTaskUseCase(mck, &MockTransactionRepository{})
+				mention := new(MockMentionRepository)
+				mention.On("ReplaceTaskMentions", context.Background(), "0193df27-fa0e-7889-9563-2c265d14d185", []uuid.UUID(nil)).Return(nil)
+				return usecase.NewTaskUseCase(mck, nil, nil, mention, &MockTransactionRepository{})
 			},
 			want: want{},
 		},
@@ -317,7 +412,7 @@ func TestTask_UpdateTask(t *testing.T) {
 					return true
 				})
 				mck.On("Update", context.Background(), matcher).Return(apperr.New("failed to save", "failed to save"))
-				return usecase.NewTaskUseCase(mck, &MockTransactionRepository{})
+				return usecase.NewTaskUseCase(mck, nil, nil, nil, &MockTransactionRepository{})
 			},
 			want: want{err: "failed to save", errCode: apperr.CodeInternal},
 		},
@@ -331,7 +426,7 @@ func TestTask_UpdateTask(t *testing.T) {
 					CreatedAt: time.Date(2024, 12, 19, 0, 0, 0, 0, time.UTC),
 					UpdatedAt: time.Date(2024, 12, 19, 0, 0, 0, 0, time.UTC),
 				}, nil)
-				return usecase.NewTaskUseCase(mck, &MockTransactionRepository{})
+				return usecase.NewTaskUseCase(mck, nil, nil, nil, &MockTransactionRepository{})
 			},
 			want: want{err: "task content must be non empty", errCode: apperr.CodeInvalidArgument},
 		},
@@ -340,7 +435,7 @@ func TestTask_UpdateTask(t *testing.T) {
 			setup: func(t *testing.T) *usecase.TaskUseCase {
 				mck := new(MockTaskRepository)
 				mck.On("FindByID", context.Background(), "0193df32-f54d-7330-a242-bc72ae85d7b4").Return(entity.Task{}, apperr.New("find task", "task not found", apperr.CodeNotFound, apperr.WithCause(sql.ErrNoRows)))
-				return usecase.NewTaskUseCase(mck, &MockTransactionRepository{})
+				return usecase.NewTaskUseCase(mck, nil, nil, nil, &MockTransactionRepository{})
 			},
 			want: want{err: "find task: sql: no rows in result set", errCode: apperr.CodeNotFound},
 		},
@@ -360,3 +455,73 @@ func TestTask_UpdateTask(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskUseCase_ListMentionedTasks(t *testing.T) {
+	type input struct {
+		ctx   context.Context
+		sub   string
+		next  string
+		limit int32
+	}
+	type want struct {
+		tasks   entity.Page[entity.Task]
+		err     string
+		errCode apperr.Code
+	}
+	type setup func(t *testing.T) *usecase.TaskUseCase
+	tests := map[string]struct {
+		input input
+		setup setup
+		want  want
+	}{
+		"success": {
+			input: input{ctx: context.Background(), sub: "80dbb87a-5ce8-4b45-85a0-3b8aec488b7a", next: "eyJpZCI6IjAxOTBmZTU5LTY2MTgtNzgxMS04YjI4LWEzZTY3OTY5YTRlZiJ9"},
+			setup: func(t *testing.T) *usecase.TaskUseCase {
+				user := new(MockUserRepository)
+				user.
+					On("FindBySub", context.Background(), "80dbb87a-5ce8-4b45-85a0-3b8aec488b7a").
+					Return(entity.User{ID: uuid.MustParse("01930c3a-e82b-700a-b41a-6f58b5c2b812")}, nil)
+				mention := new(MockMentionRepository)
+				mention.
+					On("ListMentionedTasks", context.Background(), uuid.MustParse("01930c3a-e82b-700a-b41a-6f58b5c2b812"), "0190fe59-6618-7811-8b28-a3e67969a4ef", int32(10)).
+					Return(entity.Page[entity.Task]{Items: []entity.Task{{ID: "0190fe59-6618-7811-8b28-a3e67969a4ef"}}}, nil)
+				return usecase.NewTaskUseCase(nil, user, nil, mention, nil)
+			},
+			want: want{tasks: entity.Page[entity.Task]{Items: []entity.Task{{ID: "0190fe59-6618-7811-8b28-a3e67969a4ef"}}}},
+		},
+		"failure user not found": {
+			input: input{ctx: context.Background(), sub: "unknown", limit: 1},
+			setup: func(t *testing.T) *usecase.TaskUseCase {
+				user := new(MockUserRepository)
+				user.
+					On("FindBySub", context.Background(), "unknown").
+					Return(entity.User{}, apperr.New("find user", "user is not found", apperr.CodeNotFound))
+				return usecase.NewTaskUseCase(nil, user, nil, nil, nil)
+			},
+			want: want{err: "find user", errCode: apperr.CodeNotFound},
+		},
+		"failure invalid token": {
+			input: input{ctx: context.Background(), next: "invalid"},
+			setup: func(t *testing.T) *usecase.TaskUseCase {
+				return usecase.NewTaskUseCase(nil, nil, nil, nil, nil)
+			},
+			want: want{err: "decode task cursor by base64: illegal base64 data at input byte 4", errCode: apperr.CodeInvalidArgument},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			u := tc.setup(t)
+
+			got, err := u.ListMentionedTasks(tc.input.ctx, tc.input.sub, tc.input.next, tc.input.limit)
+
+			if tc.want.err != "" {
+				assert.Zero(t, got)
+				assert.EqualError(t, err, tc.want.err)
+				assert.True(t, apperr.IsCode(err, tc.want.errCode))
+			} else {
+				assert.Equal(t, tc.want.tasks, got)
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/domain/repository"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// MaxTimeReportDays is max number of days in time report.
+const MaxTimeReportDays = 366
+
+// TimeEntryUseCase handles time tracking on tasks.
+type TimeEntryUseCase struct {
+	transaction         repository.TransactionRepository
+	taskRepository      repository.TaskRepository
+	userRepository      repository.UserRepository
+	timeEntryRepository repository.TimeEntryRepository
+}
+
+// NewTimeEntryUseCase creates TimeEntryUseCase.
+func NewTimeEntryUseCase(
+	taskRepo repository.TaskRepository,
+	userRepo repository.UserRepository,
+	timeEntryRepo repository.TimeEntryRepository,
+	transaction repository.TransactionRepository,
+) *TimeEntryUseCase {
+	return &TimeEntryUseCase{
+		transaction:         transaction,
+		taskRepository:      taskRepo,
+		userRepository:      userRepo,
+		timeEntryRepository: timeEntryRepo,
+	}
+}
+
+// StartTimer starts timer on given task for user of given sub.
+// Error will be returned if user already has running timer.
+func (u *TimeEntryUseCase) StartTimer(ctx context.Context, sub string, taskID entity.TaskID) (entity.TimeEntry, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/TimeEntryUseCase/StartTimer").End()
+
+	var entry entity.TimeEntry
+	err := u.transaction.Do(ctx, func(ctx context.Context) error {
+		user, err := u.userRepository.FindBySub(ctx, sub)
+		if err != nil {
+			return err
+		}
+		task, err := u.taskRepository.FindByID(ctx, taskID)
+		if err != nil {
+			return err
+		}
+		running, err := u.timeEntryRepository.FindRunningForUpdate(ctx, user.ID)
+		if err == nil {
+			return apperr.New(
+				fmt.Sprintf("start timer on task %q but timer is running on task %q", task.ID, running.TaskID),
+				"Timer is already running. Stop it before starting a new one",
+				apperr.WithMessageID(message.TimerAlreadyRunning),
+				apperr.CodeFailedPrecondition,
+			)
+		}
+		if !apperr.IsCode(err, apperr.CodeNotFound) {
+			return err
+		}
+		entry, err = entity.StartTimeEntry(task.ID, user.ID, time.Now())
+		if err != nil {
+			return err
+		}
+		return u.timeEntryRepository.Create(ctx, entry)
+	})
+	if err != nil {
+		return entity.TimeEntry{}, err
+	}
+	return entry, nil
+}
+
+// StopTimer stops running timer on given task for user of given sub.
+func (u *TimeEntryUseCase) StopTimer(ctx context.Context, sub string, taskID entity.TaskID) (entity.TimeEntry, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/TimeEntryUseCase/StopTimer").End()
+
+	var entry entity.TimeEntry
+	err := u.transaction.Do(ctx, func(ctx context.Context) error {
+		user, err := u.userRepository.FindBySub(ctx, sub)
+		if err != nil {
+			return err
+		}
+		entry, err = u.timeEntryRepository.FindRunningForUpdate(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+		if entry.TaskID != taskID {
+			return apperr.New(
+				fmt.Sprintf("stop timer on task %q but timer is running on task %q", taskID, entry.TaskID),
+				"No timer is running on the task",
+				apperr.WithMessageID(message.TimerNotRunning),
+				apperr.CodeNotFound,
+			)
+		}
+		err = entry.Stop(time.Now())
+		if err != nil {
+			return err
+		}
+		return u.timeEntryRepository.Update(ctx, entry)
+	})
+	if err != nil {
+		return entity.TimeEntry{}, err
+	}
+	return entry, nil
+}
+
+// AddTimeEntry adds time tracked manually on given task for user of given sub.
+func (u *TimeEntryUseCase) AddTimeEntry(ctx context.Context, sub string, taskID entity.TaskID, startedAt, endedAt time.Time) (entity.TimeEntry, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/TimeEntryUseCase/AddTimeEntry").End()
+
+	user, err := u.userRepository.FindBySub(ctx, sub)
+	if err != nil {
+		return entity.TimeEntry{}, err
+	}
+	task, err := u.taskRepository.FindByID(ctx, taskID)
+	if err != nil {
+		return entity.TimeEntry{}, err
+	}
+	entry, err := entity.NewTimeEntry(task.ID, user.ID, startedAt, endedAt)
+	if err != nil {
+		return entity.TimeEntry{}, err
+	}
+	err = u.timeEntryRepository.Create(ctx, entry)
+	if err != nil {
+		return entity.TimeEntry{}, err
+	}
+	return entry, nil
+}
+
+// ReportTime sums time tracked by user of given sub from date of from to date of to inclusive.
+// Dates and weeks are in timezone and week start preferred by the user.
+func (u *TimeEntryUseCase) ReportTime(ctx context.Context, sub string, from, to time.Time, groupBy entity.TimeReportGroupBy) (entity.TimeReport, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/TimeEntryUseCase/ReportTime").End()
+
+	prefs := preferencesFromContext(ctx)
+	loc := prefs.Location
+	start := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc)
+	end := time.Date(to.Year(), to.Month(), to.Day()+1, 0, 0, 0, 0, loc)
+	if !end.After(start) {
+		return entity.TimeReport{}, apperr.New(fmt.Sprintf("report time from %s to %s", start, end), "from must not be after to", apperr.WithMessageID(message.TimeReportInvalidRange), apperr.CodeInvalidArgument)
+	}
+	if end.After(start.AddDate(0, 0, MaxTimeReportDays)) {
+		return entity.TimeReport{}, apperr.New(
+			fmt.Sprintf("report time from %s to %s exceeds %d days", start, end, MaxTimeReportDays),
+			fmt.Sprintf("Time report must be at most %d days", MaxTimeReportDays),
+			apperr.WithMessageID(message.TimeReportTooLong, MaxTimeReportDays),
+			apperr.CodeInvalidArgument,
+		)
+	}
+	user, err := u.userRepository.FindBySub(ctx, sub)
+	if err != nil {
+		return entity.TimeReport{}, err
+	}
+	entries, err := u.timeEntryRepository.ListInRange(ctx, user.ID, start, end)
+	if err != nil {
+		return entity.TimeReport{}, err
+	}
+	items, err := entity.NewTimeReport(entries, start, end, groupBy, prefs.WeekStart, time.Now())
+	if err != nil {
+		return entity.TimeReport{}, err
+	}
+	return entity.TimeReport{Location: loc, Items: items}, nil
+}
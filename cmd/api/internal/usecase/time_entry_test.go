@@ -0,0 +1,325 @@
+package usecase_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/usecase"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"go-playground/pkg/timex"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testUserID = uuid.MustParse("01930c3a-e82b-700a-b41a-6f58b5c2b812")
+	testSub    = "80dbb87a-5ce8-4b45-85a0-3b8aec488b7a"
+	testTaskID = "0190fe59-6618-7811-8b28-a3e67969a4ef"
+)
+
+func newMockUserRepository() *MockUserRepository {
+	mck := new(MockUserRepository)
+	mck.On("FindBySub", context.Background(), testSub).Return(entity.User{ID: testUserID, Sub: testSub}, nil)
+	return mck
+}
+
+func newMockTaskRepository() *MockTaskRepository {
+	mck := new(MockTaskRepository)
+	mck.On("FindByID", context.Background(), testTaskID).Return(entity.Task{ID: testTaskID}, nil)
+	return mck
+}
+
+func TestTimeEntryUseCase_StartTimer(t *testing.T) {
+	type want struct {
+		err     string
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		setup func(t *testing.T) *usecase.TimeEntryUseCase
+		want  want
+	}{
+		"success": {
+			setup: func(t *testing.T) *usecase.TimeEntryUseCase {
+				mck := new(MockTimeEntryRepository)
+				mck.On("FindRunningForUpdate", context.Background(), testUserID).Return(entity.TimeEntry{}, apperr.New("no running", "no timer is running", apperr.CodeNotFound))
+				mck.On("Create", context.Background(), mock.MatchedBy(func(e entity.TimeEntry) bool {
+					return e.TaskID == testTaskID && e.UserID == testUserID && e.Running()
+				})).Return(nil)
+				return usecase.NewTimeEntryUseCase(newMockTaskRepository(), newMockUserRepository(), mck, &MockTransactionRepository{})
+			},
+		},
+		"failure timer is already running": {
+			setup: func(t *testing.T) *usecase.TimeEntryUseCase {
+				mck := new(MockTimeEntryRepository)
+				mck.On("FindRunningForUpdate", context.Background(), testUserID).Return(entity.TimeEntry{TaskID: "0190fe5b-1f83-7024-a233-c8a18935f5dc"}, nil)
+				return usecase.NewTimeEntryUseCase(newMockTaskRepository(), newMockUserRepository(), mck, &MockTransactionRepository{})
+			},
+			want: want{
+				err:     `start timer on task "0190fe59-6618-7811-8b28-a3e67969a4ef" but timer is running on task "0190fe5b-1f83-7024-a233-c8a18935f5dc"`,
+				errCode: apperr.CodeFailedPrecondition,
+			},
+		},
+		"failure to find running timer": {
+			setup: func(t *testing.T) *usecase.TimeEntryUseCase {
+				mck := new(MockTimeEntryRepository)
+				mck.On("FindRunningForUpdate", context.Background(), testUserID).Return(entity.TimeEntry{}, apperr.New("lock user", "failed to find running timer"))
+				return usecase.NewTimeEntryUseCase(newMockTaskRepository(), newMockUserRepository(), mck, &MockTransactionRepository{})
+			},
+			want: want{err: "lock user", errCode: apperr.CodeInternal},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			u := tc.setup(t)
+
+			got, err := u.StartTimer(context.Background(), testSub, testTaskID)
+
+			if tc.want.err != "" {
+				assert.Zero(t, got)
+				assert.EqualError(t, err, tc.want.err)
+				assert.True(t, apperr.IsCode(err, tc.want.errCode))
+			} else {
+				require.NoError(t, err)
+				assert.True(t, got.Running())
+			}
+		})
+	}
+}
+
+func TestTimeEntryUseCase_StopTimer(t *testing.T) {
+	running := entity.TimeEntry{
+		ID:        "0193a5b2-7400-7c1d-8e2f-4b9a1d8e3c02",
+		TaskID:    testTaskID,
+		UserID:    testUserID,
+		StartedAt: time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC),
+	}
+	type want struct {
+		err     string
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		taskID string
+		setup  func(t *testing.T) *usecase.TimeEntryUseCase
+		want   want
+	}{
+		"success": {
+			taskID: testTaskID,
+			setup: func(t *testing.T) *usecase.TimeEntryUseCase {
+				mck := new(MockTimeEntryRepository)
+				mck.On("FindRunningForUpdate", context.Background(), testUserID).Return(running, nil)
+				mck.On("Update", context.Background(), mock.MatchedBy(func(e entity.TimeEntry) bool {
+					return e.ID == running.ID && !e.Running()
+				})).Return(nil)
+				return usecase.NewTimeEntryUseCase(nil, newMockUserRepository(), mck, &MockTransactionRepository{})
+			},
+		},
+		"failure timer is running on other task": {
+			taskID: "0190fe5b-1f83-7024-a233-c8a18935f5dc",
+			setup: func(t *testing.T) *usecase.TimeEntryUseCase {
+				mck := new(MockTimeEntryRepository)
+				mck.On("FindRunningForUpdate", context.Background(), testUserID).Return(running, nil)
+				return usecase.NewTimeEntryUseCase(nil, newMockUserRepository(), mck, &MockTransactionRepository{})
+			},
+			want: want{
+				err:     `stop timer on task "0190fe5b-1f83-7024-a233-c8a18935f5dc" but timer is running on task "0190fe59-6618-7811-8b28-a3e67969a4ef"`,
+				errCode: apperr.CodeNotFound,
+			},
+		},
+		"failure no timer is running": {
+			taskID: testTaskID,
+			setup: func(t *testing.T) *usecase.TimeEntryUseCase {
+				mck := new(MockTimeEntryRepository)
+				mck.On("FindRunningForUpdate", context.Background(), testUserID).Return(entity.TimeEntry{}, apperr.New("no running", "no timer is running", apperr.CodeNotFound))
+				return usecase.NewTimeEntryUseCase(nil, newMockUserRepository(), mck, &MockTransactionRepository{})
+			},
+			want: want{err: "no running", errCode: apperr.CodeNotFound},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			u := tc.setup(t)
+
+			got, err := u.StopTimer(context.Background(), testSub, tc.taskID)
+
+			if tc.want.err != "" {
+				assert.Zero(t, got)
+				assert.EqualError(t, err, tc.want.err)
+				assert.True(t, apperr.IsCode(err, tc.want.errCode))
+			} else {
+				require.NoError(t, err)
+				assert.False(t, got.Running())
+			}
+		})
+	}
+}
+
+func TestTimeEntryUseCase_AddTimeEntry(t *testing.T) {
+	type input struct {
+		startedAt, endedAt time.Time
+	}
+	type want struct {
+		err     string
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		input input
+		setup func(t *testing.T) *usecase.TimeEntryUseCase
+		want  want
+	}{
+		"success": {
+			input: input{startedAt: time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC), endedAt: time.Date(2024, 12, 1, 1, 0, 0, 0, time.UTC)},
+			setup: func(t *testing.T) *usecase.TimeEntryUseCase {
+				mck := new(MockTimeEntryRepository)
+				mck.On("Create", context.Background(), mock.MatchedBy(func(e entity.TimeEntry) bool {
+					return e.TaskID == testTaskID && e.Duration(time.Now()) == time.Hour
+				})).Return(nil)
+				return usecase.NewTimeEntryUseCase(newMockTaskRepository(), newMockUserRepository(), mck, nil)
+			},
+		},
+		"failure invalid period": {
+			input: input{startedAt: time.Date(2024, 12, 1, 1, 0, 0, 0, time.UTC), endedAt: time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)},
+			setup: func(t *testing.T) *usecase.TimeEntryUseCase {
+				return usecase.NewTimeEntryUseCase(newMockTaskRepository(), newMockUserRepository(), nil, nil)
+			},
+			want: want{
+				err:     "time entry ended at 2024-12-01 00:00:00 +0000 UTC is not after started at 2024-12-01 01:00:00 +0000 UTC",
+				errCode: apperr.CodeInvalidArgument,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			u := tc.setup(t)
+
+			got, err := u.AddTimeEntry(context.Background(), testSub, testTaskID, tc.input.startedAt, tc.input.endedAt)
+
+			if tc.want.err != "" {
+				assert.Zero(t, got)
+				assert.EqualError(t, err, tc.want.err)
+				assert.True(t, apperr.IsCode(err, tc.want.errCode))
+			} else {
+				require.NoError(t, err)
+				assert.NotZero(t, got.ID)
+			}
+		})
+	}
+}
+
+func TestTimeEntryUseCase_ReportTime(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	preferred := ctxhelper.WithPreferences(context.Background(), ctxhelper.UserPreferences{Location: newYork, WeekStart: time.Sunday})
+	type input struct {
+		ctx      context.Context
+		from, to time.Time
+		groupBy  entity.TimeReportGroupBy
+	}
+	type want struct {
+		report  entity.TimeReport
+		err     string
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		input input
+		setup func(t *testing.T) *usecase.TimeEntryUseCase
+		want  want
+	}{
+		"success": {
+			input: input{
+				from:    time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+				to:      time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC),
+				groupBy: entity.TimeReportGroupByDay,
+			},
+			setup: func(t *testing.T) *usecase.TimeEntryUseCase {
+				mck := new(MockTimeEntryRepository)
+				mck.
+					On("ListInRange", context.Background(), testUserID, time.Date(2024, 12, 1, 0, 0, 0, 0, timex.JST()), time.Date(2024, 12, 3, 0, 0, 0, 0, timex.JST())).
+					Return([]entity.TimeEntry{
+						{
+							TaskID:    testTaskID,
+							StartedAt: time.Date(2024, 12, 1, 14, 0, 0, 0, time.UTC),
+							EndedAt:   time.Date(2024, 12, 1, 16, 0, 0, 0, time.UTC),
+						},
+					}, nil)
+				return usecase.NewTimeEntryUseCase(nil, newMockUserRepository(), mck, nil)
+			},
+			want: want{report: entity.TimeReport{Location: timex.JST(), Items: []entity.TimeReportItem{
+				{Key: "2024-12-01", Duration: time.Hour},
+				{Key: "2024-12-02", Duration: time.Hour},
+			}}},
+		},
+		"success in preferred timezone and week start": {
+			input: input{
+				ctx:     preferred,
+				from:    time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+				to:      time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC),
+				groupBy: entity.TimeReportGroupByWeek,
+			},
+			setup: func(t *testing.T) *usecase.TimeEntryUseCase {
+				users := new(MockUserRepository)
+				users.On("FindBySub", preferred, testSub).Return(entity.User{ID: testUserID}, nil)
+				mck := new(MockTimeEntryRepository)
+				mck.
+					On("ListInRange", preferred, testUserID, time.Date(2024, 12, 1, 0, 0, 0, 0, newYork), time.Date(2024, 12, 3, 0, 0, 0, 0, newYork)).
+					Return([]entity.TimeEntry{
+						{
+							TaskID:    testTaskID,
+							StartedAt: time.Date(2024, 12, 1, 14, 0, 0, 0, time.UTC),
+							EndedAt:   time.Date(2024, 12, 1, 16, 0, 0, 0, time.UTC),
+						},
+					}, nil)
+				return usecase.NewTimeEntryUseCase(nil, users, mck, nil)
+			},
+			want: want{report: entity.TimeReport{Location: newYork, Items: []entity.TimeReportItem{
+				{Key: "2024-12-01", Duration: 2 * time.Hour},
+			}}},
+		},
+		"failure from is after to": {
+			input: input{
+				from:    time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC),
+				to:      time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+				groupBy: entity.TimeReportGroupByDay,
+			},
+			setup: func(t *testing.T) *usecase.TimeEntryUseCase {
+				return usecase.NewTimeEntryUseCase(nil, nil, nil, nil)
+			},
+			want: want{err: "report time from 2024-12-02 00:00:00 +0900 JST to 2024-12-02 00:00:00 +0900 JST", errCode: apperr.CodeInvalidArgument},
+		},
+		"failure range is too long": {
+			input: input{
+				from:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				to:      time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				groupBy: entity.TimeReportGroupByTask,
+			},
+			setup: func(t *testing.T) *usecase.TimeEntryUseCase {
+				return usecase.NewTimeEntryUseCase(nil, nil, nil, nil)
+			},
+			want: want{err: "report time from 2024-01-01 00:00:00 +0900 JST to 2025-01-02 00:00:00 +0900 JST exceeds 366 days", errCode: apperr.CodeInvalidArgument},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			u := tc.setup(t)
+			ctx := tc.input.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			got, err := u.ReportTime(ctx, testSub, tc.input.from, tc.input.to, tc.input.groupBy)
+
+			if tc.want.err != "" {
+				assert.Zero(t, got)
+				assert.EqualError(t, err, tc.want.err)
+				assert.True(t, apperr.IsCode(err, tc.want.errCode))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.want.report, got)
+			}
+		})
+	}
+}
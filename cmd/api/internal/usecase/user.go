@@ -2,21 +2,44 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 	"go-playground/cmd/api/internal/domain/entity"
 	"go-playground/cmd/api/internal/domain/repository"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/newrelic/go-agent/v3/newrelic"
 )
 
+// MaxPurgeBatchSize is max number of users purged in one transaction.
+const MaxPurgeBatchSize int32 = 1000
+
 // UserUseCase handles user entity.
 type UserUseCase struct {
-	userRepository repository.UserRepository
+	transaction            repository.TransactionRepository
+	userRepository         repository.UserRepository
+	organizationRepository repository.OrganizationRepository
+	taskArchiveRepository  repository.TaskArchiveRepository
+	auditLogRepository     repository.AuditLogRepository
 }
 
 // NewUserUseCase creates UserUseCase
-func NewUserUseCase(userRepo repository.UserRepository) *UserUseCase {
-	return &UserUseCase{userRepository: userRepo}
+func NewUserUseCase(
+	userRepo repository.UserRepository,
+	organizationRepo repository.OrganizationRepository,
+	taskArchiveRepo repository.TaskArchiveRepository,
+	auditLogRepo repository.AuditLogRepository,
+	transaction repository.TransactionRepository,
+) *UserUseCase {
+	return &UserUseCase{
+		transaction:            transaction,
+		userRepository:         userRepo,
+		organizationRepository: organizationRepo,
+		taskArchiveRepository:  taskArchiveRepo,
+		auditLogRepository:     auditLogRepo,
+	}
 }
 
 func (u *UserUseCase) FindBySub(
@@ -28,23 +51,236 @@ func (u *UserUseCase) FindBySub(
 	return u.userRepository.FindBySub(ctx, sub)
 }
 
-// CreateUser creates new user with given user information.
+// CreateUser creates new user with given user information and personal organization owned by the user.
+// Email is not verified until user verifies it by [EmailVerificationUseCase].
 func (u *UserUseCase) CreateUser(
 	ctx context.Context,
 	sub string,
 	givenName, familyName string,
 	email string,
-	emailVerified bool,
 ) (uuid.UUID, error) {
 	defer newrelic.FromContext(ctx).StartSegment("usecase/UserUseCase/CreateUser").End()
 
-	user, err := entity.NewUser(sub, givenName, familyName, email, emailVerified)
+	user, err := entity.NewUser(sub, givenName, familyName, email, false)
 	if err != nil {
 		return uuid.UUID{}, err
 	}
-	err = u.userRepository.Create(ctx, user)
+	err = u.transaction.Do(ctx, func(ctx context.Context) error {
+		return u.create(ctx, user)
+	})
 	if err != nil {
 		return uuid.UUID{}, err
 	}
 	return user.ID, nil
 }
+
+// create creates given user with personal organization owned by the user.
+// Tasks belong to organization, so user without organization could do nothing.
+// This must be called in transaction.
+func (u *UserUseCase) create(ctx context.Context, user entity.User) error {
+	org, err := entity.NewOrganization(entity.PersonalOrganizationName, user.CreatedAt)
+	if err != nil {
+		return err
+	}
+	err = u.userRepository.Create(ctx, user)
+	if err != nil {
+		return err
+	}
+	err = u.organizationRepository.Create(ctx, org)
+	if err != nil {
+		return err
+	}
+	return u.organizationRepository.AddMember(ctx, entity.NewOrganizationOwner(org, user.ID))
+}
+
+// ProvisionUser creates user with given sub from claims of access token issued at issuedAt,
+// or syncs existing user with them if token is issued after user is last updated.
+// New user gets personal organization as [UserUseCase.CreateUser].
+// So profile updated by [UserUseCase.UpdateMe] is kept until user logs in again.
+// Deleted user is neither synced nor created again.
+func (u *UserUseCase) ProvisionUser(
+	ctx context.Context,
+	sub string,
+	givenName, familyName string,
+	email string,
+	emailVerified bool,
+	issuedAt time.Time,
+) error {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/UserUseCase/ProvisionUser").End()
+
+	// Most requests come from provisioned user, so look up without lock first.
+	user, err := u.userRepository.FindBySub(ctx, sub)
+	if err == nil && (user.Deleted() || !issuedAt.After(user.UpdatedAt)) {
+		return nil
+	}
+	if err != nil && !apperr.IsCode(err, apperr.CodeNotFound) {
+		return err
+	}
+	return u.transaction.Do(ctx, func(ctx context.Context) error {
+		user, err := u.userRepository.FindBySubForUpdate(ctx, sub)
+		if apperr.IsCode(err, apperr.CodeNotFound) {
+			user, err := entity.NewUser(sub, givenName, familyName, email, emailVerified)
+			if err != nil {
+				return err
+			}
+			return u.create(ctx, user)
+		}
+		if err != nil {
+			return err
+		}
+		if user.Deleted() || !issuedAt.After(user.UpdatedAt) {
+			return nil
+		}
+		changed, emailChanged, err := user.SyncClaims(givenName, familyName, email, emailVerified, time.Now())
+		if err != nil || !changed {
+			return err
+		}
+		err = u.userRepository.Update(ctx, user)
+		if err != nil {
+			return err
+		}
+		if !emailChanged {
+			return nil
+		}
+		log, err := entity.NewAuditLog(user.ID, entity.AuditActionUserEmailChanged, user.ID.String(), map[string]string{
+			"source": "access_token",
+		})
+		if err != nil {
+			return err
+		}
+		return u.auditLogRepository.Create(ctx, log)
+	})
+}
+
+// UpdateMe updates profile of user with given sub by given patch.
+// Changing email resets its verification and is recorded in audit log.
+func (u *UserUseCase) UpdateMe(ctx context.Context, sub string, patch entity.UserProfilePatch) (entity.User, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/UserUseCase/UpdateMe").End()
+
+	var user entity.User
+	err := u.transaction.Do(ctx, func(ctx context.Context) error {
+		var err error
+		user, err = u.userRepository.FindBySubForUpdate(ctx, sub)
+		if err != nil {
+			return err
+		}
+		emailChanged, err := user.ApplyProfilePatch(patch)
+		if err != nil {
+			return err
+		}
+		err = u.userRepository.Update(ctx, user)
+		if err != nil {
+			return err
+		}
+		if !emailChanged {
+			return nil
+		}
+		log, err := entity.NewAuditLog(user.ID, entity.AuditActionUserEmailChanged, user.ID.String(), nil)
+		if err != nil {
+			return err
+		}
+		return u.auditLogRepository.Create(ctx, log)
+	})
+	if err != nil {
+		return entity.User{}, err
+	}
+	return user, nil
+}
+
+// DeleteMe deletes user with given sub. User can be restored by [UserUseCase.RestoreMe] during grace period.
+//
+// Tasks owned by the user are archived in the same transaction, and purged with the user after grace period.
+func (u *UserUseCase) DeleteMe(ctx context.Context, sub string) (entity.User, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/UserUseCase/DeleteMe").End()
+
+	return u.changeDeletion(ctx, sub, entity.AuditActionUserDeleted, func(ctx context.Context, user *entity.User, now time.Time) error {
+		err := user.Delete(now)
+		if err != nil {
+			return err
+		}
+		_, err = u.taskArchiveRepository.ArchiveOwnedTasks(ctx, user.ID, user.DeletedAt)
+		return err
+	})
+}
+
+// RestoreMe cancels deletion of user with given sub.
+//
+// Tasks archived by [UserUseCase.DeleteMe] are unarchived, while tasks archived before deletion are kept archived.
+func (u *UserUseCase) RestoreMe(ctx context.Context, sub string) (entity.User, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/UserUseCase/RestoreMe").End()
+
+	return u.changeDeletion(ctx, sub, entity.AuditActionUserRestored, func(ctx context.Context, user *entity.User, now time.Time) error {
+		deletedAt := user.DeletedAt
+		err := user.Restore(now)
+		if err != nil {
+			return err
+		}
+		_, err = u.taskArchiveRepository.UnarchiveOwnedTasks(ctx, user.ID, deletedAt)
+		return err
+	})
+}
+
+func (u *UserUseCase) changeDeletion(ctx context.Context, sub string, action entity.AuditAction, change func(context.Context, *entity.User, time.Time) error) (entity.User, error) {
+	var user entity.User
+	err := u.transaction.Do(ctx, func(ctx context.Context) error {
+		var err error
+		user, err = u.userRepository.FindBySubForUpdate(ctx, sub)
+		if err != nil {
+			return err
+		}
+		err = change(ctx, &user, time.Now())
+		if err != nil {
+			return err
+		}
+		err = u.userRepository.Update(ctx, user)
+		if err != nil {
+			return err
+		}
+		log, err := entity.NewAuditLog(user.ID, action, user.ID.String(), nil)
+		if err != nil {
+			return err
+		}
+		return u.auditLogRepository.Create(ctx, log)
+	})
+	if err != nil {
+		return entity.User{}, err
+	}
+	return user, nil
+}
+
+// PurgeDeletedUsers permanently deletes users whose grace period ended before now and returns number of purged users.
+//
+// Users are purged in batches of batchSize and each batch is committed in its own transaction,
+// so interrupted run can be resumed by running again. Concurrent runs never purge the same user.
+func (u *UserUseCase) PurgeDeletedUsers(ctx context.Context, now time.Time, batchSize int32) (int64, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/UserUseCase/PurgeDeletedUsers").End()
+
+	if batchSize <= 0 || batchSize > MaxPurgeBatchSize {
+		return 0, apperr.New(
+			fmt.Sprintf("purge batch size %d is out of range", batchSize),
+			fmt.Sprintf("Batch size must be between 1 and %d", MaxPurgeBatchSize),
+			apperr.WithMessageID(message.BatchSizeOutOfRange, MaxPurgeBatchSize),
+			apperr.CodeInvalidArgument,
+		)
+	}
+	before := now.Add(-entity.UserDeletionGracePeriod)
+	var total int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, apperr.New("purge deleted users is canceled", "purge deleted users is canceled", apperr.WithCause(err))
+		}
+		var n int64
+		err := u.transaction.Do(ctx, func(ctx context.Context) error {
+			var err error
+			n, err = u.userRepository.PurgeDeleted(ctx, before, batchSize)
+			return err
+		})
+		if err != nil {
+			return total, err
+		}
+		total += n
+		if n < int64(batchSize) {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/domain/repository"
+	"go-playground/cmd/api/internal/message"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"log/slog"
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// exportPageSize is number of mentioned tasks fetched at once while building export.
+const exportPageSize int32 = 100
+
+// exportSince is lower bound of time entries included in export. This is the minimum of MySQL DATETIME.
+var exportSince = time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// UserExportUseCase handles personal data export of user.
+type UserExportUseCase struct {
+	transaction            repository.TransactionRepository
+	userRepository         repository.UserRepository
+	organizationRepository repository.OrganizationRepository
+	taskArchiveRepository  repository.TaskArchiveRepository
+	mentionRepository      repository.MentionRepository
+	timeEntryRepository    repository.TimeEntryRepository
+	userExportRepository   repository.UserExportRepository
+}
+
+// NewUserExportUseCase creates UserExportUseCase.
+func NewUserExportUseCase(
+	userRepo repository.UserRepository,
+	organizationRepo repository.OrganizationRepository,
+	taskArchiveRepo repository.TaskArchiveRepository,
+	mentionRepo repository.MentionRepository,
+	timeEntryRepo repository.TimeEntryRepository,
+	userExportRepo repository.UserExportRepository,
+	transaction repository.TransactionRepository,
+) *UserExportUseCase {
+	return &UserExportUseCase{
+		transaction:            transaction,
+		userRepository:         userRepo,
+		organizationRepository: organizationRepo,
+		taskArchiveRepository:  taskArchiveRepo,
+		mentionRepository:      mentionRepo,
+		timeEntryRepository:    timeEntryRepo,
+		userExportRepository:   userExportRepo,
+	}
+}
+
+// RequestExport requests export of user with given sub. Export is built later by [UserExportUseCase.ProcessPendingExports].
+func (u *UserExportUseCase) RequestExport(ctx context.Context, sub string) (entity.UserExport, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/UserExportUseCase/RequestExport").End()
+
+	user, err := u.userRepository.FindBySub(ctx, sub)
+	if err != nil {
+		return entity.UserExport{}, err
+	}
+	export, err := entity.NewUserExport(user.ID)
+	if err != nil {
+		return entity.UserExport{}, err
+	}
+	err = u.userExportRepository.Create(ctx, export)
+	if err != nil {
+		return entity.UserExport{}, err
+	}
+	return export, nil
+}
+
+// FindExport finds export of given id requested by user with given sub.
+// Export of other user is reported as not found.
+func (u *UserExportUseCase) FindExport(ctx context.Context, sub string, id entity.UserExportID) (entity.UserExport, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/UserExportUseCase/FindExport").End()
+
+	user, err := u.userRepository.FindBySub(ctx, sub)
+	if err != nil {
+		return entity.UserExport{}, err
+	}
+	export, err := u.userExportRepository.FindByID(ctx, id)
+	if err != nil {
+		return entity.UserExport{}, err
+	}
+	if export.UserID != user.ID {
+		return entity.UserExport{}, apperr.New(fmt.Sprintf("user %q finds export %q of other user", user.ID, id), "Export not found", apperr.WithMessageID(message.ExportNotFound), apperr.CodeNotFound)
+	}
+	return export, nil
+}
+
+// ProcessPendingExports builds pending exports one by one until no export is pending and returns number of processed exports.
+//
+// Each export is locked with SKIP LOCKED while it is built, so multiple processes can run this concurrently.
+// Export which can not be built is marked as failed.
+func (u *UserExportUseCase) ProcessPendingExports(ctx context.Context) (int, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/UserExportUseCase/ProcessPendingExports").End()
+
+	var processed int
+	for {
+		var done bool
+		err := u.transaction.Do(ctx, func(ctx context.Context) error {
+			export, err := u.userExportRepository.FindPendingForUpdate(ctx)
+			if err != nil {
+				if apperr.IsCode(err, apperr.CodeNotFound) {
+					done = true
+					return nil
+				}
+				return err
+			}
+			content, err := u.buildExportContent(ctx, export)
+			if err == nil {
+				err = export.Complete(content)
+			}
+			if err != nil {
+				slog.WarnContext(ctx, "failed to build user export", slog.String("id", export.ID), slog.String("error", err.Error()))
+				export.Fail()
+			}
+			return u.userExportRepository.Update(ctx, export)
+		})
+		if err != nil {
+			return processed, err
+		}
+		if done {
+			return processed, nil
+		}
+		processed++
+	}
+}
+
+func (u *UserExportUseCase) buildExportContent(ctx context.Context, export entity.UserExport) (entity.UserExportContent, error) {
+	user, err := u.userRepository.FindByID(ctx, export.UserID)
+	if err != nil {
+		return entity.UserExportContent{}, err
+	}
+	// Owned tasks are collected from all organizations at once, including ones which user has already left.
+	ownedTasks, err := u.taskArchiveRepository.ListOwnedTasks(ctx, user.ID)
+	if err != nil {
+		return entity.UserExportContent{}, err
+	}
+	// Mentions are tenant data, so they are collected from each organization which user belongs to.
+	orgs, err := u.organizationRepository.ListByUserID(ctx, user.ID)
+	if err != nil {
+		return entity.UserExportContent{}, err
+	}
+	var tasks []entity.Task
+	for _, org := range orgs {
+		orgCtx := ctxhelper.WithOrganizationID(ctx, org.ID)
+		var next entity.TaskID
+		for {
+			page, err := u.mentionRepository.ListMentionedTasks(orgCtx, user.ID, next, exportPageSize)
+			if err != nil {
+				return entity.UserExportContent{}, err
+			}
+			tasks = append(tasks, page.Items...)
+			if !page.HasNext {
+				break
+			}
+			cursor, err := entity.DecodeTaskCursor(page.NextToken)
+			if err != nil {
+				return entity.UserExportContent{}, err
+			}
+			next = cursor.ID
+		}
+	}
+	now := time.Now()
+	entries, err := u.timeEntryRepository.ListInRange(ctx, user.ID, exportSince, now)
+	if err != nil {
+		return entity.UserExportContent{}, err
+	}
+	return entity.NewUserExportContent(user, ownedTasks, tasks, entries, now), nil
+}
+
+// UserExportWorker runs [UserExportUseCase.ProcessPendingExports] when notified and periodically.
+//
+// Periodic run picks up exports left pending by other processes, for example stopped while building them.
+type UserExportWorker struct {
+	useCase  *UserExportUseCase
+	interval time.Duration
+	wake     chan struct{}
+}
+
+// NewUserExportWorker creates UserExportWorker.
+func NewUserExportWorker(useCase *UserExportUseCase, interval time.Duration) *UserExportWorker {
+	return &UserExportWorker{
+		useCase:  useCase,
+		interval: interval,
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+// Notify wakes worker up without blocking.
+func (w *UserExportWorker) Notify() {
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run processes pending exports until ctx is done.
+func (w *UserExportWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		if _, err := w.useCase.ProcessPendingExports(ctx); err != nil {
+			slog.ErrorContext(ctx, "failed to process user exports", slog.String("error", err.Error()))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.wake:
+		case <-ticker.C:
+		}
+	}
+}
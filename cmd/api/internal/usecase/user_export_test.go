@@ -0,0 +1,132 @@
+package usecase_test
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/usecase"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserExportUseCase_RequestExport(t *testing.T) {
+	exports := new(MockUserExportRepository)
+	exports.On("Create", context.Background(), mock.MatchedBy(func(e entity.UserExport) bool {
+		return e.UserID == testUserID && e.Status == entity.UserExportStatusPending
+	})).Return(nil).Once()
+	u := usecase.NewUserExportUseCase(newMockUserRepository(), nil, nil, nil, nil, exports, &MockTransactionRepository{})
+
+	got, err := u.RequestExport(context.Background(), testSub)
+
+	require.NoError(t, err)
+	assert.Equal(t, testUserID, got.UserID)
+	assert.Equal(t, entity.UserExportStatusPending, got.Status)
+	exports.AssertExpectations(t)
+}
+
+func TestUserExportUseCase_FindExport(t *testing.T) {
+	tests := map[string]struct {
+		export  entity.UserExport
+		wantErr string
+	}{
+		"success": {
+			export: entity.UserExport{ID: "0193dd96-47aa-755b-806e-0b22d6f1849b", UserID: testUserID},
+		},
+		"failure export of other user": {
+			export:  entity.UserExport{ID: "0193dd96-47aa-755b-806e-0b22d6f1849b", UserID: uuid.MustParse("01930c3b-e82b-700a-b41a-6f58b5c2b813")},
+			wantErr: `user "01930c3a-e82b-700a-b41a-6f58b5c2b812" finds export "0193dd96-47aa-755b-806e-0b22d6f1849b" of other user`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			exports := new(MockUserExportRepository)
+			exports.On("FindByID", context.Background(), "0193dd96-47aa-755b-806e-0b22d6f1849b").Return(tc.export, nil)
+			u := usecase.NewUserExportUseCase(newMockUserRepository(), nil, nil, nil, nil, exports, &MockTransactionRepository{})
+
+			got, err := u.FindExport(context.Background(), testSub, "0193dd96-47aa-755b-806e-0b22d6f1849b")
+
+			if tc.wantErr != "" {
+				assert.Zero(t, got)
+				assert.EqualError(t, err, tc.wantErr)
+				assert.True(t, apperr.IsCode(err, apperr.CodeNotFound))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.export, got)
+			}
+		})
+	}
+}
+
+func TestUserExportUseCase_ProcessPendingExports(t *testing.T) {
+	users := new(MockUserRepository)
+	users.On("FindByID", mock.Anything, testUserID).Return(entity.User{ID: testUserID, Sub: testSub}, nil)
+	orgs := new(MockOrganizationRepository)
+	orgs.On("ListByUserID", mock.Anything, testUserID).Return([]entity.Organization{{ID: testOrganizationID}, {ID: testOtherOrganizationID}}, nil)
+	inOrg := func(orgID string) any {
+		return mock.MatchedBy(func(ctx context.Context) bool {
+			id, _ := ctxhelper.OrganizationID(ctx)
+			return id == orgID
+		})
+	}
+	archives := new(MockTaskArchiveRepository)
+	archives.On("ListOwnedTasks", mock.Anything, testUserID).Return([]entity.Task{
+		{ID: "0190fe59-6618-7811-8b28-a3e67969a4ef", OwnerID: testUserID},
+		{ID: "0190fe5b-1f83-7024-a233-c8a18935f5dc", OwnerID: testUserID, Archived: true},
+	}, nil).Once()
+	mentions := new(MockMentionRepository)
+	mentions.On("ListMentionedTasks", inOrg(testOrganizationID), testUserID, "", int32(100)).Return(entity.Page[entity.Task]{
+		Items:     []entity.Task{{ID: "0191039a-d472-7e9f-9138-7b5e1c400553"}},
+		HasNext:   true,
+		NextToken: "eyJpZCI6IjAxOTEwMmNhLWI1OGItN2I0Ni04ZTI3LWQ2MzQ4NWE3MDU3NCJ9",
+	}, nil).Once()
+	mentions.On("ListMentionedTasks", inOrg(testOrganizationID), testUserID, "019102ca-b58b-7b46-8e27-d63485a70574", int32(100)).Return(entity.Page[entity.Task]{
+		Items: []entity.Task{{ID: "019102ca-b58b-7b46-8e27-d63485a70574"}},
+	}, nil).Once()
+	mentions.On("ListMentionedTasks", inOrg(testOtherOrganizationID), testUserID, "", int32(100)).Return(entity.Page[entity.Task]{
+		Items: []entity.Task{{ID: "0194c3b0-1f2e-7d3c-8b4a-5e6f7a8b9c0d"}},
+	}, nil).Once()
+	entries := new(MockTimeEntryRepository)
+	entries.On("ListInRange", mock.Anything, testUserID, time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC), mock.Anything).Return([]entity.TimeEntry{}, nil)
+	exports := new(MockUserExportRepository)
+	exports.On("FindPendingForUpdate", mock.Anything).Return(entity.UserExport{ID: "0193dd96-47aa-755b-806e-0b22d6f1849b", UserID: testUserID, Status: entity.UserExportStatusPending}, nil).Once()
+	exports.On("Update", mock.Anything, mock.MatchedBy(func(e entity.UserExport) bool {
+		return e.Ready() && assert.Contains(t, string(e.Content), `"ownedTasks":[{"id":"0190fe59-6618-7811-8b28-a3e67969a4ef"`) &&
+			assert.Contains(t, string(e.Content), `{"id":"0190fe5b-1f83-7024-a233-c8a18935f5dc","content":"","createdAt":"0001-01-01T00:00:00Z","updatedAt":"0001-01-01T00:00:00Z","archived":true}`) &&
+			assert.Contains(t, string(e.Content), `"mentionedTasks":[{"id":"0191039a-d472-7e9f-9138-7b5e1c400553"`) &&
+			assert.Contains(t, string(e.Content), `{"id":"0194c3b0-1f2e-7d3c-8b4a-5e6f7a8b9c0d"`)
+	})).Return(nil).Once()
+	exports.On("FindPendingForUpdate", mock.Anything).Return(entity.UserExport{}, apperr.New("no pending", "not found pending export", apperr.CodeNotFound)).Once()
+	u := usecase.NewUserExportUseCase(users, orgs, archives, mentions, entries, exports, &MockTransactionRepository{})
+
+	got, err := u.ProcessPendingExports(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, got)
+	exports.AssertExpectations(t)
+	archives.AssertExpectations(t)
+	mentions.AssertExpectations(t)
+}
+
+func TestUserExportUseCase_ProcessPendingExports_Failed(t *testing.T) {
+	users := new(MockUserRepository)
+	users.On("FindByID", mock.Anything, testUserID).Return(entity.User{}, apperr.New("not found", "user is not found", apperr.CodeNotFound))
+	exports := new(MockUserExportRepository)
+	exports.On("FindPendingForUpdate", mock.Anything).Return(entity.UserExport{ID: "0193dd96-47aa-755b-806e-0b22d6f1849b", UserID: testUserID, Status: entity.UserExportStatusPending}, nil).Once()
+	exports.On("Update", mock.Anything, mock.MatchedBy(func(e entity.UserExport) bool {
+		return e.Status == entity.UserExportStatusFailed
+	})).Return(nil).Once()
+	exports.On("FindPendingForUpdate", mock.Anything).Return(entity.UserExport{}, apperr.New("no pending", "not found pending export", apperr.CodeNotFound)).Once()
+	u := usecase.NewUserExportUseCase(users, nil, nil, nil, nil, exports, &MockTransactionRepository{})
+
+	got, err := u.ProcessPendingExports(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, got)
+	exports.AssertExpectations(t)
+}
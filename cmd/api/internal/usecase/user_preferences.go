@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/domain/repository"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// UserPreferencesUseCase handles preferences of users.
+type UserPreferencesUseCase struct {
+	userRepository            repository.UserRepository
+	userPreferencesRepository repository.UserPreferencesRepository
+}
+
+// NewUserPreferencesUseCase creates UserPreferencesUseCase.
+func NewUserPreferencesUseCase(
+	userRepo repository.UserRepository,
+	userPreferencesRepo repository.UserPreferencesRepository,
+) *UserPreferencesUseCase {
+	return &UserPreferencesUseCase{
+		userRepository:            userRepo,
+		userPreferencesRepository: userPreferencesRepo,
+	}
+}
+
+// FindPreferences finds preferences of user with given sub. Default preferences are returned if user has never saved them.
+func (u *UserPreferencesUseCase) FindPreferences(ctx context.Context, sub string) (entity.UserPreferences, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/UserPreferencesUseCase/FindPreferences").End()
+
+	user, err := u.userRepository.FindBySub(ctx, sub)
+	if err != nil {
+		return entity.UserPreferences{}, err
+	}
+	return u.FindPreferencesByUserID(ctx, user.ID)
+}
+
+// FindPreferencesByUserID finds preferences of user with given id. Default preferences are returned if user has never saved them.
+func (u *UserPreferencesUseCase) FindPreferencesByUserID(ctx context.Context, userID uuid.UUID) (entity.UserPreferences, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/UserPreferencesUseCase/FindPreferencesByUserID").End()
+
+	p, err := u.userPreferencesRepository.Find(ctx, userID)
+	if apperr.IsCode(err, apperr.CodeNotFound) {
+		return entity.DefaultUserPreferences(userID), nil
+	}
+	return p, err
+}
+
+// UpdatePreferences replaces preferences of user with given sub.
+func (u *UserPreferencesUseCase) UpdatePreferences(
+	ctx context.Context,
+	sub string,
+	timezone, locale string,
+	pageSize int32,
+	weekStart time.Weekday,
+) (entity.UserPreferences, error) {
+	defer newrelic.FromContext(ctx).StartSegment("usecase/UserPreferencesUseCase/UpdatePreferences").End()
+
+	user, err := u.userRepository.FindBySub(ctx, sub)
+	if err != nil {
+		return entity.UserPreferences{}, err
+	}
+	p, err := entity.NewUserPreferences(user.ID, timezone, locale, pageSize, weekStart, time.Now())
+	if err != nil {
+		return entity.UserPreferences{}, err
+	}
+	err = u.userPreferencesRepository.Save(ctx, p)
+	if err != nil {
+		return entity.UserPreferences{}, err
+	}
+	return p, nil
+}
+
+// preferencesFromContext returns preferences of user making request.
+// Default preferences are returned if request carries none, such as request by worker.
+func preferencesFromContext(ctx context.Context) ctxhelper.UserPreferences {
+	if p, ok := ctxhelper.Preferences(ctx); ok {
+		return p
+	}
+	d := entity.DefaultUserPreferences(uuid.Nil)
+	return ctxhelper.UserPreferences{Location: d.Location(), Locale: d.Locale, PageSize: d.PageSize, WeekStart: d.WeekStart}
+}
+
+// limitOrPreferred returns given limit, or page size preferred by user making request if limit is not specified.
+// fallback is used if request carries no preferences.
+func limitOrPreferred(ctx context.Context, limit, fallback int32) int32 {
+	if limit != 0 {
+		return limit
+	}
+	if p, ok := ctxhelper.Preferences(ctx); ok {
+		return p.PageSize
+	}
+	return fallback
+}
@@ -0,0 +1,100 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"go-playground/cmd/api/internal/domain/entity"
+	"go-playground/cmd/api/internal/usecase"
+	"go-playground/pkg/apperr"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserPreferencesUseCase_FindPreferences(t *testing.T) {
+	saved := entity.UserPreferences{
+		UserID:    testUserID,
+		Timezone:  "America/New_York",
+		Locale:    "en-US",
+		PageSize:  50,
+		WeekStart: time.Sunday,
+		UpdatedAt: time.Date(2026, 10, 27, 9, 0, 0, 0, time.UTC),
+	}
+	tests := map[string]struct {
+		findErr error
+		want    entity.UserPreferences
+		wantErr string
+	}{
+		"success": {
+			want: saved,
+		},
+		"success: default preferences of user who has never saved them": {
+			findErr: apperr.New("find preferences", "not found preferences", apperr.CodeNotFound),
+			want:    entity.DefaultUserPreferences(testUserID),
+		},
+		"failure": {
+			findErr: errors.New("connection refused"),
+			wantErr: "connection refused",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prefs := new(MockUserPreferencesRepository)
+			prefs.On("Find", context.Background(), testUserID).Return(saved, tc.findErr)
+			u := usecase.NewUserPreferencesUseCase(newMockUserRepository(), prefs)
+
+			got, err := u.FindPreferences(context.Background(), testSub)
+
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestUserPreferencesUseCase_UpdatePreferences(t *testing.T) {
+	tests := map[string]struct {
+		timezone string
+		setup    func() *MockUserPreferencesRepository
+		wantErr  apperr.Code
+	}{
+		"success": {
+			timezone: "America/New_York",
+			setup: func() *MockUserPreferencesRepository {
+				prefs := new(MockUserPreferencesRepository)
+				prefs.On("Save", context.Background(), mock.MatchedBy(func(p entity.UserPreferences) bool {
+					return p.UserID == testUserID && p.Timezone == "America/New_York" && p.Locale == "en-US" && p.PageSize == 50 && p.WeekStart == time.Sunday
+				})).Return(nil).Once()
+				return prefs
+			},
+		},
+		"failure invalid timezone": {
+			timezone: "Asia/Atlantis",
+			setup:    func() *MockUserPreferencesRepository { return new(MockUserPreferencesRepository) },
+			wantErr:  apperr.CodeInvalidArgument,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			prefs := tc.setup()
+			u := usecase.NewUserPreferencesUseCase(newMockUserRepository(), prefs)
+
+			got, err := u.UpdatePreferences(context.Background(), testSub, tc.timezone, "en-US", 50, time.Sunday)
+
+			if tc.wantErr != 0 {
+				assert.Zero(t, got)
+				assert.True(t, apperr.IsCode(err, tc.wantErr))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "America/New_York", got.Timezone)
+			}
+			prefs.AssertExpectations(t)
+		})
+	}
+}
@@ -2,10 +2,12 @@ package usecase_test
 
 import (
 	"context"
+	"errors"
 	"go-playground/cmd/api/internal/domain/entity"
 	"go-playground/cmd/api/internal/usecase"
 	"go-playground/pkg/apperr"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -15,13 +17,12 @@ import (
 func TestUserUseCase_CreateUser(t *testing.T) {
 	type input struct {
 		sub, givenName, familyName, email string
-		emailVerified                     bool
 	}
 	type want struct {
 		err     string
 		errCode apperr.Code
 	}
-	type setup func(t *testing.T, input input) *MockUserRepository
+	type setup func(t *testing.T, input input) (*MockUserRepository, *MockOrganizationRepository)
 	tests := map[string]struct {
 		input input
 		setup setup
@@ -29,60 +30,69 @@ func TestUserUseCase_CreateUser(t *testing.T) {
 	}{
 		"success": {
 			input: input{
-				sub:           "test-sub",
-				givenName:     "givenName",
-				familyName:    "familyName",
-				email:         "email@example.com",
-				emailVerified: true,
+				sub:        "test-sub",
+				givenName:  "givenName",
+				familyName: "familyName",
+				email:      "email@example.com",
 			},
-			setup: func(t *testing.T, input input) *MockUserRepository {
+			setup: func(t *testing.T, input input) (*MockUserRepository, *MockOrganizationRepository) {
 				mck := new(MockUserRepository)
 				userMatcher := mock.MatchedBy(func(user entity.User) bool {
 					require.Equal(t, input.sub, user.Sub)
 					require.Equal(t, input.givenName, user.GivenName)
 					require.Equal(t, input.familyName, user.FamilyName)
 					require.Equal(t, input.email, user.Email)
-					require.Equal(t, input.emailVerified, user.EmailVerified)
+					require.False(t, user.EmailVerified)
 					return true
 				})
 				mck.On("Create", context.Background(), userMatcher).Return(nil)
-				return mck
+				orgs := new(MockOrganizationRepository)
+				var orgID entity.OrganizationID
+				orgs.On("Create", context.Background(), mock.MatchedBy(func(org entity.Organization) bool {
+					orgID = org.ID
+					return org.Name == entity.PersonalOrganizationName
+				})).Return(nil)
+				orgs.On("AddMember", context.Background(), mock.MatchedBy(func(member entity.OrganizationMember) bool {
+					return member.OrganizationID == orgID && member.Role == entity.OrganizationRoleOwner
+				})).Return(nil)
+				return mck, orgs
 			},
 		},
 		"failure failed to create user": {
 			input: input{
-				sub:           "test-sub",
-				givenName:     "givenName",
-				familyName:    "familyName",
-				email:         "email@example.com",
-				emailVerified: true,
+				sub:        "test-sub",
+				givenName:  "givenName",
+				familyName: "familyName",
+				email:      "email@example.com",
 			},
-			setup: func(t *testing.T, input input) *MockUserRepository {
+			setup: func(t *testing.T, input input) (*MockUserRepository, *MockOrganizationRepository) {
 				mck := new(MockUserRepository)
 				userMatcher := mock.MatchedBy(func(user entity.User) bool {
 					require.Equal(t, input.sub, user.Sub)
 					require.Equal(t, input.givenName, user.GivenName)
 					require.Equal(t, input.familyName, user.FamilyName)
 					require.Equal(t, input.email, user.Email)
-					require.Equal(t, input.emailVerified, user.EmailVerified)
+					require.False(t, user.EmailVerified)
 					return true
 				})
 				mck.On("Create", context.Background(), userMatcher).Return(apperr.New("internal server error", "failed to update task"))
-				return mck
+				return mck, new(MockOrganizationRepository)
 			},
 			want: want{err: "internal server error", errCode: apperr.CodeInternal},
 		},
 		"failure validation error": {
-			setup: func(t *testing.T, input input) *MockUserRepository { return nil },
-			want:  want{err: "validate user entity: Email: cannot be blank; FamilyName: cannot be blank; GivenName: cannot be blank; Sub: cannot be blank.", errCode: apperr.CodeInvalidArgument},
+			setup: func(t *testing.T, input input) (*MockUserRepository, *MockOrganizationRepository) {
+				return new(MockUserRepository), new(MockOrganizationRepository)
+			},
+			want: want{err: "validate user entity: Email: cannot be blank; FamilyName: cannot be blank; GivenName: cannot be blank; Sub: cannot be blank.", errCode: apperr.CodeInvalidArgument},
 		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			mck := tc.setup(t, tc.input)
-			u := usecase.NewUserUseCase(mck)
+			mck, orgs := tc.setup(t, tc.input)
+			u := usecase.NewUserUseCase(mck, orgs, nil, nil, &MockTransactionRepository{})
 
-			got, err := u.CreateUser(context.Background(), tc.input.sub, tc.input.givenName, tc.input.familyName, tc.input.email, tc.input.emailVerified)
+			got, err := u.CreateUser(context.Background(), tc.input.sub, tc.input.givenName, tc.input.familyName, tc.input.email)
 
 			if tc.want.err != "" {
 				assert.Zero(t, got)
@@ -92,6 +102,8 @@ func TestUserUseCase_CreateUser(t *testing.T) {
 				assert.NotZero(t, got)
 				assert.NoError(t, err)
 			}
+			mck.AssertExpectations(t)
+			orgs.AssertExpectations(t)
 		})
 	}
 }
@@ -103,7 +115,7 @@ func TestFindMe(t *testing.T) {
 	}
 	in := input{ctx: context.Background(), sub: "0195195a-2958-7ccd-b39d-c7cbe04128b1"}
 	mck := new(MockUserRepository)
-	uc := usecase.NewUserUseCase(mck)
+	uc := usecase.NewUserUseCase(mck, nil, nil, nil, &MockTransactionRepository{})
 	mck.On("FindBySub", in.ctx, in.sub).
 		Return(entity.User{}, nil)
 
@@ -111,3 +123,355 @@ func TestFindMe(t *testing.T) {
 
 	assert.NoError(t, err)
 }
+
+func TestUserUseCase_ProvisionUser(t *testing.T) {
+	updatedAt := time.Date(2024, 11, 8, 0, 0, 0, 0, time.UTC)
+	current := entity.User{
+		ID:            testUserID,
+		Sub:           testSub,
+		GivenName:     "Dibbert",
+		FamilyName:    "Kozey",
+		Email:         "Jonathan74@example.com",
+		EmailVerified: true,
+		UpdatedAt:     updatedAt,
+	}
+	notFound := apperr.New("not found", "user is not found", apperr.CodeNotFound)
+	type input struct {
+		givenName string
+		email     string
+		issuedAt  time.Time
+	}
+	type want struct {
+		err     string
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		input input
+		setup func(t *testing.T, orgs *MockOrganizationRepository) (*MockUserRepository, *MockAuditLogRepository)
+		want  want
+	}{
+		"success create new user": {
+			input: input{givenName: "Dibbert", email: "Jonathan74@example.com", issuedAt: updatedAt.Add(time.Hour)},
+			setup: func(t *testing.T, orgs *MockOrganizationRepository) (*MockUserRepository, *MockAuditLogRepository) {
+				users := new(MockUserRepository)
+				users.On("FindBySub", context.Background(), testSub).Return(entity.User{}, notFound).Once()
+				users.On("FindBySubForUpdate", context.Background(), testSub).Return(entity.User{}, notFound).Once()
+				users.On("Create", context.Background(), mock.MatchedBy(func(u entity.User) bool {
+					return u.Sub == testSub && u.GivenName == "Dibbert" && u.FamilyName == "Kozey" && u.EmailVerified
+				})).Return(nil).Once()
+				orgs.On("Create", context.Background(), mock.MatchedBy(func(org entity.Organization) bool {
+					return org.Name == entity.PersonalOrganizationName
+				})).Return(nil).Once()
+				orgs.On("AddMember", context.Background(), mock.MatchedBy(func(member entity.OrganizationMember) bool {
+					return member.Role == entity.OrganizationRoleOwner
+				})).Return(nil).Once()
+				return users, new(MockAuditLogRepository)
+			},
+		},
+		"success skip token issued before last update": {
+			input: input{givenName: "Alice", email: "Jonathan74@example.com", issuedAt: updatedAt},
+			setup: func(t *testing.T, orgs *MockOrganizationRepository) (*MockUserRepository, *MockAuditLogRepository) {
+				users := new(MockUserRepository)
+				users.On("FindBySub", context.Background(), testSub).Return(current, nil).Once()
+				return users, new(MockAuditLogRepository)
+			},
+		},
+		"success skip deleted user": {
+			input: input{givenName: "Alice", email: "Jonathan74@example.com", issuedAt: updatedAt.Add(time.Hour)},
+			setup: func(t *testing.T, orgs *MockOrganizationRepository) (*MockUserRepository, *MockAuditLogRepository) {
+				deleted := current
+				deleted.DeletedAt = updatedAt
+				users := new(MockUserRepository)
+				users.On("FindBySub", context.Background(), testSub).Return(deleted, nil).Once()
+				return users, new(MockAuditLogRepository)
+			},
+		},
+		"success nothing to sync": {
+			input: input{givenName: "Dibbert", email: "Jonathan74@example.com", issuedAt: updatedAt.Add(time.Hour)},
+			setup: func(t *testing.T, orgs *MockOrganizationRepository) (*MockUserRepository, *MockAuditLogRepository) {
+				users := new(MockUserRepository)
+				users.On("FindBySub", context.Background(), testSub).Return(current, nil).Once()
+				users.On("FindBySubForUpdate", context.Background(), testSub).Return(current, nil).Once()
+				return users, new(MockAuditLogRepository)
+			},
+		},
+		"success sync email with audit log": {
+			input: input{givenName: "Dibbert", email: "alice@example.com", issuedAt: updatedAt.Add(time.Hour)},
+			setup: func(t *testing.T, orgs *MockOrganizationRepository) (*MockUserRepository, *MockAuditLogRepository) {
+				users := new(MockUserRepository)
+				users.On("FindBySub", context.Background(), testSub).Return(current, nil).Once()
+				users.On("FindBySubForUpdate", context.Background(), testSub).Return(current, nil).Once()
+				users.On("Update", context.Background(), mock.MatchedBy(func(u entity.User) bool {
+					return u.Email == "alice@example.com" && u.EmailVerified
+				})).Return(nil).Once()
+				logs := new(MockAuditLogRepository)
+				logs.On("Create", context.Background(), mock.MatchedBy(func(l entity.AuditLog) bool {
+					return l.Action == entity.AuditActionUserEmailChanged &&
+						l.Detail["source"] == "access_token" &&
+						len(l.Detail) == 1
+				})).Return(nil).Once()
+				return users, logs
+			},
+		},
+		"failure invalid claims": {
+			input: input{email: "Jonathan74@example.com", issuedAt: updatedAt.Add(time.Hour)},
+			setup: func(t *testing.T, orgs *MockOrganizationRepository) (*MockUserRepository, *MockAuditLogRepository) {
+				users := new(MockUserRepository)
+				users.On("FindBySub", context.Background(), testSub).Return(entity.User{}, notFound).Once()
+				users.On("FindBySubForUpdate", context.Background(), testSub).Return(entity.User{}, notFound).Once()
+				return users, new(MockAuditLogRepository)
+			},
+			want: want{err: "validate user entity: GivenName: cannot be blank.", errCode: apperr.CodeInvalidArgument},
+		},
+		"failure find user": {
+			input: input{givenName: "Dibbert", email: "Jonathan74@example.com", issuedAt: updatedAt.Add(time.Hour)},
+			setup: func(t *testing.T, orgs *MockOrganizationRepository) (*MockUserRepository, *MockAuditLogRepository) {
+				users := new(MockUserRepository)
+				users.On("FindBySub", context.Background(), testSub).Return(entity.User{}, errors.New("unexpected")).Once()
+				return users, new(MockAuditLogRepository)
+			},
+			want: want{err: "unexpected"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			orgs := new(MockOrganizationRepository)
+			users, logs := tc.setup(t, orgs)
+			u := usecase.NewUserUseCase(users, orgs, nil, logs, &MockTransactionRepository{})
+
+			err := u.ProvisionUser(context.Background(), testSub, tc.input.givenName, "Kozey", tc.input.email, true, tc.input.issuedAt)
+
+			if tc.want.err != "" {
+				assert.EqualError(t, err, tc.want.err)
+				if tc.want.errCode != apperr.CodeUnknown {
+					assert.True(t, apperr.IsCode(err, tc.want.errCode))
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			users.AssertExpectations(t)
+			orgs.AssertExpectations(t)
+			logs.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUserUseCase_UpdateMe(t *testing.T) {
+	var (
+		givenName = "Alice"
+		newEmail  = "alice@example.com"
+		blank     = ""
+	)
+	current := entity.User{
+		ID:            testUserID,
+		Sub:           testSub,
+		GivenName:     "Dibbert",
+		FamilyName:    "Kozey",
+		Email:         "Jonathan74@example.com",
+		EmailVerified: true,
+	}
+	type want struct {
+		user    entity.User
+		err     string
+		errCode apperr.Code
+	}
+	tests := map[string]struct {
+		input entity.UserProfilePatch
+		setup func(t *testing.T) (*MockUserRepository, *MockAuditLogRepository)
+		want  want
+	}{
+		"success update name without audit log": {
+			input: entity.UserProfilePatch{GivenName: &givenName},
+			setup: func(t *testing.T) (*MockUserRepository, *MockAuditLogRepository) {
+				users := new(MockUserRepository)
+				users.On("FindBySubForUpdate", context.Background(), testSub).Return(current, nil)
+				users.On("Update", context.Background(), mock.MatchedBy(func(u entity.User) bool {
+					return u.GivenName == "Alice" && u.EmailVerified
+				})).Return(nil)
+				return users, new(MockAuditLogRepository)
+			},
+			want: want{user: entity.User{
+				ID:            testUserID,
+				Sub:           testSub,
+				GivenName:     "Alice",
+				FamilyName:    "Kozey",
+				Email:         "Jonathan74@example.com",
+				EmailVerified: true,
+			}},
+		},
+		"success change email with audit log": {
+			input: entity.UserProfilePatch{Email: &newEmail},
+			setup: func(t *testing.T) (*MockUserRepository, *MockAuditLogRepository) {
+				users := new(MockUserRepository)
+				users.On("FindBySubForUpdate", context.Background(), testSub).Return(current, nil)
+				users.On("Update", context.Background(), mock.MatchedBy(func(u entity.User) bool {
+					return u.Email == "alice@example.com" && !u.EmailVerified
+				})).Return(nil)
+				logs := new(MockAuditLogRepository)
+				logs.On("Create", context.Background(), mock.MatchedBy(func(l entity.AuditLog) bool {
+					return l.ActorID == testUserID &&
+						l.Action == entity.AuditActionUserEmailChanged &&
+						l.TargetID == testUserID.String() &&
+						l.Detail == nil
+				})).Return(nil).Once()
+				return users, logs
+			},
+			want: want{user: entity.User{
+				ID:         testUserID,
+				Sub:        testSub,
+				GivenName:  "Dibbert",
+				FamilyName: "Kozey",
+				Email:      "alice@example.com",
+			}},
+		},
+		"failure validation error": {
+			input: entity.UserProfilePatch{FamilyName: &blank},
+			setup: func(t *testing.T) (*MockUserRepository, *MockAuditLogRepository) {
+				users := new(MockUserRepository)
+				users.On("FindBySubForUpdate", context.Background(), testSub).Return(current, nil)
+				return users, new(MockAuditLogRepository)
+			},
+			want: want{err: "validate user entity: FamilyName: cannot be blank.", errCode: apperr.CodeInvalidArgument},
+		},
+		"failure user not found": {
+			input: entity.UserProfilePatch{GivenName: &givenName},
+			setup: func(t *testing.T) (*MockUserRepository, *MockAuditLogRepository) {
+				users := new(MockUserRepository)
+				users.On("FindBySubForUpdate", context.Background(), testSub).Return(entity.User{}, apperr.New("not found", "user is not found", apperr.CodeNotFound))
+				return users, new(MockAuditLogRepository)
+			},
+			want: want{err: "not found", errCode: apperr.CodeNotFound},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			users, logs := tc.setup(t)
+			u := usecase.NewUserUseCase(users, nil, nil, logs, &MockTransactionRepository{})
+
+			got, err := u.UpdateMe(context.Background(), testSub, tc.input)
+
+			if tc.want.err != "" {
+				assert.Zero(t, got)
+				assert.EqualError(t, err, tc.want.err)
+				assert.True(t, apperr.IsCode(err, tc.want.errCode))
+			} else {
+				require.NoError(t, err)
+				got.UpdatedAt = time.Time{}
+				assert.Equal(t, tc.want.user, got)
+			}
+			users.AssertExpectations(t)
+			logs.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUserUseCase_DeleteMe(t *testing.T) {
+	tests := map[string]struct {
+		current entity.User
+		wantErr string
+	}{
+		"success": {
+			current: entity.User{ID: testUserID, Sub: testSub},
+		},
+		"failure already deleted": {
+			current: entity.User{ID: testUserID, Sub: testSub, DeletedAt: time.Date(2024, 11, 8, 0, 0, 0, 0, time.UTC)},
+			wantErr: "delete user but user is already deleted",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			users := new(MockUserRepository)
+			users.On("FindBySubForUpdate", context.Background(), testSub).Return(tc.current, nil)
+			archives := new(MockTaskArchiveRepository)
+			logs := new(MockAuditLogRepository)
+			if tc.wantErr == "" {
+				archives.On("ArchiveOwnedTasks", context.Background(), testUserID, mock.AnythingOfType("time.Time")).Return(int64(2), nil).Once()
+				users.On("Update", context.Background(), mock.MatchedBy(func(u entity.User) bool { return u.Deleted() })).Return(nil).Once()
+				logs.On("Create", context.Background(), mock.MatchedBy(func(l entity.AuditLog) bool {
+					return l.Action == entity.AuditActionUserDeleted && l.TargetID == testUserID.String()
+				})).Return(nil).Once()
+			}
+			u := usecase.NewUserUseCase(users, nil, archives, logs, &MockTransactionRepository{})
+
+			got, err := u.DeleteMe(context.Background(), testSub)
+
+			if tc.wantErr != "" {
+				assert.Zero(t, got)
+				assert.EqualError(t, err, tc.wantErr)
+				assert.True(t, apperr.IsCode(err, apperr.CodeFailedPrecondition))
+			} else {
+				require.NoError(t, err)
+				assert.True(t, got.Deleted())
+				archives.AssertCalled(t, "ArchiveOwnedTasks", context.Background(), testUserID, got.DeletedAt)
+			}
+			users.AssertExpectations(t)
+			archives.AssertExpectations(t)
+			logs.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUserUseCase_RestoreMe(t *testing.T) {
+	deletedAt := time.Now().Add(-time.Hour)
+	users := new(MockUserRepository)
+	users.On("FindBySubForUpdate", context.Background(), testSub).Return(entity.User{ID: testUserID, Sub: testSub, DeletedAt: deletedAt}, nil)
+	users.On("Update", context.Background(), mock.MatchedBy(func(u entity.User) bool { return !u.Deleted() })).Return(nil).Once()
+	archives := new(MockTaskArchiveRepository)
+	archives.On("UnarchiveOwnedTasks", context.Background(), testUserID, deletedAt).Return(int64(2), nil).Once()
+	logs := new(MockAuditLogRepository)
+	logs.On("Create", context.Background(), mock.MatchedBy(func(l entity.AuditLog) bool {
+		return l.Action == entity.AuditActionUserRestored
+	})).Return(nil).Once()
+	u := usecase.NewUserUseCase(users, nil, archives, logs, &MockTransactionRepository{})
+
+	got, err := u.RestoreMe(context.Background(), testSub)
+
+	require.NoError(t, err)
+	assert.False(t, got.Deleted())
+	users.AssertExpectations(t)
+	archives.AssertExpectations(t)
+	logs.AssertExpectations(t)
+}
+
+func TestUserUseCase_PurgeDeletedUsers(t *testing.T) {
+	now := time.Date(2024, 12, 8, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2024, 11, 8, 0, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		batchSize int32
+		setup     func() *MockUserRepository
+		want      int64
+		wantErr   string
+	}{
+		"success in multiple batches": {
+			batchSize: 2,
+			setup: func() *MockUserRepository {
+				mck := new(MockUserRepository)
+				mck.On("PurgeDeleted", context.Background(), before, int32(2)).Return(int64(2), nil).Once()
+				mck.On("PurgeDeleted", context.Background(), before, int32(2)).Return(int64(0), nil).Once()
+				return mck
+			},
+			want: 2,
+		},
+		"failure batch size is out of range": {
+			batchSize: 0,
+			setup:     func() *MockUserRepository { return new(MockUserRepository) },
+			wantErr:   "purge batch size 0 is out of range",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mck := tc.setup()
+			u := usecase.NewUserUseCase(mck, nil, nil, nil, &MockTransactionRepository{})
+
+			got, err := u.PurgeDeletedUsers(context.Background(), now, tc.batchSize)
+
+			assert.Equal(t, tc.want, got)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			mck.AssertExpectations(t)
+		})
+	}
+}
@@ -5,10 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"go-playground/cmd/api/internal/transportlayer/rest/handler/v2"
+	"go-playground/pkg/apperr"
+	"go-playground/pkg/ctxhelper"
 	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
@@ -17,15 +20,16 @@ import (
 )
 
 func main() {
-	svr, err := setup()
+	// ctx is done by interrupt signal, which stops background workers as well as server.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	svr, err := setup(ctx)
 	if err != nil {
 		panic(err)
 	}
 	idleConnsClosed := make(chan struct{})
 	go func() {
-		sigint := make(chan os.Signal, 1)
-		signal.Notify(sigint, os.Interrupt, syscall.SIGTERM)
-		<-sigint
+		<-ctx.Done()
 
 		slog.Info("We received an interrupt signal,so attempt to shutdown with gracefully")
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -45,18 +49,25 @@ func main() {
 	slog.Info("Bye!!")
 }
 
-func setup() (*http.Server, error) {
+func setup(ctx context.Context) (*http.Server, error) {
 	app, err := newrelic.NewApplication(newrelic.ConfigFromEnvironment())
 	if err != nil {
 		return nil, fmt.Errorf("new newrelic application: %w", err)
 	}
 	slog.SetDefault(slog.New(
-		nrslog.NewHandler(
-			app,
-			nrslog.WithHandler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{AddSource: true})),
+		ctxhelper.NewLogHandler(
+			nrslog.NewHandler(
+				app,
+				nrslog.WithHandler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{AddSource: true})),
+			),
 		),
 	))
-	mux, err := handler.New(app, os.LookupEnv)
+	stackPolicy, err := stackPolicyFromEnv(os.LookupEnv)
+	if err != nil {
+		return nil, fmt.Errorf("find stack trace config from env: %w", err)
+	}
+	apperr.SetStackPolicy(stackPolicy)
+	mux, err := handler.New(ctx, app, os.LookupEnv)
 	if err != nil {
 		return nil, fmt.Errorf("new handler: %w", err)
 	}
@@ -70,3 +81,21 @@ func setup() (*http.Server, error) {
 	}
 	return svr, nil
 }
+
+// stackPolicyFromEnv reads policy of capturing stack trace of errors. [apperr.DefaultStackPolicy] is kept for missing env.
+func stackPolicyFromEnv(lookup func(string) (string, bool)) (apperr.StackPolicy, error) {
+	p := apperr.DefaultStackPolicy
+	if v, ok := lookup("ERROR_STACK_TRACE_DEPTH"); ok {
+		depth, err := strconv.Atoi(v)
+		if err != nil || depth < 0 {
+			return apperr.StackPolicy{}, fmt.Errorf("ERROR_STACK_TRACE_DEPTH must be non-negative integer but got %q", v)
+		}
+		p.Depth = depth
+	}
+	if v, ok := lookup("ERROR_STACK_TRACE_MIN_LEVEL"); ok {
+		if err := p.MinLevel.UnmarshalText([]byte(v)); err != nil {
+			return apperr.StackPolicy{}, fmt.Errorf("parse ERROR_STACK_TRACE_MIN_LEVEL: %w", err)
+		}
+	}
+	return p, nil
+}
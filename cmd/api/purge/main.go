@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"go-playground/cmd/api/internal/datasource"
+	"go-playground/cmd/api/internal/datasource/database"
+	"go-playground/cmd/api/internal/usecase"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+func main() {
+	batch := flag.Int("batch", 100, "number of users purged in one transaction")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	db, err := database.NewDB(os.LookupEnv)
+	if err != nil {
+		panic(err)
+	}
+	defer func() { _ = db.Close() }()
+	u := usecase.NewUserUseCase(datasource.NewUserAdaptor(db), datasource.NewOrganizationAdaptor(db), datasource.NewTaskArchiveAdaptor(db), datasource.NewAuditLogAdaptor(db), datasource.NewDBTransactionAdaptor(db))
+
+	n, err := u.PurgeDeletedUsers(ctx, time.Now(), int32(*batch))
+	if err != nil {
+		// Committed batches are kept, so running again resumes from remaining users.
+		slog.Error("Failed to purge deleted users", slog.Int64("purged", n), slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	slog.Info("Purged deleted users", slog.Int64("purged", n))
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"go-playground/pkg/testhelper"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+func main() {
+	addr := flag.String("addr", "127.0.0.1:18081", "address to listen")
+	issuerURL := flag.String("issuer", "http://localhost:18081/", "issuer url, which is iss claim of minted tokens")
+	flag.Parse()
+
+	issuer, err := testhelper.NewIssuer(*issuerURL)
+	if err != nil {
+		panic(err)
+	}
+	svr := &http.Server{
+		Addr:              *addr,
+		Handler:           issuer,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	slog.Info("Dev issuer starting", slog.String("addr", *addr), slog.String("issuer", issuer.URL()))
+	if err := svr.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		panic(err)
+	}
+}
@@ -0,0 +1,60 @@
+package apperr
+
+import (
+	"log/slog"
+	"math"
+)
+
+// Attrs returns attributes given by [WithAttrs].
+// This method is useful for logging with slog. For example
+//
+//	slog.LogAttrs(ctx, appErr.Level(), appErr.Error(), appErr.Attrs()...)
+func (e *Error) Attrs() []slog.Attr {
+	return e.attrs[:len(e.attrs):len(e.attrs)]
+}
+
+// ErrorAttributes implements interface used by [newrelic.Transaction.NoticeError],
+// so attributes given by [WithAttrs] are reported to New Relic as attributes of error.
+//
+// Attributes in group are flattened into keys joined by dot.
+// Values which New Relic does not accept such as time are reported as string.
+//
+// [newrelic.Transaction.NoticeError]: https://pkg.go.dev/github.com/newrelic/go-agent/v3/newrelic#Transaction.NoticeError
+func (e *Error) ErrorAttributes() map[string]any {
+	if len(e.attrs) == 0 {
+		return nil
+	}
+	m := make(map[string]any, len(e.attrs))
+	for _, attr := range e.attrs {
+		putAttr(m, "", attr)
+	}
+	return m
+}
+
+func putAttr(m map[string]any, prefix string, attr slog.Attr) {
+	key := attr.Key
+	if prefix != "" {
+		key = prefix + "." + key
+	}
+	v := attr.Value.Resolve()
+	switch v.Kind() {
+	case slog.KindGroup:
+		for _, a := range v.Group() {
+			putAttr(m, key, a)
+		}
+	case slog.KindBool:
+		m[key] = v.Bool()
+	case slog.KindInt64:
+		m[key] = v.Int64()
+	case slog.KindUint64:
+		m[key] = v.Uint64()
+	case slog.KindFloat64:
+		if f := v.Float64(); !math.IsNaN(f) && !math.IsInf(f, 0) {
+			m[key] = f
+		} else {
+			m[key] = v.String()
+		}
+	default:
+		m[key] = v.String()
+	}
+}
@@ -0,0 +1,51 @@
+package apperr
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// MySQL error numbers classified by [classify].
+// See https://dev.mysql.com/doc/mysql-errors/8.0/en/server-error-reference.html
+const (
+	mysqlTooManyConnections    = 1040
+	mysqlDuplicateEntry        = 1062
+	mysqlLockWaitTimeout       = 1205
+	mysqlDeadlock              = 1213
+	mysqlDataTooLong           = 1406
+	mysqlRowIsReferenced       = 1451
+	mysqlNoReferencedRow       = 1452
+	mysqlQueryExecutionTimeout = 3024
+)
+
+// classify infers code from cause. ok is false if cause is not known kind of error.
+func classify(cause error) (code Code, ok bool) {
+	switch {
+	case errors.Is(cause, context.Canceled):
+		return CodeCanceled, true
+	case errors.Is(cause, context.DeadlineExceeded):
+		return CodeDeadlineExceeded, true
+	}
+	var mysqlErr *mysql.MySQLError
+	if !errors.As(cause, &mysqlErr) {
+		return 0, false
+	}
+	switch mysqlErr.Number {
+	case mysqlDuplicateEntry:
+		return CodeAlreadyExists, true
+	case mysqlLockWaitTimeout, mysqlDeadlock:
+		return CodeConflict, true
+	case mysqlRowIsReferenced, mysqlNoReferencedRow:
+		return CodeFailedPrecondition, true
+	case mysqlDataTooLong:
+		return CodeInvalidArgument, true
+	case mysqlTooManyConnections:
+		return CodeUnavailable, true
+	case mysqlQueryExecutionTimeout:
+		return CodeDeadlineExceeded, true
+	default:
+		return 0, false
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+
+	"google.golang.org/grpc/codes"
 )
 
 // Code represents application error code.
@@ -22,8 +24,27 @@ const (
 	CodeUnAuthz
 	CodeNotFound
 	CodeInternal
+	// CodeAlreadyExists is that resource which client tries to create already exists.
+	CodeAlreadyExists
+	// CodeConflict is that operation is aborted by concurrent operation, such as optimistic lock failure or deadlock.
+	CodeConflict
+	// CodeFailedPrecondition is that resource is not in state required by operation.
+	CodeFailedPrecondition
+	// CodeResourceExhausted is that quota or rate limit is exhausted.
+	CodeResourceExhausted
+	// CodeUnavailable is that dependency such as database is unavailable for now.
+	CodeUnavailable
+	// CodeDeadlineExceeded is that operation does not complete before deadline.
+	CodeDeadlineExceeded
+	// CodeCanceled is that operation is canceled, typically by client.
+	CodeCanceled
+	// CodeUnimplemented is that operation is not implemented.
+	CodeUnimplemented
 )
 
+// StatusClientClosedRequest is non-standard HTTP status of [CodeCanceled], which is known as status of nginx.
+const StatusClientClosedRequest = 499
+
 // String implements [fmt.Stringer].
 func (c Code) String() string {
 	switch c {
@@ -39,14 +60,33 @@ func (c Code) String() string {
 		return "notfound"
 	case CodeInternal:
 		return "internalServerError"
+	case CodeAlreadyExists:
+		return "alreadyExists"
+	case CodeConflict:
+		return "conflict"
+	case CodeFailedPrecondition:
+		return "failedPrecondition"
+	case CodeResourceExhausted:
+		return "resourceExhausted"
+	case CodeUnavailable:
+		return "unavailable"
+	case CodeDeadlineExceeded:
+		return "deadlineExceeded"
+	case CodeCanceled:
+		return "canceled"
+	case CodeUnimplemented:
+		return "unimplemented"
 	default:
 		return fmt.Sprintf("Code(%d)", c)
 	}
 }
 
-func (c Code) status() int {
+// HTTPStatus is HTTP status code of c.
+//
+// Unknown codes are mapped to 500.
+func (c Code) HTTPStatus() int {
 	switch c {
-	case CodeInvalidArgument:
+	case CodeInvalidArgument, CodeFailedPrecondition:
 		return http.StatusBadRequest
 	case CodeUnAuthn:
 		return http.StatusUnauthorized
@@ -54,6 +94,18 @@ func (c Code) status() int {
 		return http.StatusForbidden
 	case CodeNotFound:
 		return http.StatusNotFound
+	case CodeAlreadyExists, CodeConflict:
+		return http.StatusConflict
+	case CodeResourceExhausted:
+		return http.StatusTooManyRequests
+	case CodeUnavailable:
+		return http.StatusServiceUnavailable
+	case CodeDeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case CodeCanceled:
+		return StatusClientClosedRequest
+	case CodeUnimplemented:
+		return http.StatusNotImplemented
 	default: // when CodeUnknown, CodeInternal or etc.
 		return http.StatusInternalServerError
 	}
@@ -61,14 +113,62 @@ func (c Code) status() int {
 
 func (c Code) level() slog.Level {
 	switch c {
-	case CodeInvalidArgument, CodeUnAuthn, CodeUnAuthz, CodeNotFound:
+	case CodeInvalidArgument, CodeUnAuthn, CodeUnAuthz, CodeNotFound,
+		CodeAlreadyExists, CodeConflict, CodeFailedPrecondition, CodeResourceExhausted, CodeCanceled:
 		return slog.LevelWarn
 	default:
 		return slog.LevelError
 	}
 }
 
+// retryable reports whether operation failed with c may succeed by retrying it later without any change.
+func (c Code) retryable() bool {
+	switch c {
+	case CodeConflict, CodeResourceExhausted, CodeUnavailable, CodeDeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// GRPCCode is gRPC status code of c.
+//
+// Unknown codes are mapped to [codes.Unknown].
+func (c Code) GRPCCode() codes.Code {
+	switch c {
+	case CodeInvalidArgument:
+		return codes.InvalidArgument
+	case CodeUnAuthn:
+		return codes.Unauthenticated
+	case CodeUnAuthz:
+		return codes.PermissionDenied
+	case CodeNotFound:
+		return codes.NotFound
+	case CodeInternal:
+		return codes.Internal
+	case CodeAlreadyExists:
+		return codes.AlreadyExists
+	case CodeConflict:
+		return codes.Aborted
+	case CodeFailedPrecondition:
+		return codes.FailedPrecondition
+	case CodeResourceExhausted:
+		return codes.ResourceExhausted
+	case CodeUnavailable:
+		return codes.Unavailable
+	case CodeDeadlineExceeded:
+		return codes.DeadlineExceeded
+	case CodeCanceled:
+		return codes.Canceled
+	case CodeUnimplemented:
+		return codes.Unimplemented
+	default:
+		return codes.Unknown
+	}
+}
+
 // do implements [Option] interface.
 func (c Code) do(e *Error) {
 	e.code = c
+	e.codeGiven = true
 }
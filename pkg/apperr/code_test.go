@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 )
 
 func TestCodeString(t *testing.T) {
@@ -13,13 +14,21 @@ func TestCodeString(t *testing.T) {
 		input Code
 		want  string
 	}{
-		"CodeUnknown":         {input: CodeUnknown, want: "unknown"},
-		"CodeInvalidArgument": {input: CodeInvalidArgument, want: "invalidArgument"},
-		"CodeUnAuthn":         {input: CodeUnAuthn, want: "unauthenticated"},
-		"CodeUnAuthz":         {input: CodeUnAuthz, want: "unauthorized"},
-		"CodeNotFound":        {input: CodeNotFound, want: "notfound"},
-		"CodeInternal":        {input: CodeInternal, want: "internalServerError"},
-		"CodeCustom":          {input: Code(uint32(100)), want: "Code(100)"},
+		"CodeUnknown":            {input: CodeUnknown, want: "unknown"},
+		"CodeInvalidArgument":    {input: CodeInvalidArgument, want: "invalidArgument"},
+		"CodeUnAuthn":            {input: CodeUnAuthn, want: "unauthenticated"},
+		"CodeUnAuthz":            {input: CodeUnAuthz, want: "unauthorized"},
+		"CodeNotFound":           {input: CodeNotFound, want: "notfound"},
+		"CodeInternal":           {input: CodeInternal, want: "internalServerError"},
+		"CodeAlreadyExists":      {input: CodeAlreadyExists, want: "alreadyExists"},
+		"CodeConflict":           {input: CodeConflict, want: "conflict"},
+		"CodeFailedPrecondition": {input: CodeFailedPrecondition, want: "failedPrecondition"},
+		"CodeResourceExhausted":  {input: CodeResourceExhausted, want: "resourceExhausted"},
+		"CodeUnavailable":        {input: CodeUnavailable, want: "unavailable"},
+		"CodeDeadlineExceeded":   {input: CodeDeadlineExceeded, want: "deadlineExceeded"},
+		"CodeCanceled":           {input: CodeCanceled, want: "canceled"},
+		"CodeUnimplemented":      {input: CodeUnimplemented, want: "unimplemented"},
+		"CodeCustom":             {input: Code(uint32(100)), want: "Code(100)"},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -35,17 +44,25 @@ func TestCodeStatus(t *testing.T) {
 		input Code
 		want  int
 	}{
-		"CodeUnknown":         {input: CodeUnknown, want: http.StatusInternalServerError},
-		"CodeInvalidArgument": {input: CodeInvalidArgument, want: http.StatusBadRequest},
-		"CodeUnAuthn":         {input: CodeUnAuthn, want: http.StatusUnauthorized},
-		"CodeUnAuthz":         {input: CodeUnAuthz, want: http.StatusForbidden},
-		"CodeNotFound":        {input: CodeNotFound, want: http.StatusNotFound},
-		"CodeInternal":        {input: CodeInternal, want: http.StatusInternalServerError},
-		"CodeCustom":          {input: Code(uint32(100)), want: http.StatusInternalServerError},
+		"CodeUnknown":            {input: CodeUnknown, want: http.StatusInternalServerError},
+		"CodeInvalidArgument":    {input: CodeInvalidArgument, want: http.StatusBadRequest},
+		"CodeUnAuthn":            {input: CodeUnAuthn, want: http.StatusUnauthorized},
+		"CodeUnAuthz":            {input: CodeUnAuthz, want: http.StatusForbidden},
+		"CodeNotFound":           {input: CodeNotFound, want: http.StatusNotFound},
+		"CodeInternal":           {input: CodeInternal, want: http.StatusInternalServerError},
+		"CodeAlreadyExists":      {input: CodeAlreadyExists, want: http.StatusConflict},
+		"CodeConflict":           {input: CodeConflict, want: http.StatusConflict},
+		"CodeFailedPrecondition": {input: CodeFailedPrecondition, want: http.StatusBadRequest},
+		"CodeResourceExhausted":  {input: CodeResourceExhausted, want: http.StatusTooManyRequests},
+		"CodeUnavailable":        {input: CodeUnavailable, want: http.StatusServiceUnavailable},
+		"CodeDeadlineExceeded":   {input: CodeDeadlineExceeded, want: http.StatusGatewayTimeout},
+		"CodeCanceled":           {input: CodeCanceled, want: StatusClientClosedRequest},
+		"CodeUnimplemented":      {input: CodeUnimplemented, want: http.StatusNotImplemented},
+		"CodeCustom":             {input: Code(uint32(100)), want: http.StatusInternalServerError},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			got := tc.input.status()
+			got := tc.input.HTTPStatus()
 
 			assert.Equal(t, tc.want, got)
 		})
@@ -57,13 +74,21 @@ func TestCodeLevel(t *testing.T) {
 		input Code
 		want  slog.Level
 	}{
-		"CodeUnknown":         {input: CodeUnknown, want: slog.LevelError},
-		"CodeInvalidArgument": {input: CodeInvalidArgument, want: slog.LevelWarn},
-		"CodeUnAuthn":         {input: CodeUnAuthn, want: slog.LevelWarn},
-		"CodeUnAuthz":         {input: CodeUnAuthz, want: slog.LevelWarn},
-		"CodeNotFound":        {input: CodeNotFound, want: slog.LevelWarn},
-		"CodeInternal":        {input: CodeInternal, want: slog.LevelError},
-		"CodeCustom":          {input: Code(uint32(100)), want: slog.LevelError},
+		"CodeUnknown":            {input: CodeUnknown, want: slog.LevelError},
+		"CodeInvalidArgument":    {input: CodeInvalidArgument, want: slog.LevelWarn},
+		"CodeUnAuthn":            {input: CodeUnAuthn, want: slog.LevelWarn},
+		"CodeUnAuthz":            {input: CodeUnAuthz, want: slog.LevelWarn},
+		"CodeNotFound":           {input: CodeNotFound, want: slog.LevelWarn},
+		"CodeInternal":           {input: CodeInternal, want: slog.LevelError},
+		"CodeAlreadyExists":      {input: CodeAlreadyExists, want: slog.LevelWarn},
+		"CodeConflict":           {input: CodeConflict, want: slog.LevelWarn},
+		"CodeFailedPrecondition": {input: CodeFailedPrecondition, want: slog.LevelWarn},
+		"CodeResourceExhausted":  {input: CodeResourceExhausted, want: slog.LevelWarn},
+		"CodeUnavailable":        {input: CodeUnavailable, want: slog.LevelError},
+		"CodeDeadlineExceeded":   {input: CodeDeadlineExceeded, want: slog.LevelError},
+		"CodeCanceled":           {input: CodeCanceled, want: slog.LevelWarn},
+		"CodeUnimplemented":      {input: CodeUnimplemented, want: slog.LevelError},
+		"CodeCustom":             {input: Code(uint32(100)), want: slog.LevelError},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -73,3 +98,62 @@ func TestCodeLevel(t *testing.T) {
 		})
 	}
 }
+
+func TestCodeRetryable(t *testing.T) {
+	tests := map[string]struct {
+		input Code
+		want  bool
+	}{
+		"CodeUnknown":            {input: CodeUnknown},
+		"CodeInvalidArgument":    {input: CodeInvalidArgument},
+		"CodeUnAuthn":            {input: CodeUnAuthn},
+		"CodeUnAuthz":            {input: CodeUnAuthz},
+		"CodeNotFound":           {input: CodeNotFound},
+		"CodeInternal":           {input: CodeInternal},
+		"CodeAlreadyExists":      {input: CodeAlreadyExists},
+		"CodeConflict":           {input: CodeConflict, want: true},
+		"CodeFailedPrecondition": {input: CodeFailedPrecondition},
+		"CodeResourceExhausted":  {input: CodeResourceExhausted, want: true},
+		"CodeUnavailable":        {input: CodeUnavailable, want: true},
+		"CodeDeadlineExceeded":   {input: CodeDeadlineExceeded, want: true},
+		"CodeCanceled":           {input: CodeCanceled},
+		"CodeUnimplemented":      {input: CodeUnimplemented},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tc.input.retryable()
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCodeGRPCCode(t *testing.T) {
+	tests := map[string]struct {
+		input Code
+		want  codes.Code
+	}{
+		"CodeUnknown":            {input: CodeUnknown, want: codes.Unknown},
+		"CodeInvalidArgument":    {input: CodeInvalidArgument, want: codes.InvalidArgument},
+		"CodeUnAuthn":            {input: CodeUnAuthn, want: codes.Unauthenticated},
+		"CodeUnAuthz":            {input: CodeUnAuthz, want: codes.PermissionDenied},
+		"CodeNotFound":           {input: CodeNotFound, want: codes.NotFound},
+		"CodeInternal":           {input: CodeInternal, want: codes.Internal},
+		"CodeAlreadyExists":      {input: CodeAlreadyExists, want: codes.AlreadyExists},
+		"CodeConflict":           {input: CodeConflict, want: codes.Aborted},
+		"CodeFailedPrecondition": {input: CodeFailedPrecondition, want: codes.FailedPrecondition},
+		"CodeResourceExhausted":  {input: CodeResourceExhausted, want: codes.ResourceExhausted},
+		"CodeUnavailable":        {input: CodeUnavailable, want: codes.Unavailable},
+		"CodeDeadlineExceeded":   {input: CodeDeadlineExceeded, want: codes.DeadlineExceeded},
+		"CodeCanceled":           {input: CodeCanceled, want: codes.Canceled},
+		"CodeUnimplemented":      {input: CodeUnimplemented, want: codes.Unimplemented},
+		"CodeCustom":             {input: Code(uint32(100)), want: codes.Unknown},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tc.input.GRPCCode()
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
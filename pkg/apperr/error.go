@@ -5,28 +5,56 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
+
+	"google.golang.org/grpc/status"
 )
 
 // Error is represents application error.
 type Error struct {
 	text, msg  string
+	msgID      MessageID
+	msgArgs    []any
+	fieldErrs  []FieldError
+	attrs      []slog.Attr
+	retryAfter time.Duration
 	code       Code
+	codeGiven  bool
 	cause      error
 	logLevel   *slog.Level
 	stacktrace stacktrace
+	// inherited is whether stacktrace is inherited from cause.
+	inherited bool
+	noStack   bool
 }
 
 // New creates new error instance with given info.
+//
+// If [Code] is not given, code is inferred from cause given by [WithCause],
+// such as [CodeCanceled] for [context.Canceled] and [CodeAlreadyExists] for duplicate entry of MySQL.
+//
+// Stack trace is captured by [StackPolicy] given to [SetStackPolicy] unless [WithoutStackTrace] is given.
+// If cause is [Error], its stack trace is inherited instead, so that it tells where the error originally happened.
 func New(text, msg string, opts ...Option) error {
 	e := Error{
-		text:       text,
-		msg:        msg,
-		code:       CodeInternal,
-		stacktrace: caller(5, 2),
+		text: text,
+		msg:  msg,
+		code: CodeInternal,
 	}
 	for _, opt := range opts {
 		opt.do(&e)
 	}
+	if !e.codeGiven && e.cause != nil {
+		if code, ok := classify(e.cause); ok {
+			e.code = code
+		}
+	}
+	if st, ok := stackOf(e.cause); ok {
+		e.stacktrace = st
+		e.inherited = true
+	} else if p := currentStackPolicy(); !e.noStack && p.Depth > 0 && e.Level() >= p.MinLevel {
+		e.stacktrace = caller(p.Depth, 2)
+	}
 	return &e
 }
 
@@ -56,7 +84,7 @@ func (e *Error) Level() slog.Level {
 
 // Code is error's code.
 //
-// Default code is [CodeInternal].
+// Default code is [CodeInternal] unless it is inferred from cause.
 func (e *Error) Code() Code {
 	return e.code
 }
@@ -65,7 +93,27 @@ func (e *Error) Code() Code {
 //
 // Default http status is 500.
 func (e *Error) HTTPStatus() int {
-	return e.code.status()
+	return e.code.HTTPStatus()
+}
+
+// Retryable reports whether client may succeed by retrying the same request later.
+//
+// Errors of [CodeConflict], [CodeResourceExhausted], [CodeUnavailable] and [CodeDeadlineExceeded] are retryable,
+// and so are errors given [WithRetryAfter].
+func (e *Error) Retryable() bool {
+	return e.retryAfter > 0 || e.code.retryable()
+}
+
+// RetryAfter is duration which client should wait before retrying, given by [WithRetryAfter].
+// Zero means that the duration is not known.
+func (e *Error) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+// GRPCStatus is gRPC status of e with client message.
+// This implements interface used by [status.FromError], so gRPC server responds with code of e.
+func (e *Error) GRPCStatus() *status.Status {
+	return status.New(e.code.GRPCCode(), e.msg)
 }
 
 // ClientMessage is the message for client.
@@ -74,17 +122,38 @@ func (e *Error) ClientMessage() string {
 }
 
 // Format implements [fmt.Formatter.Format].
+//
+// %+v prints each [Error] in cause chain with its stack trace, followed by the first cause which is not [Error].
 func (e *Error) Format(s fmt.State, verb rune) {
 	var str strings.Builder
-	str.WriteString(e.Error())
-	str.WriteString("\n")
-	if verb == 'v' && s.Flag('+') {
-		str.WriteString(e.stacktrace.String())
+	if verb != 'v' || !s.Flag('+') {
+		str.WriteString(e.Error())
+		str.WriteString("\n")
+		_, _ = s.Write([]byte(str.String()))
+		return
+	}
+	var err error = e
+	for err != nil {
+		appErr, ok := err.(*Error)
+		if !ok {
+			str.WriteString(err.Error())
+			str.WriteString("\n")
+			break
+		}
+		str.WriteString(appErr.text)
+		str.WriteString("\n")
+		if !appErr.inherited {
+			str.WriteString(appErr.stacktrace.String())
+		}
+		if err = appErr.cause; err != nil {
+			str.WriteString("caused by: ")
+		}
 	}
 	_, _ = s.Write([]byte(str.String()))
 }
 
-// StackTrace returns stack trace.
+// StackTrace returns stack trace, which is inherited from cause if cause is [Error].
+// Stack trace is empty if it is not captured by [StackPolicy].
 // This method is useful for logging with slog. For example
 //
 //	var appErr *apperr.Error
@@ -96,15 +165,13 @@ func (e *Error) StackTrace() stacktrace {
 	return e.stacktrace
 }
 
-// IsCode checks given error's code is matched given code.
-func IsCode(err error, code Code) bool {
+// stackOf returns stack trace of the first [Error] in err's chain.
+func stackOf(err error) (stacktrace, bool) {
 	var appErr *Error
-	if ok := errors.As(err, &appErr); ok {
-		if appErr.code == code {
-			return true
-		}
+	if errors.As(err, &appErr) && len(appErr.stacktrace) > 0 {
+		return appErr.stacktrace, true
 	}
-	return false
+	return nil, false
 }
 
 var (
@@ -2,17 +2,23 @@ package apperr_test
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
 	"go-playground/pkg/apperr"
 	"log/slog"
+	"math"
 	"net/http"
 	"regexp"
 	"testing"
+	"time"
 
+	"github.com/go-sql-driver/mysql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestNew(t *testing.T) {
@@ -123,6 +129,34 @@ func TestErrorCode(t *testing.T) {
 			input: input{text: "text", msg: "msg", opts: []apperr.Option{apperr.CodeInvalidArgument}},
 			want:  apperr.CodeInvalidArgument,
 		},
+		"inferred from canceled context": {
+			input: input{text: "text", msg: "msg", opts: []apperr.Option{apperr.WithCause(fmt.Errorf("query: %w", context.Canceled))}},
+			want:  apperr.CodeCanceled,
+		},
+		"inferred from exceeded deadline": {
+			input: input{text: "text", msg: "msg", opts: []apperr.Option{apperr.WithCause(context.DeadlineExceeded)}},
+			want:  apperr.CodeDeadlineExceeded,
+		},
+		"inferred from duplicate entry": {
+			input: input{text: "text", msg: "msg", opts: []apperr.Option{apperr.WithCause(&mysql.MySQLError{Number: 1062})}},
+			want:  apperr.CodeAlreadyExists,
+		},
+		"inferred from deadlock": {
+			input: input{text: "text", msg: "msg", opts: []apperr.Option{apperr.WithCause(&mysql.MySQLError{Number: 1213})}},
+			want:  apperr.CodeConflict,
+		},
+		"inferred from foreign key violation": {
+			input: input{text: "text", msg: "msg", opts: []apperr.Option{apperr.WithCause(&mysql.MySQLError{Number: 1452})}},
+			want:  apperr.CodeFailedPrecondition,
+		},
+		"not inferred from unknown cause": {
+			input: input{text: "text", msg: "msg", opts: []apperr.Option{apperr.WithCause(&mysql.MySQLError{Number: 1064})}},
+			want:  apperr.CodeInternal,
+		},
+		"given code takes priority over cause": {
+			input: input{text: "text", msg: "msg", opts: []apperr.Option{apperr.CodeInvalidArgument, apperr.WithCause(&mysql.MySQLError{Number: 1062})}},
+			want:  apperr.CodeInvalidArgument,
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -179,6 +213,7 @@ func TestErrorFormat(t *testing.T) {
 	type input struct {
 		format    string
 		text, msg string
+		opts      []apperr.Option
 	}
 	tests := map[string]struct {
 		input input
@@ -192,10 +227,16 @@ func TestErrorFormat(t *testing.T) {
 			input: input{format: "%v", text: "text", msg: "msg"},
 			want:  `^text\n$`,
 		},
+		"format cause chain with %+v": {
+			input: input{format: "%+v", text: "text", msg: "msg", opts: []apperr.Option{
+				apperr.WithCause(apperr.New("cause", "msg", apperr.WithCause(sql.ErrNoRows))),
+			}},
+			want: `^text\ncaused by: cause\ngo-playground/pkg/apperr_test.TestErrorFormat\(.+/pkg/apperr/error_test.go:\d+\)\n(.+\n)*caused by: sql: no rows in result set\n$`,
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			err := apperr.New(tc.input.text, tc.input.msg)
+			err := apperr.New(tc.input.text, tc.input.msg, tc.input.opts...)
 
 			got := fmt.Sprintf(tc.input.format, err)
 
@@ -213,40 +254,97 @@ func TestErrorStackTrace(t *testing.T) {
 	logger.Info("test", slog.Any("stacktrace", appErr.StackTrace()))
 
 	type record struct {
-		StackTrace string `json:"stacktrace"`
+		StackTrace []apperr.Frame `json:"stacktrace"`
 	}
 	var got record
 	err := json.NewDecoder(buf).Decode(&got)
 	require.NoError(t, err)
-	assert.Regexp(t, regexp.MustCompile(`^go-playground/pkg/apperr_test.TestErrorStackTrace\(.+/pkg/apperr/error_test.go:\d+\)`), got.StackTrace)
+	require.NotEmpty(t, got.StackTrace)
+	assert.Equal(t, "go-playground/pkg/apperr_test.TestErrorStackTrace", got.StackTrace[0].Function)
+	assert.Regexp(t, regexp.MustCompile(`/pkg/apperr/error_test.go$`), got.StackTrace[0].File)
+	assert.Positive(t, got.StackTrace[0].Line)
 }
 
-func TestIsCode(t *testing.T) {
-	type input struct {
-		err  error
-		code apperr.Code
+func TestErrorStackTraceInherited(t *testing.T) {
+	cause := apperr.MustAppErr(t, apperr.New("cause", "msg"))
+
+	got := apperr.MustAppErr(t, apperr.New("text", "msg", apperr.WithCause(fmt.Errorf("wrapped: %w", cause))))
+
+	assert.Equal(t, cause.StackTrace().Frames(), got.StackTrace().Frames())
+}
+
+func TestErrorRetryable(t *testing.T) {
+	tests := map[string]struct {
+		input          error
+		wantRetryable  bool
+		wantRetryAfter time.Duration
+	}{
+		"not retryable": {
+			input: apperr.New("find task", "Task not found", apperr.CodeNotFound),
+		},
+		"retryable code": {
+			input:         apperr.New("update task", "Task is being updated", apperr.CodeConflict),
+			wantRetryable: true,
+		},
+		"retry after": {
+			input:          apperr.New("call api", "Too many requests", apperr.WithRetryAfter(30*time.Second)),
+			wantRetryable:  true,
+			wantRetryAfter: 30 * time.Second,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := apperr.MustAppErr(t, tc.input)
+
+			assert.Equal(t, tc.wantRetryable, got.Retryable())
+			assert.Equal(t, tc.wantRetryAfter, got.RetryAfter())
+		})
 	}
+}
+
+func TestErrorAttributes(t *testing.T) {
+	at := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
 	tests := map[string]struct {
-		input input
-		want  bool
+		input []slog.Attr
+		want  map[string]any
 	}{
-		"err is *apperr.Error(without options)": {
-			input: input{err: apperr.New("text", "msg"), code: apperr.CodeInternal},
-			want:  true,
+		"no attributes": {},
+		"typed values": {
+			input: []slog.Attr{
+				slog.String("taskID", "0192ae1b"),
+				slog.Int("count", 3),
+				slog.Uint64("size", 10),
+				slog.Float64("ratio", 0.5),
+				slog.Bool("archived", true),
+			},
+			want: map[string]any{"taskID": "0192ae1b", "count": int64(3), "size": uint64(10), "ratio": 0.5, "archived": true},
 		},
-		"err is *apperr.Error(with options)": {
-			input: input{err: apperr.New("text", "msg", apperr.CodeInvalidArgument), code: apperr.CodeInvalidArgument},
-			want:  true,
+		"values not accepted by New Relic": {
+			input: []slog.Attr{slog.Time("at", at), slog.Duration("elapsed", time.Second), slog.Float64("nan", math.NaN())},
+			want:  map[string]any{"at": "2026-10-19 09:00:00 +0000 UTC", "elapsed": "1s", "nan": "NaN"},
 		},
-		"err is not *apperr.Error": {
-			input: input{err: sql.ErrNoRows, code: apperr.CodeInternal},
+		"group": {
+			input: []slog.Attr{slog.Group("task", slog.String("id", "0192ae1b"), slog.Group("owner", slog.String("id", "0192ae1c")))},
+			want:  map[string]any{"task.id": "0192ae1b", "task.owner.id": "0192ae1c"},
 		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			got := apperr.IsCode(tc.input.err, tc.input.code)
+			got := apperr.MustAppErr(t, apperr.New("text", "msg", apperr.WithAttrs(tc.input...)))
 
-			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.input, got.Attrs())
+			assert.Equal(t, tc.want, got.ErrorAttributes())
 		})
 	}
 }
+
+func TestErrorGRPCStatus(t *testing.T) {
+	err := apperr.New("find task", "Task not found", apperr.CodeNotFound)
+
+	st, ok := status.FromError(err)
+
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Equal(t, "Task not found", st.Message())
+	assert.Equal(t, codes.NotFound, status.Code(fmt.Errorf("wrapped: %w", err)))
+}
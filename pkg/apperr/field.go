@@ -0,0 +1,16 @@
+package apperr
+
+// FieldError is validation failure of a field of request.
+type FieldError struct {
+	// Field is path to the field such as "email" or "scopes.0".
+	Field string
+	// Rule is name of violated rule such as "required".
+	Rule string
+	// Message describes the violation for client.
+	Message string
+}
+
+// FieldErrors returns validation failures of fields given by [WithFieldErrors].
+func (e *Error) FieldErrors() []FieldError {
+	return e.fieldErrs
+}
@@ -0,0 +1,69 @@
+package apperr
+
+import "errors"
+
+// IsCode checks whether the outermost [Error] on any branch of err's tree has given code.
+//
+// The tree is walked through both wrapped errors and errors joined by [errors.Join],
+// but not below an [Error], since code of [Error] deliberately overrides code of its cause.
+func IsCode(err error, code Code) bool {
+	found := false
+	walkUntil(err, func(err error) bool {
+		appErr, ok := err.(*Error)
+		if ok && appErr.code == code {
+			found = true
+		}
+		return !ok
+	})
+	return found
+}
+
+// CodeOf returns code of the first [Error] found in err's tree in the same order as [errors.As].
+//
+// CodeOf returns [CodeUnknown] if err is nil, and [CodeInternal] if err has no [Error].
+func CodeOf(err error) Code {
+	if err == nil {
+		return CodeUnknown
+	}
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr.code
+	}
+	return CodeInternal
+}
+
+// AsAll returns all [Error] in err's tree in pre-order, depth-first traversal same as [errors.As].
+//
+// Unlike [errors.As], AsAll continues walking after [Error] is found,
+// so both an [Error] and its cause are returned if the cause is also [Error].
+func AsAll(err error) []*Error {
+	var errs []*Error
+	walk(err, func(err error) {
+		if appErr, ok := err.(*Error); ok {
+			errs = append(errs, appErr)
+		}
+	})
+	return errs
+}
+
+func walk(err error, fn func(error)) {
+	walkUntil(err, func(err error) bool {
+		fn(err)
+		return true
+	})
+}
+
+// walkUntil walks err's tree in pre-order, and does not descend below errors which fn returns false for.
+func walkUntil(err error, fn func(error) bool) {
+	if err == nil || !fn(err) {
+		return
+	}
+	switch x := err.(type) {
+	case interface{ Unwrap() error }:
+		walkUntil(x.Unwrap(), fn)
+	case interface{ Unwrap() []error }:
+		for _, err := range x.Unwrap() {
+			walkUntil(err, fn)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package apperr_test
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"go-playground/pkg/apperr"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsCode(t *testing.T) {
+	type input struct {
+		err  error
+		code apperr.Code
+	}
+	tests := map[string]struct {
+		input input
+		want  bool
+	}{
+		"err is *apperr.Error(without options)": {
+			input: input{err: apperr.New("text", "msg"), code: apperr.CodeInternal},
+			want:  true,
+		},
+		"err is *apperr.Error(with options)": {
+			input: input{err: apperr.New("text", "msg", apperr.CodeInvalidArgument), code: apperr.CodeInvalidArgument},
+			want:  true,
+		},
+		"err is not *apperr.Error": {
+			input: input{err: sql.ErrNoRows, code: apperr.CodeInternal},
+		},
+		"code of cause is overridden": {
+			input: input{err: apperr.New("text", "msg", apperr.WithCause(apperr.New("text", "msg", apperr.CodeNotFound)), apperr.CodeUnAuthz), code: apperr.CodeNotFound},
+		},
+		"wrapped err is *apperr.Error": {
+			input: input{err: fmt.Errorf("wrapped: %w", apperr.New("text", "msg", apperr.CodeNotFound)), code: apperr.CodeNotFound},
+			want:  true,
+		},
+		"joined err is *apperr.Error": {
+			input: input{err: errors.Join(sql.ErrNoRows, apperr.New("text", "msg", apperr.CodeUnavailable)), code: apperr.CodeUnavailable},
+			want:  true,
+		},
+		"code of cause of joined err is overridden": {
+			input: input{err: errors.Join(sql.ErrNoRows, apperr.New("text", "msg", apperr.WithCause(apperr.New("text", "msg", apperr.CodeNotFound)), apperr.CodeUnAuthz)), code: apperr.CodeNotFound},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := apperr.IsCode(tc.input.err, tc.input.code)
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCodeOf(t *testing.T) {
+	tests := map[string]struct {
+		input error
+		want  apperr.Code
+	}{
+		"nil":                      {want: apperr.CodeUnknown},
+		"err is *apperr.Error":     {input: apperr.New("text", "msg", apperr.CodeNotFound), want: apperr.CodeNotFound},
+		"err is not *apperr.Error": {input: sql.ErrNoRows, want: apperr.CodeInternal},
+		"wrapped":                  {input: fmt.Errorf("wrapped: %w", apperr.New("text", "msg", apperr.CodeUnAuthz)), want: apperr.CodeUnAuthz},
+		"joined":                   {input: errors.Join(sql.ErrNoRows, apperr.New("text", "msg", apperr.CodeConflict), apperr.New("text", "msg", apperr.CodeNotFound)), want: apperr.CodeConflict},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := apperr.CodeOf(tc.input)
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestAsAll(t *testing.T) {
+	notFound := apperr.New("find task", "Task not found", apperr.CodeNotFound)
+	internal := apperr.New("archive task", "Internal error", apperr.WithCause(notFound))
+	conflict := apperr.New("update task", "Task is being updated", apperr.CodeConflict)
+	tests := map[string]struct {
+		input error
+		want  []error
+	}{
+		"nil":                      {},
+		"err is not *apperr.Error": {input: sql.ErrNoRows},
+		"cause is *apperr.Error":   {input: internal, want: []error{internal, notFound}},
+		"joined": {
+			input: fmt.Errorf("batch: %w", errors.Join(internal, sql.ErrNoRows, conflict)),
+			want:  []error{internal, notFound, conflict},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := apperr.AsAll(tc.input)
+
+			assert.Len(t, got, len(tc.want))
+			for i, want := range tc.want {
+				assert.Same(t, want, got[i])
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package apperr
+
+import (
+	"fmt"
+
+	"golang.org/x/text/language"
+)
+
+// MessageID identifies client message in [Catalog].
+type MessageID string
+
+// MessageID is identifier of client message. It is empty if message is not identified by [WithMessageID].
+func (e *Error) MessageID() MessageID {
+	return e.msgID
+}
+
+// Translation is client messages in a language.
+// Messages are format strings of [fmt.Sprintf], which take args given to [WithMessageID].
+type Translation struct {
+	Language language.Tag
+	Messages map[MessageID]string
+}
+
+// Catalog is translations of client messages keyed by [MessageID].
+type Catalog struct {
+	matcher      language.Matcher
+	translations []Translation
+}
+
+// NewCatalog creates Catalog of given translations.
+// The first translation is used when no preferred language is supported.
+func NewCatalog(translations ...Translation) *Catalog {
+	tags := make([]language.Tag, len(translations))
+	for i, t := range translations {
+		tags[i] = t.Language
+	}
+	return &Catalog{matcher: language.NewMatcher(tags), translations: translations}
+}
+
+// Language returns supported language best matching given preferences in order of priority.
+// Each preference is value of Accept-Language header or BCP 47 language tag. Invalid preferences are ignored.
+func (c *Catalog) Language(preferences ...string) language.Tag {
+	return c.translations[c.match(preferences)].Language
+}
+
+// Translate formats message of given id in given language with args.
+// ok is false if message is not translated into the language.
+func (c *Catalog) Translate(lang language.Tag, id MessageID, args ...any) (msg string, ok bool) {
+	format, ok := c.translations[c.match([]string{lang.String()})].Messages[id]
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf(format, args...), true
+}
+
+// ClientMessage returns client message of e in given language.
+// Message given to [New] is returned if message is not identified or not translated.
+func (c *Catalog) ClientMessage(lang language.Tag, e *Error) string {
+	if e.msgID == "" {
+		return e.msg
+	}
+	msg, ok := c.Translate(lang, e.msgID, e.msgArgs...)
+	if !ok {
+		return e.msg
+	}
+	return msg
+}
+
+// match returns index of translation best matching given preferences.
+func (c *Catalog) match(preferences []string) int {
+	var tags []language.Tag
+	for _, p := range preferences {
+		parsed, _, err := language.ParseAcceptLanguage(p)
+		if err != nil {
+			continue
+		}
+		tags = append(tags, parsed...)
+	}
+	_, i, _ := c.matcher.Match(tags...)
+	return i
+}
@@ -0,0 +1,84 @@
+package apperr_test
+
+import (
+	"go-playground/pkg/apperr"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestCatalog_Language(t *testing.T) {
+	catalog := apperr.NewCatalog(
+		apperr.Translation{Language: language.English},
+		apperr.Translation{Language: language.Japanese},
+	)
+	tests := map[string]struct {
+		preferences []string
+		want        language.Tag
+	}{
+		"no preference":                        {want: language.English},
+		"accept language":                      {preferences: []string{"ja,en;q=0.8"}, want: language.Japanese},
+		"accept language by quality":           {preferences: []string{"en;q=0.5,ja-JP;q=0.9"}, want: language.Japanese},
+		"regional language":                    {preferences: []string{"en-GB"}, want: language.English},
+		"unsupported language falls through":   {preferences: []string{"fr", "ja-JP"}, want: language.Japanese},
+		"invalid preference is ignored":        {preferences: []string{"!!", "ja"}, want: language.Japanese},
+		"first translation is used by default": {preferences: []string{"fr"}, want: language.English},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, catalog.Language(tc.preferences...))
+		})
+	}
+}
+
+func TestCatalog_ClientMessage(t *testing.T) {
+	catalog := apperr.NewCatalog(
+		apperr.Translation{Language: language.English, Messages: map[apperr.MessageID]string{
+			"task.not_found":    "Task not found",
+			"task.content_long": "Task content must be at most %d characters",
+		}},
+		apperr.Translation{Language: language.Japanese, Messages: map[apperr.MessageID]string{
+			"task.not_found":    "タスクが見つかりません",
+			"task.content_long": "タスクの内容は %d 文字以内にしてください",
+		}},
+	)
+	tests := map[string]struct {
+		err  error
+		lang language.Tag
+		want string
+	}{
+		"translated": {
+			err:  apperr.New("find task", "not found task", apperr.WithMessageID("task.not_found")),
+			lang: language.Japanese,
+			want: "タスクが見つかりません",
+		},
+		"translated with args": {
+			err:  apperr.New("validate task", "too long", apperr.WithMessageID("task.content_long", 1000)),
+			lang: language.Japanese,
+			want: "タスクの内容は 1000 文字以内にしてください",
+		},
+		"translated into english": {
+			err:  apperr.New("find task", "not found task", apperr.WithMessageID("task.not_found")),
+			lang: language.English,
+			want: "Task not found",
+		},
+		"message without id": {
+			err:  apperr.New("find task", "not found task"),
+			lang: language.Japanese,
+			want: "not found task",
+		},
+		"message not in catalog": {
+			err:  apperr.New("find user", "not found user", apperr.WithMessageID("user.not_found")),
+			lang: language.Japanese,
+			want: "not found user",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := catalog.ClientMessage(tc.lang, apperr.MustAppErr(t, tc.err))
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
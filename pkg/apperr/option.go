@@ -1,6 +1,9 @@
 package apperr
 
-import "log/slog"
+import (
+	"log/slog"
+	"time"
+)
 
 // Option applies optional info to [Error].
 type Option interface {
@@ -26,3 +29,42 @@ func WithCause(cause error) Option {
 		e.cause = cause
 	})
 }
+
+// WithMessageID identifies client message so that it can be translated by [Catalog].
+// args are formatted into translated message. msg given to [New] is used if message is not translated.
+func WithMessageID(id MessageID, args ...any) Option {
+	return optionFunc(func(e *Error) {
+		e.msgID = id
+		e.msgArgs = args
+	})
+}
+
+// WithFieldErrors attaches validation failures of fields, so that client can tell which fields are invalid.
+func WithFieldErrors(errs ...FieldError) Option {
+	return optionFunc(func(e *Error) {
+		e.fieldErrs = append(e.fieldErrs, errs...)
+	})
+}
+
+// WithAttrs attaches typed key/value attributes which describe context of error, such as id of resource.
+// Attributes are logged as [slog.Attr] and reported to New Relic as attributes of error.
+func WithAttrs(attrs ...slog.Attr) Option {
+	return optionFunc(func(e *Error) {
+		e.attrs = append(e.attrs, attrs...)
+	})
+}
+
+// WithRetryAfter marks error as retryable after given duration regardless of [Code].
+func WithRetryAfter(d time.Duration) Option {
+	return optionFunc(func(e *Error) {
+		e.retryAfter = d
+	})
+}
+
+// WithoutStackTrace skips capturing stack trace regardless of [StackPolicy].
+// This is useful for errors which happen in hot paths and whose stack trace is not worth its cost.
+func WithoutStackTrace() Option {
+	return optionFunc(func(e *Error) {
+		e.noStack = true
+	})
+}
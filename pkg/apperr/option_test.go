@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"log/slog"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -21,3 +22,47 @@ func TestWithCause(t *testing.T) {
 
 	assert.Equal(t, sql.ErrNoRows, appErr.cause)
 }
+
+func TestWithMessageID(t *testing.T) {
+	err := New("text", "msg", WithMessageID("task.not_found", 1))
+	appErr := MustAppErr(t, err)
+
+	assert.Equal(t, MessageID("task.not_found"), appErr.MessageID())
+	assert.Equal(t, []any{1}, appErr.msgArgs)
+}
+
+func TestWithFieldErrors(t *testing.T) {
+	err := New(
+		"text", "msg",
+		WithFieldErrors(FieldError{Field: "email", Rule: "required", Message: "cannot be blank"}),
+		WithFieldErrors(FieldError{Field: "scopes.0", Rule: "in_invalid", Message: "must be a valid value"}),
+	)
+	appErr := MustAppErr(t, err)
+
+	assert.Equal(t, []FieldError{
+		{Field: "email", Rule: "required", Message: "cannot be blank"},
+		{Field: "scopes.0", Rule: "in_invalid", Message: "must be a valid value"},
+	}, appErr.FieldErrors())
+}
+
+func TestWithAttrs(t *testing.T) {
+	err := New("text", "msg", WithAttrs(slog.String("taskID", "0192ae1b")), WithAttrs(slog.Int("count", 3)))
+	appErr := MustAppErr(t, err)
+
+	assert.Equal(t, []slog.Attr{slog.String("taskID", "0192ae1b"), slog.Int("count", 3)}, appErr.attrs)
+}
+
+func TestWithRetryAfter(t *testing.T) {
+	err := New("text", "msg", WithRetryAfter(time.Minute))
+	appErr := MustAppErr(t, err)
+
+	assert.Equal(t, time.Minute, appErr.retryAfter)
+}
+
+func TestWithoutStackTrace(t *testing.T) {
+	err := New("text", "msg", WithoutStackTrace())
+	appErr := MustAppErr(t, err)
+
+	assert.True(t, appErr.noStack)
+	assert.Empty(t, appErr.stacktrace)
+}
@@ -6,10 +6,45 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"sync/atomic"
 
 	"github.com/tecchu11/nrgo-std/nrslog"
 )
 
+// StackPolicy is policy of capturing stack trace by [New].
+type StackPolicy struct {
+	// Depth is max number of captured frames. Stack trace is not captured if Depth is zero.
+	Depth int
+	// MinLevel is min [Error.Level] of error whose stack trace is captured.
+	// For example, [slog.LevelError] captures stack trace only for unexpected errors.
+	MinLevel slog.Level
+}
+
+// DefaultStackPolicy captures 5 frames for errors of all levels.
+var DefaultStackPolicy = StackPolicy{Depth: 5, MinLevel: slog.LevelDebug}
+
+var stackPolicy atomic.Pointer[StackPolicy]
+
+// SetStackPolicy replaces policy of capturing stack trace, which is [DefaultStackPolicy] by default.
+// This is expected to be called on startup, and is safe to call concurrently with [New].
+func SetStackPolicy(p StackPolicy) {
+	stackPolicy.Store(&p)
+}
+
+func currentStackPolicy() StackPolicy {
+	if p := stackPolicy.Load(); p != nil {
+		return *p
+	}
+	return DefaultStackPolicy
+}
+
+// Frame is a frame of stack trace.
+type Frame struct {
+	Function string `json:"function"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+}
+
 type stacktrace []uintptr
 
 func caller(size, skip int) stacktrace {
@@ -18,28 +53,41 @@ func caller(size, skip int) stacktrace {
 	return pc[:n:n]
 }
 
+// Frames returns frames from the innermost caller.
+func (s stacktrace) Frames() []Frame {
+	if len(s) == 0 {
+		return nil
+	}
+	var frames []Frame
+	iter := runtime.CallersFrames(s)
+	for {
+		f, more := iter.Next()
+		frames = append(frames, Frame{Function: f.Function, File: f.File, Line: f.Line})
+		if !more {
+			break
+		}
+	}
+	return frames
+}
+
 // String implements [fmt.Stringer].
 func (s stacktrace) String() string {
 	var str strings.Builder
-	frames := runtime.CallersFrames(s)
-	for {
-		f, more := frames.Next()
+	for _, f := range s.Frames() {
 		str.WriteString(f.Function)
 		str.WriteString("(")
 		str.WriteString(f.File)
 		str.WriteString(":")
 		str.WriteString(strconv.Itoa(f.Line))
 		str.WriteString(")\n")
-		if !more {
-			break
-		}
 	}
 	return str.String()
 }
 
 // LogValue implements [slog.LogValuer].
+// Stack trace is logged as list of frames, such as [{"function":"main.main","file":"/app/main.go","line":10}] by [slog.JSONHandler].
 func (s stacktrace) LogValue() slog.Value {
-	return slog.StringValue(s.String())
+	return slog.AnyValue(s.Frames())
 }
 
 // NRAttributes implements [nrslog.Attributer].
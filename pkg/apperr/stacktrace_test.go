@@ -1,6 +1,7 @@
 package apperr
 
 import (
+	"log/slog"
 	"regexp"
 	"testing"
 
@@ -15,3 +16,73 @@ func TestStackTraceNRAttribute(t *testing.T) {
 
 	assert.Regexp(t, regexp.MustCompile(`^go-playground/pkg/apperr.TestStackTraceNRAttribute\(.+/pkg/apperr/stacktrace_test.go:\d+\)\n$`), got)
 }
+
+func TestStackTraceFrames(t *testing.T) {
+	st := caller(1, 1)
+
+	got := st.Frames()
+
+	assert.Len(t, got, 1)
+	assert.Equal(t, "go-playground/pkg/apperr.TestStackTraceFrames", got[0].Function)
+	assert.Regexp(t, regexp.MustCompile(`/pkg/apperr/stacktrace_test.go$`), got[0].File)
+	assert.Positive(t, got[0].Line)
+	assert.Empty(t, stacktrace(nil).Frames())
+	assert.Empty(t, stacktrace(nil).String())
+}
+
+func TestSetStackPolicy(t *testing.T) {
+	tests := map[string]struct {
+		policy    StackPolicy
+		opts      []Option
+		wantDepth int
+	}{
+		"default": {
+			policy:    DefaultStackPolicy,
+			wantDepth: 1,
+		},
+		"disabled": {
+			policy: StackPolicy{Depth: 0},
+		},
+		"without stack trace": {
+			policy: DefaultStackPolicy,
+			opts:   []Option{WithoutStackTrace()},
+		},
+		"level is lower than min level": {
+			policy: StackPolicy{Depth: 5, MinLevel: slog.LevelError},
+			opts:   []Option{CodeNotFound},
+		},
+		"level is min level": {
+			policy:    StackPolicy{Depth: 5, MinLevel: slog.LevelError},
+			opts:      []Option{CodeInternal},
+			wantDepth: 1,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			SetStackPolicy(tc.policy)
+			t.Cleanup(func() { SetStackPolicy(DefaultStackPolicy) })
+
+			appErr := MustAppErr(t, New("text", "msg", tc.opts...))
+
+			// Frames from the test function are counted since callers of the test function depend on runtime.
+			frames := appErr.StackTrace().Frames()
+			got := 0
+			for _, f := range frames {
+				if f.Function == "go-playground/pkg/apperr.TestSetStackPolicy.func1" {
+					got++
+				}
+			}
+			assert.Equal(t, tc.wantDepth, got)
+			assert.LessOrEqual(t, len(frames), tc.policy.Depth)
+		})
+	}
+}
+
+func TestSetStackPolicyDepth(t *testing.T) {
+	SetStackPolicy(StackPolicy{Depth: 1})
+	t.Cleanup(func() { SetStackPolicy(DefaultStackPolicy) })
+
+	appErr := MustAppErr(t, New("text", "msg"))
+
+	assert.Len(t, appErr.StackTrace(), 1)
+}
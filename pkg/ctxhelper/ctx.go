@@ -2,17 +2,56 @@ package ctxhelper
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	jwtmiddleware "github.com/auth0/go-jwt-middleware/v3"
 	"github.com/auth0/go-jwt-middleware/v3/core"
 	"github.com/auth0/go-jwt-middleware/v3/validator"
 )
 
+// Claims is custom claims of access token describing user profile and granted scopes.
+// Each claim is empty if access token does not carry it.
+type Claims struct {
+	GivenName     string `json:"given_name"`
+	FamilyName    string `json:"family_name"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	// Scope is space separated scopes defined in RFC 8693.
+	Scope string `json:"scope"`
+	// Permissions is granted permissions by RBAC of identity provider such as Auth0.
+	Permissions []string `json:"permissions"`
+	// OrgID is id of organization which access token is issued for.
+	OrgID string `json:"org_id"`
+}
+
+// Validate implements [validator.CustomClaims]. Custom claims are optional, so nothing is validated.
+func (*Claims) Validate(context.Context) error {
+	return nil
+}
+
 // WithSubject attaches user auth subject into [context.Context].
 func WithSubject(ctx context.Context, sub string) context.Context {
 	return core.SetClaims(ctx, &validator.ValidatedClaims{RegisteredClaims: validator.RegisteredClaims{Subject: sub}})
 }
 
+// WithClaims attaches user auth subject, custom claims and issued time into [context.Context].
+func WithClaims(ctx context.Context, sub string, claims Claims, issuedAt time.Time) context.Context {
+	return core.SetClaims(ctx, &validator.ValidatedClaims{
+		RegisteredClaims: validator.RegisteredClaims{Subject: sub, IssuedAt: issuedAt.Unix()},
+		CustomClaims:     &claims,
+	})
+}
+
+// WithScopes attaches user auth subject and granted scopes into [context.Context].
+// This is for credentials other than JWT, such as personal access token, which carry no profile claims.
+func WithScopes(ctx context.Context, sub string, scopes []string) context.Context {
+	return core.SetClaims(ctx, &validator.ValidatedClaims{
+		RegisteredClaims: validator.RegisteredClaims{Subject: sub},
+		CustomClaims:     &Claims{Scope: strings.Join(scopes, " ")},
+	})
+}
+
 // Subject retrieves user auth subject from [context.Context].
 func Subject(ctx context.Context) (sub string, ok bool) {
 	claim, err := jwtmiddleware.GetClaims[*validator.ValidatedClaims](ctx)
@@ -21,3 +60,103 @@ func Subject(ctx context.Context) (sub string, ok bool) {
 	}
 	return claim.RegisteredClaims.Subject, true
 }
+
+// CustomClaims retrieves custom claims from [context.Context].
+// ok is false if access token is validated without [Claims].
+func CustomClaims(ctx context.Context) (claims Claims, ok bool) {
+	claim, err := jwtmiddleware.GetClaims[*validator.ValidatedClaims](ctx)
+	if err != nil {
+		return
+	}
+	c, ok := claim.CustomClaims.(*Claims)
+	if !ok || c == nil {
+		return Claims{}, false
+	}
+	return *c, true
+}
+
+// Scopes retrieves scopes granted to access token from [context.Context].
+// Both scope and permissions claims are treated as scopes.
+func Scopes(ctx context.Context) []string {
+	claims, ok := CustomClaims(ctx)
+	if !ok {
+		return nil
+	}
+	return append(strings.Fields(claims.Scope), claims.Permissions...)
+}
+
+// IssuedAt retrieves issued time of access token from [context.Context].
+// ok is false if access token does not have iat claim.
+func IssuedAt(ctx context.Context) (iat time.Time, ok bool) {
+	claim, err := jwtmiddleware.GetClaims[*validator.ValidatedClaims](ctx)
+	if err != nil || claim.RegisteredClaims.IssuedAt == 0 {
+		return
+	}
+	return time.Unix(claim.RegisteredClaims.IssuedAt, 0), true
+}
+
+type organizationIDKey struct{}
+
+// WithOrganizationID attaches id of active organization into [context.Context].
+// Tenant data is read and written within active organization.
+func WithOrganizationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, organizationIDKey{}, id)
+}
+
+// OrganizationID retrieves id of active organization from [context.Context].
+// ok is false if no organization is active.
+func OrganizationID(ctx context.Context) (id string, ok bool) {
+	id, ok = ctx.Value(organizationIDKey{}).(string)
+	return id, ok && id != ""
+}
+
+// UserPreferences is preferences of user making request, which are used to render and compute dates and lists.
+type UserPreferences struct {
+	Location *time.Location
+	// Locale is BCP 47 language tag.
+	Locale    string
+	PageSize  int32
+	WeekStart time.Weekday
+}
+
+type preferencesKey struct{}
+
+// WithPreferences attaches preferences of user making request into [context.Context].
+func WithPreferences(ctx context.Context, p UserPreferences) context.Context {
+	return context.WithValue(ctx, preferencesKey{}, p)
+}
+
+// Preferences retrieves preferences of user making request from [context.Context].
+// ok is false if request does not carry preferences, such as request by worker.
+func Preferences(ctx context.Context) (p UserPreferences, ok bool) {
+	p, ok = ctx.Value(preferencesKey{}).(UserPreferences)
+	return p, ok
+}
+
+type requestIDKey struct{}
+
+// WithRequestID attaches id of request into [context.Context].
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID retrieves id of request from [context.Context].
+// ok is false if request is not identified.
+func RequestID(ctx context.Context) (id string, ok bool) {
+	id, ok = ctx.Value(requestIDKey{}).(string)
+	return id, ok && id != ""
+}
+
+type traceParentKey struct{}
+
+// WithTraceParent attaches W3C traceparent header of request into [context.Context].
+func WithTraceParent(ctx context.Context, traceParent string) context.Context {
+	return context.WithValue(ctx, traceParentKey{}, traceParent)
+}
+
+// TraceParent retrieves W3C traceparent header of request from [context.Context].
+// ok is false if request is not traced.
+func TraceParent(ctx context.Context) (traceParent string, ok bool) {
+	traceParent, ok = ctx.Value(traceParentKey{}).(string)
+	return traceParent, ok && traceParent != ""
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"go-playground/pkg/ctxhelper"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -43,3 +44,187 @@ func TestSubject(t *testing.T) {
 		})
 	}
 }
+
+func TestCustomClaims(t *testing.T) {
+	type want struct {
+		claims ctxhelper.Claims
+		ok     bool
+	}
+	tests := map[string]struct {
+		setup func(context.Context) context.Context
+		want  want
+	}{
+		"success: get custom claims from context after authorization": {
+			setup: func(ctx context.Context) context.Context {
+				return ctxhelper.WithClaims(ctx, "sub1", ctxhelper.Claims{GivenName: "Alice", FamilyName: "Kozey", Email: "alice@example.com", EmailVerified: true}, time.Now())
+			},
+			want: want{
+				claims: ctxhelper.Claims{GivenName: "Alice", FamilyName: "Kozey", Email: "alice@example.com", EmailVerified: true},
+				ok:     true,
+			},
+		},
+		"failure: token is validated without custom claims": {
+			setup: func(ctx context.Context) context.Context {
+				return ctxhelper.WithSubject(ctx, "sub1")
+			},
+		},
+		"failure: missing claims from context before authorization": {
+			setup: func(ctx context.Context) context.Context {
+				return ctx
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := tc.setup(t.Context())
+
+			got, ok := ctxhelper.CustomClaims(ctx)
+
+			assert.Equal(t, tc.want.claims, got)
+			assert.Equal(t, tc.want.ok, ok)
+		})
+	}
+}
+
+func TestIssuedAt(t *testing.T) {
+	iat := time.Date(2024, 11, 8, 14, 40, 33, 0, time.UTC)
+	type want struct {
+		iat time.Time
+		ok  bool
+	}
+	tests := map[string]struct {
+		setup func(context.Context) context.Context
+		want  want
+	}{
+		"success: get issued time from context after authorization": {
+			setup: func(ctx context.Context) context.Context {
+				return ctxhelper.WithClaims(ctx, "sub1", ctxhelper.Claims{}, iat)
+			},
+			want: want{iat: iat, ok: true},
+		},
+		"failure: token does not have iat": {
+			setup: func(ctx context.Context) context.Context {
+				return ctxhelper.WithSubject(ctx, "sub1")
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := tc.setup(t.Context())
+
+			got, ok := ctxhelper.IssuedAt(ctx)
+
+			assert.True(t, tc.want.iat.Equal(got))
+			assert.Equal(t, tc.want.ok, ok)
+		})
+	}
+}
+
+func TestScopes(t *testing.T) {
+	tests := map[string]struct {
+		setup func(context.Context) context.Context
+		want  []string
+	}{
+		"success: scope and permissions claims": {
+			setup: func(ctx context.Context) context.Context {
+				return ctxhelper.WithClaims(ctx, "sub1", ctxhelper.Claims{Scope: "openid read:tasks", Permissions: []string{"write:tasks"}}, time.Now())
+			},
+			want: []string{"openid", "read:tasks", "write:tasks"},
+		},
+		"success: scopes of credential other than JWT": {
+			setup: func(ctx context.Context) context.Context {
+				return ctxhelper.WithScopes(ctx, "sub1", []string{"read:tasks", "write:tasks"})
+			},
+			want: []string{"read:tasks", "write:tasks"},
+		},
+		"success: no scopes granted": {
+			setup: func(ctx context.Context) context.Context {
+				return ctxhelper.WithClaims(ctx, "sub1", ctxhelper.Claims{}, time.Now())
+			},
+		},
+		"failure: token is validated without custom claims": {
+			setup: func(ctx context.Context) context.Context {
+				return ctxhelper.WithSubject(ctx, "sub1")
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := tc.setup(t.Context())
+
+			got := ctxhelper.Scopes(ctx)
+
+			assert.ElementsMatch(t, tc.want, got)
+		})
+	}
+}
+
+func TestOrganizationID(t *testing.T) {
+	type want struct {
+		id string
+		ok bool
+	}
+	tests := map[string]struct {
+		setup func(context.Context) context.Context
+		want  want
+	}{
+		"success: get active organization from context": {
+			setup: func(ctx context.Context) context.Context {
+				return ctxhelper.WithOrganizationID(ctx, "0194c3a1-6f0e-7c2b-9d1a-0a1b2c3d4e5f")
+			},
+			want: want{id: "0194c3a1-6f0e-7c2b-9d1a-0a1b2c3d4e5f", ok: true},
+		},
+		"failure: no organization is active": {
+			setup: func(ctx context.Context) context.Context { return ctx },
+		},
+		"failure: empty organization is not active": {
+			setup: func(ctx context.Context) context.Context {
+				return ctxhelper.WithOrganizationID(ctx, "")
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := tc.setup(t.Context())
+
+			got, ok := ctxhelper.OrganizationID(ctx)
+
+			assert.Equal(t, tc.want.id, got)
+			assert.Equal(t, tc.want.ok, ok)
+		})
+	}
+}
+
+func TestPreferences(t *testing.T) {
+	want := ctxhelper.UserPreferences{Location: time.UTC, Locale: "en-US", PageSize: 20, WeekStart: time.Sunday}
+	ctx := ctxhelper.WithPreferences(t.Context(), want)
+
+	got, ok := ctxhelper.Preferences(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, want, got)
+
+	_, ok = ctxhelper.Preferences(t.Context())
+	assert.False(t, ok)
+}
+
+func TestRequestID(t *testing.T) {
+	ctx := ctxhelper.WithRequestID(t.Context(), "0d0b5b2e-6b0c-4b8e-9a55-3f0a2f6c7f1d")
+
+	got, ok := ctxhelper.RequestID(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, "0d0b5b2e-6b0c-4b8e-9a55-3f0a2f6c7f1d", got)
+
+	_, ok = ctxhelper.RequestID(ctxhelper.WithRequestID(t.Context(), ""))
+	assert.False(t, ok)
+}
+
+func TestTraceParent(t *testing.T) {
+	ctx := ctxhelper.WithTraceParent(t.Context(), "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	got, ok := ctxhelper.TraceParent(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", got)
+
+	_, ok = ctxhelper.TraceParent(ctxhelper.WithTraceParent(t.Context(), ""))
+	assert.False(t, ok)
+}
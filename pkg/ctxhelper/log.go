@@ -0,0 +1,40 @@
+package ctxhelper
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LogKeyRequestID is key of id of request added to log records by handler of [NewLogHandler].
+const LogKeyRequestID = "requestID"
+
+type logHandler struct {
+	slog.Handler
+}
+
+// NewLogHandler wraps h so that id of request attached by [WithRequestID] is added to every record logged with context,
+// such as by [slog.InfoContext].
+func NewLogHandler(h slog.Handler) slog.Handler {
+	return &logHandler{Handler: h}
+}
+
+// Handle implements [slog.Handler].
+func (h *logHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id, ok := RequestID(ctx); ok {
+		r = r.Clone()
+		r.AddAttrs(slog.String(LogKeyRequestID, id))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+// WithAttrs implements [slog.Handler].
+func (h *logHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &logHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup implements [slog.Handler].
+func (h *logHandler) WithGroup(name string) slog.Handler {
+	return &logHandler{Handler: h.Handler.WithGroup(name)}
+}
+
+var _ slog.Handler = (*logHandler)(nil)
@@ -0,0 +1,62 @@
+package ctxhelper_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"go-playground/pkg/ctxhelper"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLogHandler(t *testing.T) {
+	tests := map[string]struct {
+		ctx  context.Context
+		log  func(ctx context.Context, logger *slog.Logger)
+		want map[string]any
+	}{
+		"identified request": {
+			ctx: ctxhelper.WithRequestID(context.Background(), "0d0b5b2e-6b0c-4b8e-9a55-3f0a2f6c7f1d"),
+			log: func(ctx context.Context, logger *slog.Logger) {
+				logger.InfoContext(ctx, "test", slog.String("taskID", "0192ae1b"))
+			},
+			want: map[string]any{"level": "INFO", "msg": "test", "taskID": "0192ae1b", "requestID": "0d0b5b2e-6b0c-4b8e-9a55-3f0a2f6c7f1d"},
+		},
+		"logger with attrs": {
+			ctx: ctxhelper.WithRequestID(context.Background(), "0d0b5b2e-6b0c-4b8e-9a55-3f0a2f6c7f1d"),
+			log: func(ctx context.Context, logger *slog.Logger) {
+				logger.With(slog.String("worker", "export")).InfoContext(ctx, "test")
+			},
+			want: map[string]any{"level": "INFO", "msg": "test", "worker": "export", "requestID": "0d0b5b2e-6b0c-4b8e-9a55-3f0a2f6c7f1d"},
+		},
+		"not identified request": {
+			ctx: context.Background(),
+			log: func(ctx context.Context, logger *slog.Logger) {
+				logger.InfoContext(ctx, "test")
+			},
+			want: map[string]any{"level": "INFO", "msg": "test"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			buf := bytes.NewBuffer(nil)
+			logger := slog.New(ctxhelper.NewLogHandler(slog.NewJSONHandler(buf, &slog.HandlerOptions{
+				ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+					if a.Key == slog.TimeKey && len(groups) == 0 {
+						return slog.Attr{}
+					}
+					return a
+				},
+			})))
+
+			tc.log(tc.ctx, logger)
+
+			var got map[string]any
+			require.NoError(t, json.NewDecoder(buf).Decode(&got))
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
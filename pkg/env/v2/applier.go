@@ -1,10 +1,12 @@
 package env
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
 	"os"
+	"strconv"
 )
 
 type Applier struct {
@@ -46,6 +48,46 @@ func (a *Applier) URL(key string) *url.URL {
 	return u
 }
 
+// Bool parses env of given key as bool. fallback is returned if env is missing.
+func (a *Applier) Bool(key string, fallback bool) bool {
+	v, ok := a.lookup(key)
+	if !ok {
+		return fallback
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		a.err = errors.Join(a.err, fmt.Errorf("parse env %s: %w", key, err))
+		return fallback
+	}
+	return b
+}
+
+// Float parses env of given key as float64. fallback is returned if env is missing.
+func (a *Applier) Float(key string, fallback float64) float64 {
+	v, ok := a.lookup(key)
+	if !ok {
+		return fallback
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		a.err = errors.Join(a.err, fmt.Errorf("parse env %s: %w", key, err))
+		return fallback
+	}
+	return f
+}
+
+// JSON parses env of given key as JSON into v.
+func (a *Applier) JSON(key string, v any) {
+	s, ok := a.lookup(key)
+	if !ok {
+		a.err = errors.Join(a.err, errMissing(key))
+		return
+	}
+	if err := json.Unmarshal([]byte(s), v); err != nil {
+		a.err = errors.Join(a.err, fmt.Errorf("parse env %s: %w", key, err))
+	}
+}
+
 func (a *Applier) Err() error {
 	return a.err
 }
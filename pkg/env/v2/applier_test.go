@@ -130,3 +130,124 @@ func TestApplier_URL(t *testing.T) {
 		})
 	}
 }
+
+func TestApplier_Bool(t *testing.T) {
+	type want struct {
+		v   bool
+		err string
+	}
+	tests := map[string]struct {
+		setEnv func(t *testing.T)
+		want   want
+	}{
+		"success": {
+			setEnv: func(t *testing.T) { t.Setenv("TEST_ENV", "true") },
+			want:   want{v: true},
+		},
+		"missing env falls back": {
+			setEnv: func(t *testing.T) { /* noop */ },
+			want:   want{v: false},
+		},
+		"invalid env": {
+			setEnv: func(t *testing.T) { t.Setenv("TEST_ENV", "yes") },
+			want:   want{err: `parse env TEST_ENV: strconv.ParseBool: parsing "yes": invalid syntax`},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.setEnv(t)
+			applier := env.New(nil)
+
+			got := applier.Bool("TEST_ENV", false)
+
+			assert.Equal(t, tc.want.v, got)
+			if tc.want.err == "" {
+				assert.NoError(t, applier.Err())
+			} else {
+				assert.EqualError(t, applier.Err(), tc.want.err)
+			}
+		})
+	}
+}
+
+func TestApplier_Float(t *testing.T) {
+	type want struct {
+		v   float64
+		err string
+	}
+	tests := map[string]struct {
+		setEnv func(t *testing.T)
+		want   want
+	}{
+		"success": {
+			setEnv: func(t *testing.T) { t.Setenv("TEST_ENV", "0.25") },
+			want:   want{v: 0.25},
+		},
+		"missing env falls back": {
+			setEnv: func(t *testing.T) { /* noop */ },
+			want:   want{v: 1},
+		},
+		"invalid env": {
+			setEnv: func(t *testing.T) { t.Setenv("TEST_ENV", "half") },
+			want:   want{v: 1, err: `parse env TEST_ENV: strconv.ParseFloat: parsing "half": invalid syntax`},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.setEnv(t)
+			applier := env.New(nil)
+
+			got := applier.Float("TEST_ENV", 1)
+
+			assert.Equal(t, tc.want.v, got)
+			if tc.want.err == "" {
+				assert.NoError(t, applier.Err())
+			} else {
+				assert.EqualError(t, applier.Err(), tc.want.err)
+			}
+		})
+	}
+}
+
+func TestApplier_JSON(t *testing.T) {
+	type value struct {
+		Name string `json:"name"`
+	}
+	type want struct {
+		v   []value
+		err string
+	}
+	tests := map[string]struct {
+		setEnv func(t *testing.T)
+		want   want
+	}{
+		"success": {
+			setEnv: func(t *testing.T) { t.Setenv("TEST_ENV", `[{"name":"a"},{"name":"b"}]`) },
+			want:   want{v: []value{{Name: "a"}, {Name: "b"}}},
+		},
+		"missing env": {
+			setEnv: func(t *testing.T) { /* noop */ },
+			want:   want{err: "missing env TEST_ENV"},
+		},
+		"invalid env": {
+			setEnv: func(t *testing.T) { t.Setenv("TEST_ENV", "[") },
+			want:   want{err: "parse env TEST_ENV: unexpected end of JSON input"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.setEnv(t)
+			applier := env.New(nil)
+
+			var got []value
+			applier.JSON("TEST_ENV", &got)
+
+			assert.Equal(t, tc.want.v, got)
+			if tc.want.err == "" {
+				assert.NoError(t, applier.Err())
+			} else {
+				assert.EqualError(t, applier.Err(), tc.want.err)
+			}
+		})
+	}
+}
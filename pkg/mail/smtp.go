@@ -0,0 +1,111 @@
+// Package mail sends plain text mails.
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// SMTPSender sends mails via SMTP server.
+type SMTPSender struct {
+	// Addr is host:port of SMTP server.
+	Addr string
+	// From is address of sender.
+	From string
+	// Auth is used if SMTP server supports AUTH extension. Nil means no authentication.
+	Auth smtp.Auth
+	// TLSConfig is used if SMTP server supports STARTTLS extension. Nil means config verifying host of Addr by system roots.
+	TLSConfig *tls.Config
+}
+
+// Send sends plain text mail to given address.
+// Connection is established and closed per mail, so this is not for bulk mails.
+func (s *SMTPSender) Send(ctx context.Context, to, subject, body string) error {
+	msg, err := s.message(to, subject, body)
+	if err != nil {
+		return err
+	}
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", s.Addr)
+	if err != nil {
+		return fmt.Errorf("dial smtp server: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	host, _, _ := net.SplitHostPort(s.Addr)
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("new smtp client: %w", err)
+	}
+	defer func() { _ = c.Close() }()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(s.tlsConfig(host)); err != nil {
+			return fmt.Errorf("smtp starttls: %w", err)
+		}
+	}
+	if s.Auth != nil {
+		if err := c.Auth(s.Auth); err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+	if err := c.Mail(s.From); err != nil {
+		return fmt.Errorf("smtp mail from: %w", err)
+	}
+	if err := c.Rcpt(to); err != nil {
+		return fmt.Errorf("smtp rcpt to: %w", err)
+	}
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+	if _, err := w.Write(msg); err != nil {
+		return fmt.Errorf("write mail: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("close mail: %w", err)
+	}
+	return c.Quit()
+}
+
+// tlsConfig returns config for STARTTLS which verifies given host unless ServerName is configured.
+func (s *SMTPSender) tlsConfig(host string) *tls.Config {
+	if s.TLSConfig == nil {
+		return &tls.Config{ServerName: host}
+	}
+	cfg := s.TLSConfig.Clone()
+	if cfg.ServerName == "" {
+		cfg.ServerName = host
+	}
+	return cfg
+}
+
+func (s *SMTPSender) message(to, subject, body string) ([]byte, error) {
+	if _, err := mail.ParseAddress(to); err != nil {
+		return nil, fmt.Errorf("parse to address %q: %w", to, err)
+	}
+	if strings.ContainsAny(to, "\r\n") {
+		return nil, fmt.Errorf("to address %q contains line break", to)
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", s.From)
+	fmt.Fprintf(&buf, "To: %s\r\n", to)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(strings.ReplaceAll(body, "\r\n", "\n"), "\n", "\r\n"))
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,68 @@
+package mail_test
+
+import (
+	"context"
+	"crypto/tls"
+	"go-playground/pkg/mail"
+	"go-playground/pkg/testhelper"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSMTPSender_Send(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		svr := testhelper.StartSMTPServer(t)
+		sender := &mail.SMTPSender{Addr: svr.Addr(), From: "noreply@example.com"}
+
+		err := sender.Send(context.Background(), "user@example.com", "Verify your email", "Open link.\nhttps://example.com")
+
+		require.NoError(t, err)
+		messages := svr.Messages()
+		require.Len(t, messages, 1)
+		assert.Equal(t, "noreply@example.com", messages[0].From)
+		assert.Equal(t, []string{"user@example.com"}, messages[0].To)
+		assert.Contains(t, messages[0].Data, "To: user@example.com\n")
+		assert.Contains(t, messages[0].Data, "Subject: Verify your email\n")
+		assert.Contains(t, messages[0].Data, "\nOpen link.\nhttps://example.com")
+		assert.False(t, messages[0].TLS)
+	})
+	t.Run("success with starttls", func(t *testing.T) {
+		svr := testhelper.StartTLSSMTPServer(t)
+		sender := &mail.SMTPSender{Addr: svr.Addr(), From: "noreply@example.com", TLSConfig: &tls.Config{RootCAs: svr.RootCAs()}}
+
+		err := sender.Send(context.Background(), "user@example.com", "Verify your email", "Open link.")
+
+		require.NoError(t, err)
+		messages := svr.Messages()
+		require.Len(t, messages, 1)
+		assert.Equal(t, []string{"user@example.com"}, messages[0].To)
+		assert.True(t, messages[0].TLS)
+	})
+	t.Run("untrusted certificate", func(t *testing.T) {
+		svr := testhelper.StartTLSSMTPServer(t)
+		sender := &mail.SMTPSender{Addr: svr.Addr(), From: "noreply@example.com"}
+
+		err := sender.Send(context.Background(), "user@example.com", "subject", "body")
+
+		require.ErrorContains(t, err, "smtp starttls")
+		assert.Empty(t, svr.Messages())
+	})
+	t.Run("invalid address", func(t *testing.T) {
+		svr := testhelper.StartSMTPServer(t)
+		sender := &mail.SMTPSender{Addr: svr.Addr(), From: "noreply@example.com"}
+
+		err := sender.Send(context.Background(), "user@example.com\r\nBcc: other@example.com", "subject", "body")
+
+		require.Error(t, err)
+		assert.Empty(t, svr.Messages())
+	})
+	t.Run("server is unreachable", func(t *testing.T) {
+		sender := &mail.SMTPSender{Addr: "127.0.0.1:1", From: "noreply@example.com"}
+
+		err := sender.Send(context.Background(), "user@example.com", "subject", "body")
+
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,39 @@
+// Package markdown renders markdown into sanitized HTML.
+package markdown
+
+import (
+	"bytes"
+	"regexp"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+var (
+	converter = goldmark.New(goldmark.WithExtensions(extension.GFM))
+	policy    = newPolicy()
+)
+
+// newPolicy creates sanitizing policy for user generated content.
+// Scripts, event handlers and unsafe link schemes(e.g. javascript:) are removed.
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowURLSchemes("http", "https", "mailto")
+	p.RequireNoFollowOnLinks(true)
+	p.RequireNoReferrerOnLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+	// checkbox of GFM task list
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").OnElements("input")
+	return p
+}
+
+// ToHTML renders given markdown to sanitized HTML.
+func ToHTML(src string) (string, error) {
+	var buf bytes.Buffer
+	if err := converter.Convert([]byte(src), &buf); err != nil {
+		return "", err
+	}
+	return policy.Sanitize(buf.String()), nil
+}
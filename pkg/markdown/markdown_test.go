@@ -0,0 +1,53 @@
+package markdown_test
+
+import (
+	"go-playground/pkg/markdown"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToHTML(t *testing.T) {
+	tests := map[string]struct {
+		in   string
+		want string
+	}{
+		"plain text": {
+			in:   "go shopping",
+			want: "<p>go shopping</p>\n",
+		},
+		"emphasis and list": {
+			in:   "**milk**\n\n- eggs\n- bread",
+			want: "<p><strong>milk</strong></p>\n<ul>\n<li>eggs</li>\n<li>bread</li>\n</ul>\n",
+		},
+		"task list": {
+			in:   "- [x] done",
+			want: "<ul>\n<li><input checked=\"\" disabled=\"\" type=\"checkbox\"> done</li>\n</ul>\n",
+		},
+		"safe link": {
+			in:   "[example](https://example.com)",
+			want: "<p><a href=\"https://example.com\" rel=\"nofollow noreferrer noopener\" target=\"_blank\">example</a></p>\n",
+		},
+		"javascript link is removed": {
+			in:   "[click](javascript:alert(1))",
+			want: "<p>click</p>\n",
+		},
+		"raw script is removed": {
+			in:   "<script>alert(1)</script>",
+			want: "\n",
+		},
+		"event handler is removed": {
+			in:   "<img src=\"https://example.com/a.png\" onerror=\"alert(1)\">",
+			want: "\n",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := markdown.ToHTML(tc.in)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
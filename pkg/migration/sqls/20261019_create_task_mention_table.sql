@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE task_mentions (
+    task_id VARCHAR(36) NOT NULL COMMENT 'task_id is id of task mentioning user',
+    user_id BINARY(16) NOT NULL COMMENT 'user_id is id of mentioned user',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (task_id, user_id),
+    KEY idx_user_id_task_id (user_id, task_id) COMMENT 'index for listing tasks mentioning user',
+    CONSTRAINT fk_task_mentions_task_id FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_mentions_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) COMMENT = 'task_mentions is users mentioned in task content';
+
+-- +goose Down
+DROP TABLE IF EXISTS task_mentions;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE time_entries (
+    id VARCHAR(36) NOT NULL PRIMARY KEY COMMENT 'id is time entry id',
+    task_id VARCHAR(36) NOT NULL COMMENT 'task_id is id of tracked task',
+    user_id BINARY(16) NOT NULL COMMENT 'user_id is id of user who tracked time',
+    started_at DATETIME NOT NULL COMMENT 'started_at is when tracking started',
+    ended_at DATETIME NULL COMMENT 'ended_at is when tracking ended. null means timer is running',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    KEY idx_user_id_started_at (user_id, started_at) COMMENT 'index for time entries of user',
+    CONSTRAINT fk_time_entries_task_id FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE,
+    CONSTRAINT fk_time_entries_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) COMMENT = 'time_entries is time tracked on tasks';
+
+-- +goose Down
+DROP TABLE IF EXISTS time_entries;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE tasks_archive (
+    id VARCHAR(36) NOT NULL PRIMARY KEY COMMENT 'id is task id',
+    content TEXT NOT NULL COMMENT 'content is task content',
+    created_at DATETIME NOT NULL,
+    updated_at DATETIME NOT NULL,
+    archived_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT 'archived_at is when task was moved from tasks'
+) COMMENT = 'tasks_archive is cold storage of tasks not updated for long time';
+
+-- Task is either in tasks or tasks_archive, so rows referring task must not be deleted by moving it.
+ALTER TABLE task_mentions DROP FOREIGN KEY fk_task_mentions_task_id;
+ALTER TABLE time_entries DROP FOREIGN KEY fk_time_entries_task_id;
+
+-- +goose Down
+ALTER TABLE time_entries ADD CONSTRAINT fk_time_entries_task_id FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE;
+ALTER TABLE task_mentions ADD CONSTRAINT fk_task_mentions_task_id FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE;
+DROP TABLE IF EXISTS tasks_archive;
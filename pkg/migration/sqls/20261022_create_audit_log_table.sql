@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE audit_logs (
+    id VARCHAR(36) NOT NULL PRIMARY KEY COMMENT 'id is audit log id',
+    actor_id BINARY(16) NOT NULL COMMENT 'actor_id is id of user who did the action',
+    action VARCHAR(64) NOT NULL COMMENT 'action is kind of recorded action',
+    target_id VARCHAR(64) NOT NULL COMMENT 'target_id is id of resource which the action was done to',
+    detail JSON NOT NULL COMMENT 'detail is additional information depending on action',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    KEY idx_actor_id_created_at (actor_id, created_at) COMMENT 'index for audit logs of actor',
+    KEY idx_target_id_created_at (target_id, created_at) COMMENT 'index for audit logs of target'
+) COMMENT = 'audit_logs is append only record of security relevant actions. It has no foreign key to outlive actors and targets';
+
+-- +goose Down
+DROP TABLE IF EXISTS audit_logs;
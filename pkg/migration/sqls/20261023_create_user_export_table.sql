@@ -0,0 +1,22 @@
+-- +goose Up
+ALTER TABLE users
+    ADD COLUMN deleted_at DATETIME NULL COMMENT 'deleted_at is when user requested deletion. User is purged after grace period',
+    ADD KEY idx_deleted_at (deleted_at) COMMENT 'index for purging deleted users';
+
+CREATE TABLE user_exports (
+    id VARCHAR(36) NOT NULL PRIMARY KEY COMMENT 'id is user export id',
+    user_id BINARY(16) NOT NULL COMMENT 'user_id is id of exported user',
+    status VARCHAR(16) NOT NULL COMMENT 'status is one of pending, ready and failed',
+    content JSON NULL COMMENT 'content is exported personal data. This is present when status is ready',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    KEY idx_status (status) COMMENT 'index for pending exports',
+    KEY idx_user_id (user_id) COMMENT 'index for exports of user',
+    CONSTRAINT fk_user_exports_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) COMMENT = 'user_exports is personal data export requested by user';
+
+-- +goose Down
+DROP TABLE IF EXISTS user_exports;
+ALTER TABLE users
+    DROP KEY idx_deleted_at,
+    DROP COLUMN deleted_at;
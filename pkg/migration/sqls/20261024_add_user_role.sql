@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE users
+    ADD COLUMN role VARCHAR(16) NOT NULL DEFAULT 'user' COMMENT 'role is one of user, support and admin',
+    ADD COLUMN disabled_at DATETIME NULL COMMENT 'disabled_at is when user is disabled by admin. Disabled user is rejected by auth layer';
+
+-- +goose Down
+ALTER TABLE users
+    DROP COLUMN disabled_at,
+    DROP COLUMN role;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE personal_access_tokens (
+    id VARCHAR(36) NOT NULL PRIMARY KEY COMMENT 'id is personal access token id',
+    user_id BINARY(16) NOT NULL COMMENT 'user_id is id of user who owns the token',
+    name VARCHAR(100) NOT NULL COMMENT 'name is label of the token given by user',
+    token_hash BINARY(32) NOT NULL COMMENT 'token_hash is SHA-256 of raw token. Raw token is never stored',
+    scopes VARCHAR(255) NOT NULL COMMENT 'scopes is space separated scopes granted to the token',
+    expires_at DATETIME NOT NULL COMMENT 'expires_at is when the token expires',
+    revoked_at DATETIME NULL COMMENT 'revoked_at is when the token is revoked',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY idx_token_hash (token_hash) COMMENT 'index to authenticate request by token',
+    KEY idx_user_id (user_id) COMMENT 'index for tokens of user',
+    CONSTRAINT fk_personal_access_tokens_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) COMMENT = 'personal_access_tokens is long-lived token issued by user for scripts and CI jobs';
+
+-- +goose Down
+DROP TABLE IF EXISTS personal_access_tokens;
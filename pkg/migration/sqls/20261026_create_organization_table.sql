@@ -0,0 +1,53 @@
+-- +goose Up
+CREATE TABLE organizations (
+    id VARCHAR(36) NOT NULL PRIMARY KEY COMMENT 'id is organization id',
+    name VARCHAR(100) NOT NULL COMMENT 'name is display name of organization',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+) COMMENT = 'organizations is tenancy boundary which owns tasks';
+
+CREATE TABLE organization_members (
+    organization_id VARCHAR(36) NOT NULL COMMENT 'organization_id is id of organization',
+    user_id BINARY(16) NOT NULL COMMENT 'user_id is id of member',
+    role VARCHAR(16) NOT NULL COMMENT 'role is one of owner, admin and member',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (organization_id, user_id),
+    KEY idx_user_id (user_id) COMMENT 'index for organizations of user',
+    CONSTRAINT fk_organization_members_organization_id FOREIGN KEY (organization_id) REFERENCES organizations (id) ON DELETE CASCADE,
+    CONSTRAINT fk_organization_members_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) COMMENT = 'organization_members is users belonging to organization with role';
+
+-- Tasks were shared by all users, so existing users and tasks move to default organization to keep them visible to each other.
+-- Admins of this service own it, and others are members.
+INSERT INTO organizations (id, name) VALUES ('00000000-0000-0000-0000-000000000001', 'Default');
+INSERT INTO organization_members (organization_id, user_id, role)
+SELECT
+    '00000000-0000-0000-0000-000000000001',
+    id,
+    CASE role WHEN 'admin' THEN 'owner' ELSE 'member' END
+FROM
+    users;
+
+ALTER TABLE tasks ADD COLUMN organization_id VARCHAR(36) NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' COMMENT 'organization_id is id of organization owning task' AFTER id;
+ALTER TABLE tasks
+    ALTER COLUMN organization_id DROP DEFAULT,
+    ADD KEY idx_organization_id_id (organization_id, id) COMMENT 'index for listing tasks of organization',
+    ADD CONSTRAINT fk_tasks_organization_id FOREIGN KEY (organization_id) REFERENCES organizations (id) ON DELETE CASCADE;
+
+ALTER TABLE tasks_archive ADD COLUMN organization_id VARCHAR(36) NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' COMMENT 'organization_id is id of organization owning task' AFTER id;
+ALTER TABLE tasks_archive
+    ALTER COLUMN organization_id DROP DEFAULT,
+    ADD KEY idx_organization_id_id (organization_id, id) COMMENT 'index for listing archived tasks of organization',
+    ADD CONSTRAINT fk_tasks_archive_organization_id FOREIGN KEY (organization_id) REFERENCES organizations (id) ON DELETE CASCADE;
+
+-- +goose Down
+ALTER TABLE tasks_archive
+    DROP FOREIGN KEY fk_tasks_archive_organization_id,
+    DROP KEY idx_organization_id_id,
+    DROP COLUMN organization_id;
+ALTER TABLE tasks
+    DROP FOREIGN KEY fk_tasks_organization_id,
+    DROP KEY idx_organization_id_id,
+    DROP COLUMN organization_id;
+DROP TABLE IF EXISTS organization_members;
+DROP TABLE IF EXISTS organizations;
@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE user_preferences (
+    user_id BINARY(16) NOT NULL PRIMARY KEY COMMENT 'user_id is id of user who owns the preferences',
+    timezone VARCHAR(64) NOT NULL COMMENT 'timezone is IANA time zone name to render and compute dates',
+    locale VARCHAR(35) NOT NULL COMMENT 'locale is BCP 47 language tag',
+    page_size INT NOT NULL COMMENT 'page_size is default size of list pages',
+    week_start TINYINT NOT NULL COMMENT 'week_start is first day of week. 0 is Sunday and 6 is Saturday',
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    CONSTRAINT fk_user_preferences_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) COMMENT = 'user_preferences is preferences of user. Default preferences are used for user without the record';
+
+-- +goose Down
+DROP TABLE IF EXISTS user_preferences;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- Tasks created before ownership have no owner and are kept when users are purged.
+ALTER TABLE tasks
+    ADD COLUMN owner_id BINARY(16) NULL COMMENT 'owner_id is id of user who created task' AFTER organization_id,
+    ADD KEY idx_owner_id (owner_id) COMMENT 'index for tasks of owner',
+    ADD CONSTRAINT fk_tasks_owner_id FOREIGN KEY (owner_id) REFERENCES users (id) ON DELETE CASCADE;
+
+ALTER TABLE tasks_archive
+    ADD COLUMN owner_id BINARY(16) NULL COMMENT 'owner_id is id of user who created task' AFTER organization_id,
+    ADD KEY idx_owner_id_archived_at (owner_id, archived_at) COMMENT 'index for archived tasks of owner',
+    ADD CONSTRAINT fk_tasks_archive_owner_id FOREIGN KEY (owner_id) REFERENCES users (id) ON DELETE CASCADE;
+
+-- +goose Down
+ALTER TABLE tasks_archive
+    DROP FOREIGN KEY fk_tasks_archive_owner_id,
+    DROP KEY idx_owner_id_archived_at,
+    DROP COLUMN owner_id;
+ALTER TABLE tasks
+    DROP FOREIGN KEY fk_tasks_owner_id,
+    DROP KEY idx_owner_id,
+    DROP COLUMN owner_id;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- Rows referring task have no foreign key to tasks since task is either in tasks or tasks_archive, so they are not deleted by cascade.
+-- Organization can be deleted only after its tasks are deleted with rows referring them, not to leave those rows behind.
+ALTER TABLE tasks
+    DROP FOREIGN KEY fk_tasks_organization_id,
+    ADD CONSTRAINT fk_tasks_organization_id FOREIGN KEY (organization_id) REFERENCES organizations (id) ON DELETE RESTRICT;
+ALTER TABLE tasks_archive
+    DROP FOREIGN KEY fk_tasks_archive_organization_id,
+    ADD CONSTRAINT fk_tasks_archive_organization_id FOREIGN KEY (organization_id) REFERENCES organizations (id) ON DELETE RESTRICT;
+
+-- +goose Down
+ALTER TABLE tasks_archive
+    DROP FOREIGN KEY fk_tasks_archive_organization_id,
+    ADD CONSTRAINT fk_tasks_archive_organization_id FOREIGN KEY (organization_id) REFERENCES organizations (id) ON DELETE CASCADE;
+ALTER TABLE tasks
+    DROP FOREIGN KEY fk_tasks_organization_id,
+    ADD CONSTRAINT fk_tasks_organization_id FOREIGN KEY (organization_id) REFERENCES organizations (id) ON DELETE CASCADE;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- Audit logs are kept after users are purged, so they must not keep emails of users.
+UPDATE audit_logs SET detail = JSON_REMOVE(detail, '$.from', '$.to') WHERE action = 'user.email_changed';
+UPDATE audit_logs SET detail = JSON_REMOVE(detail, '$.email') WHERE action = 'user.email_verified';
+
+-- +goose Down
+-- Removed emails can not be restored.
@@ -0,0 +1,177 @@
+package testhelper
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// IssuerAudience is default audience of tokens minted by [Issuer].
+const IssuerAudience = "backend"
+
+// IssuerTokenLifetime is default lifetime of tokens minted by [Issuer].
+const IssuerTokenLifetime = time.Hour
+
+// Issuer is fake OpenID Connect issuer for development and tests.
+// It serves OpenID configuration and JWKS, and mints RS256 signed tokens with arbitrary subjects and claims.
+// Signing key is generated per issuer, so tokens are invalidated when issuer is recreated.
+type Issuer struct {
+	url string
+	kid string
+	key *rsa.PrivateKey
+	mux *http.ServeMux
+}
+
+// NewIssuer creates fake issuer identified by issuerURL, which must be URL serving the issuer.
+//
+// Following endpoints are served under issuerURL.
+//   - GET /.well-known/openid-configuration: OpenID configuration
+//   - GET /.well-known/jwks.json: JWKS
+//   - POST /token: mints token with claims in JSON body, which must contain sub
+func NewIssuer(issuerURL string) (*Issuer, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, fmt.Errorf("generate rsa key: %w", err)
+	}
+	kid := make([]byte, 8)
+	if _, err := rand.Read(kid); err != nil {
+		return nil, fmt.Errorf("generate key id: %w", err)
+	}
+	i := &Issuer{
+		url: issuerURL,
+		kid: base64.RawURLEncoding.EncodeToString(kid),
+		key: key,
+		mux: http.NewServeMux(),
+	}
+	i.mux.HandleFunc("GET /.well-known/openid-configuration", i.serveConfiguration)
+	i.mux.HandleFunc("GET /.well-known/jwks.json", i.serveJWKS)
+	i.mux.HandleFunc("POST /token", i.serveToken)
+	return i, nil
+}
+
+// StartIssuer starts fake issuer on local server, which is closed when test ends.
+func StartIssuer(t *testing.T) *Issuer {
+	t.Helper()
+	svr := httptest.NewUnstartedServer(nil)
+	issuer, err := NewIssuer(fmt.Sprintf("http://%s/", svr.Listener.Addr()))
+	if err != nil {
+		t.Fatalf("new issuer: %v", err)
+	}
+	svr.Config.Handler = issuer
+	svr.Start()
+	t.Cleanup(svr.Close)
+	return issuer
+}
+
+// URL returns issuer URL, which is iss claim of minted tokens.
+func (i *Issuer) URL() string {
+	return i.url
+}
+
+// ServeHTTP implements [http.Handler].
+func (i *Issuer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	i.mux.ServeHTTP(w, r)
+}
+
+// Mint mints token of sub signed by issuer.
+// Token has iss, sub, aud of [IssuerAudience], iat and exp after [IssuerTokenLifetime], and they are overridden by claims.
+func (i *Issuer) Mint(sub string, claims map[string]any) (string, error) {
+	now := time.Now()
+	payload := map[string]any{
+		"iss": i.url,
+		"sub": sub,
+		"aud": []string{IssuerAudience},
+		"iat": now.Unix(),
+		"exp": now.Add(IssuerTokenLifetime).Unix(),
+	}
+	for k, v := range claims {
+		payload[k] = v
+	}
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": i.kid})
+	if err != nil {
+		return "", fmt.Errorf("marshal token header: %w", err)
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("marshal token payload: %w", err)
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(body)
+	digest := sha256.Sum256([]byte(signingInput))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, i.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("sign token: %w", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+// Token mints token like [Issuer.Mint] and fails test if minting fails.
+func (i *Issuer) Token(t *testing.T, sub string, claims map[string]any) string {
+	t.Helper()
+	token, err := i.Mint(sub, claims)
+	if err != nil {
+		t.Fatalf("mint token: %v", err)
+	}
+	return token
+}
+
+func (i *Issuer) serveConfiguration(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                i.url,
+		"jwks_uri":                              strings.TrimSuffix(i.url, "/") + "/.well-known/jwks.json",
+		"token_endpoint":                        strings.TrimSuffix(i.url, "/") + "/token",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (i *Issuer) serveJWKS(w http.ResponseWriter, _ *http.Request) {
+	pub := i.key.PublicKey
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"use": "sig",
+			"alg": "RS256",
+			"kid": i.kid,
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}},
+	})
+}
+
+func (i *Issuer) serveToken(w http.ResponseWriter, r *http.Request) {
+	var claims map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&claims); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request", "error_description": err.Error()})
+		return
+	}
+	sub, _ := claims["sub"].(string)
+	if sub == "" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request", "error_description": "sub is required"})
+		return
+	}
+	token, err := i.Mint(sub, claims)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error", "error_description": err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": token,
+		"token_type":   "Bearer",
+		"expires_in":   int(IssuerTokenLifetime.Seconds()),
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,86 @@
+package testhelper_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"go-playground/pkg/testhelper"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssuer(t *testing.T) {
+	issuer := testhelper.StartIssuer(t)
+
+	t.Run("openid configuration", func(t *testing.T) {
+		res, err := http.Get(issuer.URL() + ".well-known/openid-configuration")
+		require.NoError(t, err)
+		defer func() { _ = res.Body.Close() }()
+		var got map[string]any
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, issuer.URL(), got["issuer"])
+		assert.Equal(t, issuer.URL()+".well-known/jwks.json", got["jwks_uri"])
+	})
+	t.Run("jwks", func(t *testing.T) {
+		res, err := http.Get(issuer.URL() + ".well-known/jwks.json")
+		require.NoError(t, err)
+		defer func() { _ = res.Body.Close() }()
+		var got struct {
+			Keys []map[string]string `json:"keys"`
+		}
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		require.Len(t, got.Keys, 1)
+		assert.Equal(t, "RSA", got.Keys[0]["kty"])
+		assert.Equal(t, "RS256", got.Keys[0]["alg"])
+	})
+	t.Run("mint", func(t *testing.T) {
+		token := issuer.Token(t, "test-sub", map[string]any{"scope": "read:tasks", "aud": "api"})
+
+		got := decodePayload(t, token)
+		assert.Equal(t, issuer.URL(), got["iss"])
+		assert.Equal(t, "test-sub", got["sub"])
+		assert.Equal(t, "api", got["aud"])
+		assert.Equal(t, "read:tasks", got["scope"])
+		assert.Contains(t, got, "exp")
+	})
+	t.Run("token endpoint", func(t *testing.T) {
+		res, err := http.Post(issuer.URL()+"token", "application/json", strings.NewReader(`{"sub":"test-sub","email":"test@example.com"}`))
+		require.NoError(t, err)
+		defer func() { _ = res.Body.Close() }()
+		var got struct {
+			AccessToken string `json:"access_token"`
+			TokenType   string `json:"token_type"`
+		}
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "Bearer", got.TokenType)
+		payload := decodePayload(t, got.AccessToken)
+		assert.Equal(t, "test-sub", payload["sub"])
+		assert.Equal(t, "test@example.com", payload["email"])
+	})
+	t.Run("token endpoint without sub", func(t *testing.T) {
+		res, err := http.Post(issuer.URL()+"token", "application/json", strings.NewReader(`{"email":"test@example.com"}`))
+		require.NoError(t, err)
+		defer func() { _ = res.Body.Close() }()
+
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+}
+
+func decodePayload(t *testing.T, token string) map[string]any {
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+	b, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(b, &payload))
+	return payload
+}
@@ -0,0 +1,193 @@
+package testhelper
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// SMTPMessage is mail received by [SMTPServer].
+type SMTPMessage struct {
+	From string
+	To   []string
+	// Data is raw mail including headers.
+	Data string
+	// TLS reports whether mail was received after STARTTLS.
+	TLS bool
+}
+
+// SMTPServer is fake SMTP server for tests which accepts every mail without authentication.
+type SMTPServer struct {
+	listener  net.Listener
+	tlsConfig *tls.Config
+	roots     *x509.CertPool
+	mu        sync.Mutex
+	messages  []SMTPMessage
+}
+
+// StartSMTPServer starts fake SMTP server on local, which is closed when test ends.
+func StartSMTPServer(t *testing.T) *SMTPServer {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen smtp: %v", err)
+	}
+	s := &SMTPServer{listener: l}
+	go s.serve()
+	t.Cleanup(func() { _ = l.Close() })
+	return s
+}
+
+// StartTLSSMTPServer starts fake SMTP server supporting STARTTLS extension with self-signed certificate for 127.0.0.1.
+// Clients must trust [SMTPServer.RootCAs] to verify the certificate.
+func StartTLSSMTPServer(t *testing.T) *SMTPServer {
+	t.Helper()
+	cert, roots := selfSignedCertificate(t)
+	s := StartSMTPServer(t)
+	s.tlsConfig = &tls.Config{Certificates: []tls.Certificate{cert}}
+	s.roots = roots
+	return s
+}
+
+// Addr returns host:port of server.
+func (s *SMTPServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// RootCAs returns pool of certificate of server started by [StartTLSSMTPServer]. Nil is returned for server without TLS.
+func (s *SMTPServer) RootCAs() *x509.CertPool {
+	return s.roots
+}
+
+// Messages returns mails received so far.
+func (s *SMTPServer) Messages() []SMTPMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]SMTPMessage(nil), s.messages...)
+}
+
+func (s *SMTPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *SMTPServer) handle(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+	tp := textproto.NewConn(conn)
+	_ = tp.PrintfLine("220 localhost fake smtp")
+	var msg SMTPMessage
+	secure := false
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "HELO", "EHLO":
+			if s.tlsConfig != nil && !secure {
+				_ = tp.PrintfLine("250-localhost")
+				_ = tp.PrintfLine("250 STARTTLS")
+				continue
+			}
+			_ = tp.PrintfLine("250 localhost")
+		case "STARTTLS":
+			if s.tlsConfig == nil || secure {
+				_ = tp.PrintfLine("502 command not implemented")
+				continue
+			}
+			_ = tp.PrintfLine("220 ready to start TLS")
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			// Client must greet again after TLS handshake.
+			conn, tp, secure, msg = tlsConn, textproto.NewConn(tlsConn), true, SMTPMessage{}
+		case "MAIL":
+			msg = SMTPMessage{From: trimPath(arg, "FROM:"), TLS: secure}
+			_ = tp.PrintfLine("250 OK")
+		case "RCPT":
+			msg.To = append(msg.To, trimPath(arg, "TO:"))
+			_ = tp.PrintfLine("250 OK")
+		case "DATA":
+			_ = tp.PrintfLine("354 end data with <CR><LF>.<CR><LF>")
+			data, err := readData(tp.R)
+			if err != nil {
+				return
+			}
+			msg.Data = data
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+			_ = tp.PrintfLine("250 OK")
+		case "RSET", "NOOP":
+			_ = tp.PrintfLine("250 OK")
+		case "QUIT":
+			_ = tp.PrintfLine("221 bye")
+			return
+		default:
+			_ = tp.PrintfLine("502 command not implemented")
+		}
+	}
+}
+
+func trimPath(arg, prefix string) string {
+	arg = strings.TrimSpace(arg)
+	if len(arg) >= len(prefix) && strings.EqualFold(arg[:len(prefix)], prefix) {
+		arg = arg[len(prefix):]
+	}
+	arg, _, _ = strings.Cut(strings.TrimSpace(arg), " ")
+	return strings.Trim(arg, "<>")
+}
+
+func readData(r *bufio.Reader) (string, error) {
+	b, err := textproto.NewReader(r).ReadDotBytes()
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func selfSignedCertificate(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse certificate: %v", err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(leaf)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}, roots
+}
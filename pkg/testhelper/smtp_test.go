@@ -0,0 +1,23 @@
+package testhelper_test
+
+import (
+	"go-playground/pkg/testhelper"
+	"net/smtp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSMTPServer(t *testing.T) {
+	svr := testhelper.StartSMTPServer(t)
+
+	err := smtp.SendMail(svr.Addr(), nil, "from@example.com", []string{"to1@example.com", "to2@example.com"}, []byte("Subject: test\r\n\r\nhello\r\n"))
+
+	require.NoError(t, err)
+	assert.Equal(t, []testhelper.SMTPMessage{{
+		From: "from@example.com",
+		To:   []string{"to1@example.com", "to2@example.com"},
+		Data: "Subject: test\n\nhello\n",
+	}}, svr.Messages())
+}